@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"time"
+
+	"linkedin-job-scraper/internal/addresses"
+	"linkedin-job-scraper/internal/commute"
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/services"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var addressesCmd = &cobra.Command{
+	Use:   "addresses",
+	Short: "Resolve job addresses against the offline Danish address register",
+}
+
+var addressesResolveCmd = &cobra.Command{
+	Use:   "resolve",
+	Short: "Find street addresses in job locations and descriptions and save them on the jobs",
+	Long: `Look up street addresses like "Vesterbrogade 12, København V" in the location and
+description of jobs without an address, using the deduplicated address CSV or binary
+index written by cmd/trimmed-adresses. Matching is done offline against the register, so only addresses
+that exist are saved, and no network call is made besides loading and saving the jobs.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		indexPath, _ := cmd.Flags().GetString("index")
+		limit, _ := cmd.Flags().GetInt("limit")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		debug, _ := cmd.Flags().GetBool("debug")
+
+		if debug {
+			logrus.SetLevel(logrus.DebugLevel)
+			logrus.Info("🐛 Debug mode enabled - will show jobs without an address")
+		}
+
+		runAddressResolve(indexPath, limit, dryRun)
+	},
+}
+
+var addressesCentroidsCmd = &cobra.Command{
+	Use:   "centroids <register.csv>",
+	Short: "Build the postal code centroid table used for commute estimates",
+	Long: `Average the WGS84 coordinates of every address in the full address register
+(https://api.dataforsyningen.dk/adresser?format=csv) per postal code. Point
+POSTAL_CENTROIDS_PATH at the output to use it instead of the bundled table, which only
+covers the larger postal codes.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		output, _ := cmd.Flags().GetString("output")
+		runBuildCentroids(args[0], output)
+	},
+}
+
+var addressesCommuteCmd = &cobra.Command{
+	Use:   "commute <from> <to>",
+	Short: "Estimate the commute between two postal codes or districts",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		mode, _ := cmd.Flags().GetString("mode")
+		maxCommute, _ := cmd.Flags().GetDuration("max")
+		runCommuteEstimate(args[0], args[1], commute.Mode(mode), maxCommute)
+	},
+}
+
+func init() {
+	addressesResolveCmd.Flags().StringP("index", "i", "", "Address CSV or binary index to load (default: ADDRESS_INDEX_PATH)")
+	addressesResolveCmd.Flags().IntP("limit", "l", 500, "Maximum number of jobs to resolve")
+	addressesResolveCmd.Flags().Bool("dry-run", false, "Print the resolved addresses without saving them")
+	addressesResolveCmd.Flags().BoolP("debug", "d", false, "Enable debug mode")
+
+	addressesCentroidsCmd.Flags().StringP("output", "o", "postal_centroids.csv", "Centroid CSV file to write")
+
+	addressesCommuteCmd.Flags().StringP("mode", "m", string(commute.ModeCar), "Travel mode: car, public_transport, bike or walk")
+	addressesCommuteCmd.Flags().Duration("max", 45*time.Minute, "Maximum commute, used for the location score")
+
+	addressesCmd.AddCommand(addressesResolveCmd)
+	addressesCmd.AddCommand(addressesCentroidsCmd)
+	addressesCmd.AddCommand(addressesCommuteCmd)
+	rootCmd.AddCommand(addressesCmd)
+}
+
+func runAddressResolve(indexPath string, limit int, dryRun bool) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	if indexPath == "" {
+		indexPath = cfg.Addresses.IndexPath
+	}
+
+	logrus.Infof("📚 Loading address index from %s", indexPath)
+	index, err := addresses.LoadFile(indexPath)
+	if err != nil {
+		logrus.Fatal("Failed to load address index: ", err)
+	}
+	logrus.Infof("✅ Loaded %d addresses in %d postal codes", index.Addresses(), index.PostalCodes())
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	processed, resolved, err := dataService.ResolveAddresses(index, limit, dryRun)
+	if err != nil {
+		logrus.Fatal("Address resolution failed: ", err)
+	}
+
+	if dryRun {
+		fmt.Printf("\n🧪 Dry run: found addresses for %d of %d jobs (nothing saved)\n", resolved, processed)
+		return
+	}
+	fmt.Printf("\n🎉 Address resolution completed! Checked: %d, Resolved: %d\n", processed, resolved)
+}
+
+func runBuildCentroids(registerPath, output string) {
+	inFile, err := os.Open(registerPath)
+	if err != nil {
+		logrus.Fatal("Failed to open address register: ", err)
+	}
+	defer inFile.Close()
+
+	outFile, err := os.Create(output)
+	if err != nil {
+		logrus.Fatal("Failed to create centroid file: ", err)
+	}
+	defer outFile.Close()
+
+	writer := bufio.NewWriter(outFile)
+	count, err := addresses.BuildCentroids(bufio.NewReaderSize(inFile, 1024*1024), writer)
+	if err != nil {
+		logrus.Fatal("Failed to build centroids: ", err)
+	}
+	if err := writer.Flush(); err != nil {
+		logrus.Fatal("Failed to write centroid file: ", err)
+	}
+
+	fmt.Printf("✅ Wrote centroids for %d postal codes to %s\n", count, output)
+}
+
+func runCommuteEstimate(from, to string, mode commute.Mode, maxCommute time.Duration) {
+	cfg := config.Load()
+
+	centroids, err := addresses.LoadCentroidsFile(cfg.Addresses.CentroidsPath)
+	if err != nil {
+		logrus.Fatal("Failed to load postal centroids: ", err)
+	}
+
+	estimator, err := commute.NewEstimator(centroids, from, maxCommute, mode)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	job := &models.JobPosting{Location: to}
+	estimate, ok := estimator.EstimateJob(job)
+	if !ok {
+		logrus.Fatalf("Unknown destination %q", to)
+	}
+
+	home := estimator.Home()
+	fmt.Printf("🚗 %s %s -> %s %s (%s)\n", home.PostalCode, home.City, estimate.Destination.PostalCode, estimate.Destination.City, mode)
+	fmt.Printf("   Distance: %.1f km in a straight line\n", estimate.DistanceKm)
+	fmt.Printf("   Travel time: ~%.0f minutes\n", estimate.TravelTime.Minutes())
+	fmt.Printf("   Location score: %d/100 (max commute %s)\n", *estimator.LocationScore(job), maxCommute)
+}
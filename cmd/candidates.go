@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/match"
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/services"
+	"linkedin-job-scraper/internal/skills"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var candidatesForJobCmd = &cobra.Command{
+	Use:   "candidates-for-job <linkedin-job-id>",
+	Short: "Rank the LinkedIn profiles collected by linkedin-user-scraper by fit for a job",
+	Long: `The reverse of rate: scores every stored user profile for one job on skills overlap
+(the job's skills against the profile's skill frequencies), title similarity with the
+latest positions, location and years of experience for the job's seniority level, and
+lists them best first with the reason for each score.
+
+The profiles come from the Laravel API, so this command doesn't work with --standalone.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		linkedinJobID, err := strconv.Atoi(args[0])
+		if err != nil {
+			logrus.Fatalf("Invalid LinkedIn job ID %q", args[0])
+		}
+		top, _ := cmd.Flags().GetInt("top")
+		limit, _ := cmd.Flags().GetInt("limit")
+		format, _ := cmd.Flags().GetString("format")
+		taxonomyPath, _ := cmd.Flags().GetString("taxonomy")
+
+		if format != "table" && format != "json" {
+			logrus.Fatalf("Unknown format %q, use table or json", format)
+		}
+
+		runCandidatesForJob(linkedinJobID, top, limit, format, taxonomyPath)
+	},
+}
+
+func init() {
+	candidatesForJobCmd.Flags().IntP("top", "n", 20, "Number of profiles to list")
+	candidatesForJobCmd.Flags().IntP("limit", "l", 0, "Maximum number of profiles to load (0 for all)")
+	candidatesForJobCmd.Flags().StringP("format", "f", "table", "Output format: table or json")
+	candidatesForJobCmd.Flags().StringP("taxonomy", "t", "", "Skill taxonomy file (default: SKILL_TAXONOMY_PATH)")
+
+	rootCmd.AddCommand(candidatesForJobCmd)
+}
+
+func runCandidatesForJob(linkedinJobID, top, limit int, format, taxonomyPath string) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	if taxonomyPath == "" {
+		taxonomyPath = cfg.Skills.TaxonomyPath
+	}
+	taxonomy, err := skills.LoadTaxonomy(taxonomyPath)
+	if err != nil {
+		logrus.Warnf("⚠️  Skills will be compared by label: %v", err)
+	}
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	matcher := match.NewProfileMatcher(taxonomy, time.Now())
+	job, matches, err := dataService.RankProfilesForJob(matcher, linkedinJobID, limit)
+	if err != nil {
+		logrus.Fatal("Ranking failed: ", err)
+	}
+	if top > 0 && len(matches) > top {
+		matches = matches[:top]
+	}
+
+	if format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(matches); err != nil {
+			logrus.Fatal("Failed to write JSON: ", err)
+		}
+		return
+	}
+	printProfileMatches(job, matches)
+}
+
+func printProfileMatches(job *models.JobPosting, matches []match.ProfileMatch) {
+	fmt.Printf("🎯 %s at %s (job %d)\n\n", job.Title, job.CompanyName, job.LinkedInJobID)
+	if len(matches) == 0 {
+		fmt.Println("📭 No user profiles found")
+		return
+	}
+
+	rules := []string{match.RuleSkills, match.RuleTitle, match.RuleLocation, match.RuleExperience}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "RANK\tSCORE\tUSER\tYEARS\tSKILLS\tTITLE\tLOCATION\tEXPERIENCE")
+	for i, m := range matches {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%d", i+1, m.Score, m.Username, m.YearsExperience)
+		for _, rule := range rules {
+			fmt.Fprintf(w, "\t%s", formatRuleScore(m.Criteria[rule]))
+		}
+		fmt.Fprintln(w)
+	}
+	w.Flush()
+
+	fmt.Println()
+	for i, m := range matches {
+		fmt.Printf("%d. %s (%d/100)\n", i+1, m.Username, m.Score)
+		for _, rule := range rules {
+			fmt.Printf("   %-10s %s\n", rule, m.Criteria[rule].Reason)
+		}
+	}
+}
+
+// formatRuleScore prints a rule's score, or "-" if it couldn't be evaluated
+func formatRuleScore(result match.RuleResult) string {
+	if result.Score == nil {
+		return "-"
+	}
+	return strconv.Itoa(*result.Score)
+}
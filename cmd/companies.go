@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/dedupe"
+	"linkedin-job-scraper/internal/services"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var companiesCmd = &cobra.Command{
+	Use:   "companies",
+	Short: "Manage companies",
+}
+
+var companiesDedupeCmd = &cobra.Command{
+	Use:   "dedupe",
+	Short: "Find near-duplicate company names and merge them",
+	Long: `Find companies whose names only differ by legal suffix ("ApS", "A/S", "Inc."), case,
+punctuation or whitespace, and write a merge plan for review.
+
+Set "approved" to true for the clusters (JSON) or rows (CSV) that should be merged,
+then apply the plan with --apply. Merged names are kept as aliases, so scraping a job
+for "Novo Nordisk A/S" later resolves to the canonical "Novo Nordisk" company.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		threshold, _ := cmd.Flags().GetFloat64("threshold")
+		output, _ := cmd.Flags().GetString("output")
+		apply, _ := cmd.Flags().GetString("apply")
+		debug, _ := cmd.Flags().GetBool("debug")
+
+		if debug {
+			logrus.SetLevel(logrus.DebugLevel)
+			logrus.Info("🐛 Debug mode enabled - will show detailed merge data")
+		}
+
+		if apply != "" {
+			runCompanyMerge(apply)
+			return
+		}
+		runCompanyDedupe(threshold, output)
+	},
+}
+
+func init() {
+	companiesDedupeCmd.Flags().Float64P("threshold", "t", dedupe.DefaultThreshold, "Minimum name similarity (0-1) for two companies to be merge candidates")
+	companiesDedupeCmd.Flags().StringP("output", "o", "company-merge-plan.json", "Merge plan file to write (.json or .csv)")
+	companiesDedupeCmd.Flags().StringP("apply", "a", "", "Apply the approved merges from a reviewed plan file instead of writing a new plan")
+	companiesDedupeCmd.Flags().BoolP("debug", "d", false, "Enable debug mode")
+
+	companiesCmd.AddCommand(companiesDedupeCmd)
+	rootCmd.AddCommand(companiesCmd)
+}
+
+func runCompanyDedupe(threshold float64, output string) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	names, err := dataService.GetAllCompanyNames()
+	if err != nil {
+		logrus.Fatal("Failed to get company names: ", err)
+	}
+	logrus.Infof("🔍 Looking for near-duplicates among %d companies (threshold: %.2f)", len(names), threshold)
+
+	plan := dedupe.NewMergePlan(names, threshold)
+	if err := dedupe.WritePlanFile(output, plan); err != nil {
+		logrus.Fatal("Failed to write merge plan: ", err)
+	}
+
+	duplicates := 0
+	for _, cluster := range plan.Clusters {
+		duplicates += len(cluster.Duplicates)
+		logrus.Debugf("🏢 %s <- %v (similarity %.3f)", cluster.Canonical, cluster.Duplicates, cluster.Similarity)
+	}
+
+	fmt.Printf("📝 Found %d clusters with %d duplicate names, merge plan written to %s\n", len(plan.Clusters), duplicates, output)
+	fmt.Printf("👀 Review the plan, approve the merges you want and run: companies dedupe --apply %s\n", output)
+}
+
+func runCompanyMerge(planPath string) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	plan, err := dedupe.ReadPlanFile(planPath)
+	if err != nil {
+		logrus.Fatal("Failed to read merge plan: ", err)
+	}
+
+	approved := plan.Approved()
+	if len(approved) == 0 {
+		fmt.Println("📭 No approved merges in plan")
+		return
+	}
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	mergedCount := 0
+	failedCount := 0
+	jobsMoved := 0
+
+	for _, cluster := range approved {
+		fmt.Printf("🔀 Merging %v into %s\n", cluster.Duplicates, cluster.Canonical)
+
+		moved, err := dataService.MergeCompanies(cluster.Canonical, cluster.Duplicates)
+		if err != nil {
+			fmt.Printf("❌ Failed to merge cluster %d (%s): %v\n", cluster.ID, cluster.Canonical, err)
+			failedCount++
+			continue
+		}
+
+		mergedCount++
+		jobsMoved += moved
+	}
+
+	fmt.Printf("\n🎉 Company merge completed! Merged: %d, Failed: %d, Jobs moved: %d\n", mergedCount, failedCount, jobsMoved)
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/export"
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/services"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export jobs or companies to CSV, JSON Lines or Parquet",
+	Long: `Export jobs or companies from the configured storage backend.
+
+The format follows the output file extension (.csv, .jsonl or .parquet) unless --format
+is given; output to stdout is CSV by default. Records are streamed from the store in
+batches, so large exports don't load everything into memory.
+
+The filter flags select jobs. Company exports contain the companies with at least one
+matching job when any filter is set, and every company otherwise.`,
+}
+
+var exportJobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "Export jobs",
+	Example: `  linkedin-scraper export jobs -o jobs.csv --bom --from 2025-01-01 --status open
+  linkedin-scraper export jobs -o go-jobs.parquet --skills go,kubernetes --min-rating 70`,
+	Run: func(cmd *cobra.Command, args []string) {
+		output, format, bom, filter := exportFlags(cmd)
+		runExportJobs(output, format, bom, filter)
+	},
+}
+
+var exportCompaniesCmd = &cobra.Command{
+	Use:     "companies",
+	Short:   "Export companies, with their number of matching jobs",
+	Example: `  linkedin-scraper export companies -o remote-companies.csv --work-type Remote --from 2025-01-01`,
+	Run: func(cmd *cobra.Command, args []string) {
+		output, format, bom, filter := exportFlags(cmd)
+		runExportCompanies(output, format, bom, filter)
+	},
+}
+
+func init() {
+	flags := exportCmd.PersistentFlags()
+	flags.StringP("output", "o", "-", "File to write, - for stdout")
+	flags.StringP("format", "f", "", "Output format: csv, jsonl or parquet (default from the output file extension)")
+	flags.Bool("bom", false, "Start CSV output with a UTF-8 byte order mark, so Excel shows æ, ø and å correctly")
+	flags.String("from", "", "Only jobs posted on or after this date (YYYY-MM-DD)")
+	flags.String("to", "", "Only jobs posted on or before this date (YYYY-MM-DD)")
+	flags.StringSlice("skills", nil, "Only jobs with all of these skills (comma separated canonical IDs or names)")
+	flags.String("work-type", "", "Only jobs with this work type: Remote, Hybrid or On-site")
+	flags.String("company", "", "Only jobs at companies whose name contains this")
+	flags.Int("min-rating", 0, "Only jobs with a rating of at least this overall score")
+	flags.String("rating-type", "", "Rating type --min-rating applies to (default any)")
+	flags.String("status", "", "Only open or closed jobs")
+	exportJobsCmd.Flags().IntP("limit", "l", 0, "Maximum number of jobs to export (0 = all)")
+
+	exportCmd.AddCommand(exportJobsCmd)
+	exportCmd.AddCommand(exportCompaniesCmd)
+	rootCmd.AddCommand(exportCmd)
+}
+
+// exportFlags reads the output and filter flags shared by the export commands
+func exportFlags(cmd *cobra.Command) (output, format string, bom bool, filter models.JobFilter) {
+	output, _ = cmd.Flags().GetString("output")
+	format, _ = cmd.Flags().GetString("format")
+	bom, _ = cmd.Flags().GetBool("bom")
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	status, _ := cmd.Flags().GetString("status")
+
+	switch format {
+	case "":
+		format = export.FormatFromPath(output)
+	case export.FormatCSV, export.FormatJSONL, export.FormatParquet:
+	default:
+		logrus.Fatalf("Invalid --format %q, expected csv, jsonl or parquet", format)
+	}
+
+	filter.Skills, _ = cmd.Flags().GetStringSlice("skills")
+	filter.WorkType, _ = cmd.Flags().GetString("work-type")
+	filter.Company, _ = cmd.Flags().GetString("company")
+	filter.MinRating, _ = cmd.Flags().GetInt("min-rating")
+	filter.RatingType, _ = cmd.Flags().GetString("rating-type")
+	if cmd.Flags().Lookup("limit") != nil {
+		filter.Limit, _ = cmd.Flags().GetInt("limit")
+	}
+
+	var err error
+	if from != "" {
+		if filter.PostedAfter, err = time.Parse("2006-01-02", from); err != nil {
+			logrus.Fatalf("Invalid --from date %q, expected YYYY-MM-DD", from)
+		}
+	}
+	if to != "" {
+		if filter.PostedBefore, err = time.Parse("2006-01-02", to); err != nil {
+			logrus.Fatalf("Invalid --to date %q, expected YYYY-MM-DD", to)
+		}
+		// --to is inclusive, PostedBefore isn't
+		filter.PostedBefore = filter.PostedBefore.AddDate(0, 0, 1)
+	}
+
+	switch strings.ToLower(status) {
+	case "":
+	case "open":
+		filter.Open = true
+	case "closed":
+		filter.Closed = true
+	default:
+		logrus.Fatalf("Invalid --status %q, expected open or closed", status)
+	}
+	return output, format, bom, filter
+}
+
+func runExportJobs(output, format string, bom bool, filter models.JobFilter) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	out, closeOutput := openExportOutput(output)
+	writer, err := export.NewWriter(out, format, export.JobColumns, export.Options{BOM: bom})
+	if err != nil {
+		logrus.Fatal("Failed to start export: ", err)
+	}
+
+	count := 0
+	err = dataService.EachJob(filter, func(job *models.JobPosting) error {
+		count++
+		if count%5000 == 0 {
+			logrus.Infof("📦 Exported %d jobs...", count)
+		}
+		return writer.Write(job)
+	})
+	if err != nil {
+		logrus.Fatal("Export failed: ", err)
+	}
+	if err := writer.Close(); err != nil {
+		logrus.Fatal("Failed to finish export: ", err)
+	}
+	if err := closeOutput(); err != nil {
+		logrus.Fatal("Failed to write export: ", err)
+	}
+
+	logrus.Infof("✅ Exported %d jobs as %s to %s", count, format, exportTarget(output))
+}
+
+func runExportCompanies(output, format string, bom bool, filter models.JobFilter) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	// Count the matching jobs per company first; only the counts are kept in memory
+	matching := make(map[int]int)
+	err := dataService.EachJob(filter, func(job *models.JobPosting) error {
+		matching[int(job.CompanyID)]++
+		return nil
+	})
+	if err != nil {
+		logrus.Fatal("Failed to count jobs per company: ", err)
+	}
+	filtered := hasJobFilter(filter)
+
+	out, closeOutput := openExportOutput(output)
+	writer, err := export.NewWriter(out, format, export.CompanyColumns, export.Options{BOM: bom})
+	if err != nil {
+		logrus.Fatal("Failed to start export: ", err)
+	}
+
+	count := 0
+	err = dataService.EachCompany(models.CompanyFilter{}, func(company *models.Company) error {
+		jobs := matching[company.CompanyID]
+		if filtered && jobs == 0 {
+			return nil
+		}
+		count++
+		return writer.Write(&export.CompanyRow{Company: *company, MatchingJobs: jobs})
+	})
+	if err != nil {
+		logrus.Fatal("Export failed: ", err)
+	}
+	if err := writer.Close(); err != nil {
+		logrus.Fatal("Failed to finish export: ", err)
+	}
+	if err := closeOutput(); err != nil {
+		logrus.Fatal("Failed to write export: ", err)
+	}
+
+	logrus.Infof("✅ Exported %d companies as %s to %s", count, format, exportTarget(output))
+}
+
+// hasJobFilter reports whether any job filter flag was set
+func hasJobFilter(filter models.JobFilter) bool {
+	return len(filter.Skills) > 0 || filter.WorkType != "" || filter.Company != "" ||
+		filter.MinRating > 0 || !filter.PostedAfter.IsZero() || !filter.PostedBefore.IsZero() ||
+		filter.Open || filter.Closed
+}
+
+// openExportOutput opens the output file, or stdout for "-". The returned function flushes
+// and closes it.
+func openExportOutput(output string) (io.Writer, func() error) {
+	if output == "-" || output == "" {
+		out := bufio.NewWriter(os.Stdout)
+		return out, out.Flush
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		logrus.Fatal("Failed to create output file: ", err)
+	}
+	out := bufio.NewWriter(file)
+	return out, func() error {
+		if err := out.Flush(); err != nil {
+			file.Close()
+			return err
+		}
+		return file.Close()
+	}
+}
+
+func exportTarget(output string) string {
+	if output == "-" || output == "" {
+		return "stdout"
+	}
+	return output
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/services"
+	"linkedin-job-scraper/internal/skills"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var jobCmd = &cobra.Command{
+	Use:     "job",
+	Aliases: []string{"jobs"},
+	Short:   "Inspect and search stored jobs",
+}
+
+var jobHistoryCmd = &cobra.Command{
+	Use:   "history <linkedin-job-id>",
+	Short: "Show how a job posting changed over time (applicants, title, description, status)",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		linkedinJobID, err := strconv.Atoi(args[0])
+		if err != nil {
+			logrus.Fatalf("Invalid LinkedIn job ID %q", args[0])
+		}
+
+		runJobHistory(linkedinJobID)
+	},
+}
+
+var jobBackfillSkillsCmd = &cobra.Command{
+	Use:   "backfill-skills",
+	Short: "Normalise skills of existing jobs and extract skills from descriptions where LinkedIn gave none",
+	Long: `Jobs scraped before the skill taxonomy existed, or whose skills insights couldn't be
+opened, have no canonical skill IDs. Skills from LinkedIn's insights are normalised to
+taxonomy IDs; jobs without them get the taxonomy skills mentioned in their title and
+description, marked with skills_source "description". Jobs without any known skill get an
+empty list of skill IDs (and skills_source "none" if they have no skills at all), so each
+run moves on to jobs that haven't been checked.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		taxonomyPath, _ := cmd.Flags().GetString("taxonomy")
+		limit, _ := cmd.Flags().GetInt("limit")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		debug, _ := cmd.Flags().GetBool("debug")
+
+		if debug {
+			logrus.SetLevel(logrus.DebugLevel)
+			logrus.Info("🐛 Debug mode enabled - will show jobs without known skills")
+		}
+
+		runBackfillSkills(taxonomyPath, limit, dryRun)
+	},
+}
+
+func init() {
+	jobBackfillSkillsCmd.Flags().StringP("taxonomy", "t", "", "Skill taxonomy file (default: SKILL_TAXONOMY_PATH)")
+	jobBackfillSkillsCmd.Flags().IntP("limit", "l", 500, "Maximum number of jobs to check")
+	jobBackfillSkillsCmd.Flags().Bool("dry-run", false, "Print the skills without saving them")
+	jobBackfillSkillsCmd.Flags().BoolP("debug", "d", false, "Enable debug mode")
+
+	jobCmd.AddCommand(jobHistoryCmd)
+	jobCmd.AddCommand(jobBackfillSkillsCmd)
+	rootCmd.AddCommand(jobCmd)
+}
+
+func runJobHistory(linkedinJobID int) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	snapshots, err := dataService.GetJobHistory(linkedinJobID)
+	if err != nil {
+		logrus.Fatal("Failed to get job history: ", err)
+	}
+
+	if len(snapshots) == 0 {
+		fmt.Printf("📭 No history recorded for job %d\n", linkedinJobID)
+		return
+	}
+
+	fmt.Printf("📜 History of job %d: %s\n\n", linkedinJobID, snapshots[len(snapshots)-1].Title)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CAPTURED\tSTATUS\tAPPLICANTS\tWORK TYPE\tCHANGES")
+	for i := range snapshots {
+		snapshot := &snapshots[i]
+
+		var previous *models.JobSnapshot
+		if i > 0 {
+			previous = &snapshots[i-1]
+		}
+
+		status := "open"
+		if !snapshot.IsOpen {
+			status = "closed"
+		}
+		applicants := "-"
+		if snapshot.Applicants != nil {
+			applicants = strconv.Itoa(*snapshot.Applicants)
+		}
+		workType := "-"
+		if snapshot.WorkType != nil {
+			workType = *snapshot.WorkType
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			snapshot.CapturedAt.Local().Format("2006-01-02 15:04"), status, applicants, workType,
+			strings.Join(snapshotChanges(previous, snapshot), ", "))
+	}
+	w.Flush()
+}
+
+// snapshotChanges describes what changed between two consecutive snapshots of a job
+func snapshotChanges(previous, current *models.JobSnapshot) []string {
+	if previous == nil {
+		return []string{"first seen"}
+	}
+
+	var changes []string
+	if previous.IsOpen && !current.IsOpen {
+		changes = append(changes, "closed")
+	} else if !previous.IsOpen && current.IsOpen {
+		changes = append(changes, "reopened")
+	}
+	if previous.Applicants != nil && current.Applicants != nil && *previous.Applicants != *current.Applicants {
+		changes = append(changes, fmt.Sprintf("applicants %+d", *current.Applicants-*previous.Applicants))
+	}
+	if previous.Title != current.Title {
+		changes = append(changes, fmt.Sprintf("title %q -> %q", previous.Title, current.Title))
+	}
+	if previous.DescriptionHash != "" && current.DescriptionHash != "" && previous.DescriptionHash != current.DescriptionHash {
+		changes = append(changes, "description edited")
+	}
+	if previous.WorkType != nil && current.WorkType != nil && *previous.WorkType != *current.WorkType {
+		changes = append(changes, fmt.Sprintf("work type %s -> %s", *previous.WorkType, *current.WorkType))
+	}
+
+	if len(changes) == 0 {
+		return []string{"-"}
+	}
+	return changes
+}
+
+func runBackfillSkills(taxonomyPath string, limit int, dryRun bool) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	if taxonomyPath == "" {
+		taxonomyPath = cfg.Skills.TaxonomyPath
+	}
+	taxonomy, err := skills.LoadTaxonomy(taxonomyPath)
+	if err != nil {
+		logrus.Fatal("Failed to load skill taxonomy: ", err)
+	}
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	processed, filled, err := dataService.BackfillSkills(skills.NewExtractor(taxonomy), limit, dryRun)
+	if err != nil {
+		logrus.Fatal("Skill backfill failed: ", err)
+	}
+
+	if dryRun {
+		fmt.Printf("\n🧪 Dry run: found skills for %d of %d jobs (nothing saved)\n", filled, processed)
+		return
+	}
+	fmt.Printf("\n🎉 Skill backfill completed! Checked: %d, Updated: %d\n", processed, filled)
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"linkedin-job-scraper/internal/cache"
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/embeddings"
+	"linkedin-job-scraper/internal/match"
+	"linkedin-job-scraper/internal/services"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var jobEmbedCmd = &cobra.Command{
+	Use:   "embed",
+	Short: "Compute embeddings for new and changed open jobs, used by job similar and job search",
+	Run: func(cmd *cobra.Command, args []string) {
+		limit, _ := cmd.Flags().GetInt("limit")
+		debug, _ := cmd.Flags().GetBool("debug")
+
+		if debug {
+			logrus.SetLevel(logrus.DebugLevel)
+		}
+
+		runJobEmbed(limit)
+	},
+}
+
+var jobSimilarCmd = &cobra.Command{
+	Use:   "similar <linkedin-job-id>",
+	Short: "List the open jobs whose descriptions are most similar to a job",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		linkedinJobID, err := strconv.Atoi(args[0])
+		if err != nil {
+			logrus.Fatalf("Invalid LinkedIn job ID %q", args[0])
+		}
+		top, _ := cmd.Flags().GetInt("top")
+
+		runJobSimilar(linkedinJobID, top)
+	},
+}
+
+var jobSearchCmd = &cobra.Command{
+	Use:   `search ["<natural language query>"]`,
+	Short: "Search open jobs by meaning, or by the candidate profile with --profile",
+	Example: `  linkedin-scraper jobs search "backend work with PHP and cloud infrastructure"
+  linkedin-scraper jobs search --profile`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		top, _ := cmd.Flags().GetInt("top")
+		profile, _ := cmd.Flags().GetBool("profile")
+		configPath, _ := cmd.Flags().GetString("config")
+
+		var query string
+		if len(args) == 1 {
+			query = args[0]
+		}
+		if query == "" && !profile {
+			logrus.Fatal("Give a query or --profile")
+		}
+
+		runJobSearch(query, profile, configPath, top)
+	},
+}
+
+func init() {
+	jobEmbedCmd.Flags().IntP("limit", "l", 0, "Only check the newest N open jobs (0 = all, and remove closed jobs)")
+	jobEmbedCmd.Flags().BoolP("debug", "d", false, "Enable debug mode")
+
+	jobSimilarCmd.Flags().IntP("top", "n", 10, "Number of jobs to show")
+
+	jobSearchCmd.Flags().IntP("top", "n", 10, "Number of jobs to show")
+	jobSearchCmd.Flags().Bool("profile", false, "Search with the candidate profile from the match config")
+	jobSearchCmd.Flags().StringP("config", "c", match.DefaultConfigPath, "Job match config file, used with --profile")
+
+	jobCmd.AddCommand(jobEmbedCmd)
+	jobCmd.AddCommand(jobSimilarCmd)
+	jobCmd.AddCommand(jobSearchCmd)
+}
+
+// newEmbedder creates the configured embedder
+func newEmbedder(cfg *config.Config) embeddings.Embedder {
+	switch cfg.Embeddings.Provider {
+	case "hashing":
+		return embeddings.NewHashingEmbedder(0)
+	case "openai":
+		if cfg.AI.APIKey == "" && cfg.AI.BaseURL == "" {
+			logrus.Fatal("OPENAI_API_KEY is required for OpenAI embeddings (or set EMBEDDING_PROVIDER=hashing)")
+		}
+		return embeddings.NewOpenAIEmbedder(cfg.AI.APIKey, cfg.AI.BaseURL, cfg.Embeddings.Model)
+	default:
+		logrus.Fatalf("Unknown EMBEDDING_PROVIDER %q, expected openai or hashing", cfg.Embeddings.Provider)
+		return nil
+	}
+}
+
+// loadEmbeddingIndex loads the index from the configured store. An index of another model
+// can't be searched with this embedder, so it's replaced by an empty one.
+func loadEmbeddingIndex(cfg *config.Config, embedder embeddings.Embedder) (embeddings.Store, *embeddings.Index) {
+	var store embeddings.Store
+	switch cfg.Embeddings.Store {
+	case "file":
+		store = embeddings.FileStore{Path: cfg.Embeddings.IndexPath}
+	case "redis":
+		store = embeddings.RedisStore{Cache: cache.NewRedisCache(&cfg.Redis)}
+	default:
+		logrus.Fatalf("Unknown EMBEDDING_STORE %q, expected file or redis", cfg.Embeddings.Store)
+	}
+
+	idx, err := store.Load()
+	if err != nil {
+		logrus.Fatal("Failed to load embedding index: ", err)
+	}
+	if idx != nil && idx.Model != embedder.Model() {
+		logrus.Warnf("⚠️  The embedding index was built with %s, not %s - starting a new index", idx.Model, embedder.Model())
+		idx = nil
+	}
+	if idx == nil {
+		idx = embeddings.NewIndex(embedder.Model())
+	}
+	return store, idx
+}
+
+func runJobEmbed(limit int) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	embedder := newEmbedder(cfg)
+	store, idx := loadEmbeddingIndex(cfg, embedder)
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	embedded, removed, err := dataService.UpdateEmbeddings(context.Background(), embedder, idx, limit)
+	if err != nil {
+		logrus.Fatal("Embedding failed: ", err)
+	}
+	if embedded > 0 || removed > 0 {
+		if err := store.Save(idx); err != nil {
+			logrus.Fatal("Failed to save embedding index: ", err)
+		}
+	}
+
+	fmt.Printf("🎉 Embedding completed! Embedded: %d, removed: %d, jobs in index: %d (%s)\n",
+		embedded, removed, idx.Len(), idx.Model)
+}
+
+func runJobSimilar(linkedinJobID, top int) {
+	cfg := config.Load()
+	setupLogging(cfg.LogLevel)
+
+	_, idx := loadEmbeddingIndex(cfg, newEmbedder(cfg))
+	entry := idx.Get(linkedinJobID)
+	if entry == nil {
+		logrus.Fatalf("Job %d is not in the embedding index - run `job embed` first", linkedinJobID)
+	}
+
+	fmt.Printf("🔎 Jobs similar to %d: %s\n\n", linkedinJobID, entry.Title)
+	printMatches(idx.Nearest(entry.Vector, top, linkedinJobID))
+}
+
+func runJobSearch(query string, profile bool, configPath string, top int) {
+	cfg := config.Load()
+	setupLogging(cfg.LogLevel)
+
+	if profile {
+		matchConfig, err := match.LoadConfig(configPath)
+		if err != nil {
+			logrus.Fatal("Failed to load match config: ", err)
+		}
+		query = strings.TrimSpace(query + "\n" + embeddings.CandidateText(&matchConfig.Candidate))
+	}
+
+	embedder := newEmbedder(cfg)
+	_, idx := loadEmbeddingIndex(cfg, embedder)
+	if idx.Len() == 0 {
+		fmt.Println("📭 The embedding index is empty - run `job embed` first")
+		return
+	}
+
+	vectors, err := embedder.Embed(context.Background(), []string{query})
+	if err != nil {
+		logrus.Fatal("Failed to embed query: ", err)
+	}
+
+	printMatches(idx.Nearest(vectors[0], top, 0))
+}
+
+func printMatches(matches []embeddings.Match) {
+	if len(matches) == 0 {
+		fmt.Println("📭 No jobs found")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SIMILARITY\tJOB ID\tTITLE\tCOMPANY\tLOCATION")
+	for _, m := range matches {
+		fmt.Fprintf(w, "%.3f\t%d\t%s\t%s\t%s\n", m.Similarity, m.Entry.LinkedInJobID, m.Entry.Title, m.Entry.CompanyName, m.Entry.Location)
+	}
+	w.Flush()
+}
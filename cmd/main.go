@@ -6,8 +6,10 @@ import (
 	"os"
 
 	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/database"
 	"linkedin-job-scraper/internal/scraper"
 	"linkedin-job-scraper/internal/services"
+	"linkedin-job-scraper/internal/storage"
 
 	"github.com/joho/godotenv"
 	"github.com/sirupsen/logrus"
@@ -18,6 +20,12 @@ var rootCmd = &cobra.Command{
 	Use:   "linkedin-scraper",
 	Short: "LinkedIn Job Scraper CLI",
 	Long:  "A CLI tool to scrape LinkedIn job postings and store them in a database",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// config.Load reads STANDALONE, so the flag applies to every command
+		if standalone, _ := cmd.Flags().GetBool("standalone"); standalone {
+			os.Setenv("STANDALONE", "true")
+		}
+	},
 }
 
 var scrapeCmd = &cobra.Command{
@@ -73,15 +81,70 @@ var processCmd = &cobra.Command{
 	},
 }
 
+var enrichCompaniesCmd = &cobra.Command{
+	Use:   "enrich-companies",
+	Short: "Visit company LinkedIn pages to collect size, industry, website and followers",
+	Run: func(cmd *cobra.Command, args []string) {
+		limit, _ := cmd.Flags().GetInt("limit")
+		debug, _ := cmd.Flags().GetBool("debug")
+
+		if debug {
+			logrus.SetLevel(logrus.DebugLevel)
+			logrus.Info("🐛 Debug mode enabled - will show detailed enrichment data")
+		}
+
+		runCompanyEnrichment(limit)
+	},
+}
+
+var refreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Short: "Revisit stored open jobs to detect closed postings and update applicant counts",
+	Run: func(cmd *cobra.Command, args []string) {
+		olderThan, _ := cmd.Flags().GetInt("older-than")
+		limit, _ := cmd.Flags().GetInt("limit")
+		workers, _ := cmd.Flags().GetInt("workers")
+		debug, _ := cmd.Flags().GetBool("debug")
+
+		if debug {
+			logrus.SetLevel(logrus.DebugLevel)
+			logrus.Info("🐛 Debug mode enabled - will show detailed refresh data")
+		}
+
+		runRefresh(olderThan, limit, workers)
+	},
+}
+
+var detectRepostsCmd = &cobra.Command{
+	Use:   "detect-reposts",
+	Short: "Fingerprint stored jobs and link reposts to their earlier versions",
+	Run: func(cmd *cobra.Command, args []string) {
+		limit, _ := cmd.Flags().GetInt("limit")
+		debug, _ := cmd.Flags().GetBool("debug")
+
+		if debug {
+			logrus.SetLevel(logrus.DebugLevel)
+			logrus.Info("🐛 Debug mode enabled - will show detailed repost matches")
+		}
+
+		runRepostDetection(limit)
+	},
+}
+
 var migrateCmd = &cobra.Command{
 	Use:   "migrate",
 	Short: "Run database migrations",
+	Long: `With STORAGE_BACKEND=mysql, add the tables and columns the scraper writes to the schema
+created by the Laravel migrations. Run the Laravel migrations first. SQLite databases are
+migrated automatically when they are opened.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		runMigrations()
 	},
 }
 
 func init() {
+	rootCmd.PersistentFlags().Bool("standalone", false, "Run without Redis, the API and MySQL: SQLite storage at SQLITE_PATH and an in-memory cache")
+
 	// Scrape command flags (legacy)
 	scrapeCmd.Flags().StringP("keywords", "k", "", "Job search keywords (required)")
 	scrapeCmd.Flags().StringP("location", "l", "", "Job search location (required)")
@@ -104,6 +167,20 @@ func init() {
 	processCmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum number of jobs to process from queue")
 	processCmd.Flags().BoolP("debug", "d", false, "Enable debug mode")
 
+	// Enrich companies command flags
+	enrichCompaniesCmd.Flags().IntP("limit", "l", 50, "Maximum number of companies to enrich")
+	enrichCompaniesCmd.Flags().BoolP("debug", "d", false, "Enable debug mode")
+
+	// Refresh command flags
+	refreshCmd.Flags().IntP("older-than", "o", 7, "Refresh open jobs and any job posted more than this many days ago (0 = only open jobs)")
+	refreshCmd.Flags().IntP("limit", "l", 100, "Maximum number of jobs to refresh")
+	refreshCmd.Flags().IntP("workers", "w", 3, "Number of browser tabs checking jobs in parallel")
+	refreshCmd.Flags().BoolP("debug", "d", false, "Enable debug mode")
+
+	// Detect reposts command flags
+	detectRepostsCmd.Flags().IntP("limit", "l", 1000, "Maximum number of jobs to fingerprint")
+	detectRepostsCmd.Flags().BoolP("debug", "d", false, "Enable debug mode")
+
 	var clearCacheCmd = &cobra.Command{
 		Use:   "clear-cache",
 		Short: "Clear polluted job existence cache and processing queue",
@@ -123,6 +200,9 @@ func init() {
 	rootCmd.AddCommand(scrapeCmd)
 	rootCmd.AddCommand(discoverCmd)
 	rootCmd.AddCommand(processCmd)
+	rootCmd.AddCommand(enrichCompaniesCmd)
+	rootCmd.AddCommand(refreshCmd)
+	rootCmd.AddCommand(detectRepostsCmd)
 	rootCmd.AddCommand(clearCacheCmd)
 	rootCmd.AddCommand(migrateCmd)
 	rootCmd.AddCommand(clearCacheCmd)
@@ -157,7 +237,9 @@ func runScraper(keywords, location string, totalJobs int) {
 	// Start scraping
 	logrus.Infof("Starting to scrape %d jobs with keywords: %s, location: %s", totalJobs, keywords, location)
 
+	run := dataService.StartRun("scrape", fmt.Sprintf("keywords=%q location=%q total=%d", keywords, location, totalJobs))
 	err := jobScraper.ScrapeJobs(keywords, location, totalJobs)
+	dataService.FinishRun(run, err)
 	if err != nil {
 		logrus.Fatal("Scraping failed: ", err)
 	}
@@ -198,7 +280,9 @@ func runDiscovery(keywords, location string, totalJobs, startFrom int) {
 		logrus.Infof("🔍 Starting job ID discovery: %d jobs with keywords: %s, location: %s", totalJobs, keywords, location)
 	}
 
+	run := dataService.StartRun("discover", fmt.Sprintf("keywords=%q location=%q total=%d start=%d", keywords, location, totalJobs, startFrom))
 	err := jobScraper.DiscoverJobIDs(keywords, location, totalJobs, startFrom)
+	dataService.FinishRun(run, err)
 	if err != nil {
 		logrus.Fatal("Job ID discovery failed: ", err)
 	}
@@ -223,7 +307,9 @@ func runProcessing(limit int) {
 	// Start processing jobs from Redis queue
 	logrus.Infof("⚙️  Starting job processing from Redis queue (limit: %d)", limit)
 
+	run := dataService.StartRun("process", fmt.Sprintf("limit=%d", limit))
 	err := jobScraper.ProcessJobsFromQueue(limit)
+	dataService.FinishRun(run, err)
 	if err != nil {
 		logrus.Fatal("Job processing failed: ", err)
 	}
@@ -231,9 +317,94 @@ func runProcessing(limit int) {
 	logrus.Info("✅ Job processing completed successfully")
 }
 
+func runCompanyEnrichment(limit int) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	// Initialize scraper
+	jobScraper := scraper.NewLinkedInScraper(cfg, dataService)
+
+	logrus.Infof("🏢 Starting company enrichment (limit: %d)", limit)
+
+	run := dataService.StartRun("enrich-companies", fmt.Sprintf("limit=%d", limit))
+	err := jobScraper.EnrichCompanies(limit)
+	dataService.FinishRun(run, err)
+	if err != nil {
+		logrus.Fatal("Company enrichment failed: ", err)
+	}
+
+	logrus.Info("✅ Company enrichment completed successfully")
+}
+
+func runRefresh(olderThan, limit, workers int) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	// Initialize scraper
+	jobScraper := scraper.NewLinkedInScraper(cfg, dataService)
+
+	logrus.Infof("🔄 Starting job refresh (older than: %d days, limit: %d, workers: %d)", olderThan, limit, workers)
+
+	run := dataService.StartRun("refresh", fmt.Sprintf("older-than=%d limit=%d workers=%d", olderThan, limit, workers))
+	err := jobScraper.RefreshJobs(olderThan, limit, workers)
+	dataService.FinishRun(run, err)
+	if err != nil {
+		logrus.Fatal("Job refresh failed: ", err)
+	}
+
+	logrus.Info("✅ Job refresh completed successfully")
+}
+
+func runRepostDetection(limit int) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	logrus.Infof("🔁 Starting repost detection (limit: %d)", limit)
+
+	processed, reposts, err := dataService.DetectReposts(limit)
+	if err != nil {
+		logrus.Fatal("Repost detection failed: ", err)
+	}
+
+	logrus.Infof("✅ Repost detection completed: %d jobs fingerprinted, %d reposts linked", processed, reposts)
+}
+
 func runMigrations() {
-	logrus.Info("Database migrations are now handled by the Laravel API backend")
-	logrus.Info("Please run migrations on the Laravel application instead")
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	if cfg.Storage.Backend != storage.BackendMySQL {
+		logrus.Info("Database migrations are now handled by the Laravel API backend")
+		logrus.Info("Please run migrations on the Laravel application instead")
+		return
+	}
+	if err := database.MigrateMySQL(&cfg.Database); err != nil {
+		logrus.Fatal("Migration failed: ", err)
+	}
 }
 
 func setupLogging(level string) {
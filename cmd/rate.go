@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+
+	"linkedin-job-scraper/internal/addresses"
+	"linkedin-job-scraper/internal/ai"
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/match"
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/services"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var rateCmd = &cobra.Command{
+	Use:   "rate",
+	Short: "Score unrated jobs against job_match_config.json with deterministic rules or an AI model",
+	Long: `Score open jobs without a rules rating against the candidate profile in the match
+config: location and commute, skills, company size, seniority and workplace type, weighted
+by the config's weights. Each sub-score is saved with the reason for it in the rating's
+criteria, as rating_type "rules".
+
+With --ai the jobs are rated by an OpenAI-compatible model instead (OPENAI_API_KEY,
+OPENAI_BASE_URL, OPENAI_MODEL) and saved as rating_type "ai", with the model's summary,
+strengths, concerns and token usage in the criteria.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		configPath, _ := cmd.Flags().GetString("config")
+		limit, _ := cmd.Flags().GetInt("limit")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		useAI, _ := cmd.Flags().GetBool("ai")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		debug, _ := cmd.Flags().GetBool("debug")
+
+		if debug {
+			logrus.SetLevel(logrus.DebugLevel)
+			logrus.Info("🐛 Debug mode enabled - will show the reason for each score")
+		}
+
+		if useAI {
+			runRateWithAI(configPath, limit, concurrency, dryRun)
+			return
+		}
+		runRate(configPath, limit, dryRun)
+	},
+}
+
+func init() {
+	rateCmd.Flags().StringP("config", "c", match.DefaultConfigPath, "Job match config file")
+	rateCmd.Flags().IntP("limit", "l", 100, "Maximum number of jobs to rate")
+	rateCmd.Flags().Bool("dry-run", false, "Print the scores without saving them")
+	rateCmd.Flags().Bool("ai", false, "Rate with the configured AI model instead of the rules")
+	rateCmd.Flags().Int("concurrency", 0, "AI requests in flight at the same time (default AI_CONCURRENCY)")
+	rateCmd.Flags().BoolP("debug", "d", false, "Enable debug mode")
+
+	rootCmd.AddCommand(rateCmd)
+}
+
+func runRate(configPath string, limit int, dryRun bool) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	matchConfig, err := match.LoadConfig(configPath)
+	if err != nil {
+		logrus.Fatal("Failed to load match config: ", err)
+	}
+	centroids, err := addresses.LoadCentroidsFile(cfg.Addresses.CentroidsPath)
+	if err != nil {
+		logrus.Fatal("Failed to load postal centroids: ", err)
+	}
+
+	matcher := match.NewMatcher(matchConfig, centroids)
+	for _, warning := range matcher.Warnings() {
+		logrus.Warnf("⚠️  %s - location scores will not include the commute", warning)
+	}
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	ratings, err := dataService.RateJobs(matcher, limit, dryRun)
+	if err != nil {
+		logrus.Fatal("Rating failed: ", err)
+	}
+	printRatingSummary(ratings, dryRun)
+}
+
+func runRateWithAI(configPath string, limit, concurrency int, dryRun bool) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	if cfg.AI.APIKey == "" && cfg.AI.BaseURL == "" {
+		logrus.Fatal("OPENAI_API_KEY is required for AI rating (or OPENAI_BASE_URL for a local server)")
+	}
+	matchConfig, err := match.LoadConfig(configPath)
+	if err != nil {
+		logrus.Fatal("Failed to load match config: ", err)
+	}
+	if concurrency <= 0 {
+		concurrency = cfg.AI.Concurrency
+	}
+
+	provider := ai.NewOpenAIProvider(cfg.AI.APIKey, cfg.AI.BaseURL, cfg.AI.Model)
+	rater := ai.NewRater(provider, matchConfig, ai.Options{
+		MaxRetries:  ai.Retries(cfg.AI.MaxRetries),
+		Concurrency: concurrency,
+		Pricing:     ai.Pricing{InputPerMillion: cfg.AI.InputCostPerMillion, OutputPerMillion: cfg.AI.OutputCostPerMillion},
+	})
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	// Ctrl+C stops queuing jobs; ratings in flight are still saved
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	logrus.Infof("🤖 Rating with %s, %d at a time", provider.Model(), concurrency)
+	ratings, err := dataService.RateJobsWithAI(ctx, rater, limit, dryRun)
+	stats := rater.Stats()
+	fmt.Printf("\n🧾 Tokens: %d prompt + %d completion, cost ≈ $%.4f, failed: %d\n",
+		stats.Usage.PromptTokens, stats.Usage.CompletionTokens, stats.CostUSD, stats.Failed)
+	if err != nil {
+		logrus.Fatal("Rating failed: ", err)
+	}
+	if len(ratings) == 0 && stats.Failed > 0 {
+		logrus.Fatalf("None of the %d jobs could be rated", stats.Failed)
+	}
+	printRatingSummary(ratings, dryRun)
+}
+
+func printRatingSummary(ratings []models.JobRating, dryRun bool) {
+	if len(ratings) == 0 {
+		fmt.Println("📭 No unrated jobs")
+		return
+	}
+
+	sort.Slice(ratings, func(i, j int) bool { return ratings[i].OverallScore > ratings[j].OverallScore })
+	best := ratings[0]
+
+	if dryRun {
+		fmt.Printf("\n🧪 Dry run: rated %d jobs (nothing saved), best score %d\n", len(ratings), best.OverallScore)
+		return
+	}
+	fmt.Printf("\n🎉 Rating completed! Rated: %d, best score: %d\n", len(ratings), best.OverallScore)
+}
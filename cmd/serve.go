@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/services"
+	"linkedin-job-scraper/internal/web"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve a JSON API and a web UI for browsing jobs",
+	Long: `Serve the scraped jobs from the configured storage backend.
+
+The JSON API has:
+  GET /api/jobs                        jobs, newest first. Filters: skill, company, company_id,
+                                       work_type, posted_after, posted_before, min_rating,
+                                       rating_type, status (open or closed), limit, offset
+  GET /api/jobs/{linkedin_job_id}      a job with its ratings and snapshot history
+  GET /api/companies                   companies. Filters: name, limit, offset
+  GET /api/queue                       processing queue counts by status
+  GET /api/runs                        recent scrape runs, newest first. Filters: limit
+
+The web UI at / is built on the same endpoints.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		addr, _ := cmd.Flags().GetString("addr")
+		runServe(addr)
+	},
+}
+
+func init() {
+	serveCmd.Flags().StringP("addr", "a", "127.0.0.1:8090", "Address to listen on, use :8090 for all interfaces (the API has no authentication)")
+
+	rootCmd.AddCommand(serveCmd)
+}
+
+func runServe(addr string) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	// Initialize data service (Redis + API)
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           web.NewServer(dataService),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Ctrl+C stops accepting connections and lets requests in flight finish
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	logrus.Infof("🌐 Serving %s storage on %s", cfg.Storage.Backend, addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logrus.Fatal("Server failed: ", err)
+	}
+	logrus.Info("👋 Server stopped")
+}
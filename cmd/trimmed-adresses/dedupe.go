@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// rowSource yields the selected columns of each input row, or io.EOF at the end
+type rowSource func() ([]string, error)
+
+// dedupeInMemory writes every row the first time it is seen. Memory grows with the number of
+// unique rows, which is fine for extracts but not for the full register.
+func dedupeInMemory(next rowSource, writer *csv.Writer, progress *progress) error {
+	seen := make(map[string]struct{})
+	for {
+		row, err := next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		key := strings.Join(row, "\x00")
+		if _, exists := seen[key]; exists {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write record: %w", err)
+		}
+		progress.wrote()
+	}
+}
+
+// dedupePartitioned deduplicates with bounded memory: rows are first spread over partition
+// files on disk by the hash of their key, so duplicates always land in the same partition,
+// then each partition is deduplicated in memory on its own. Peak memory is roughly the unique
+// rows of the largest partition. Output is grouped by partition rather than in input order.
+func dedupePartitioned(next rowSource, writer *csv.Writer, partitions int, tmpDir string, progress *progress) error {
+	dir, err := os.MkdirTemp(tmpDir, "trimmed-adresses-")
+	if err != nil {
+		return fmt.Errorf("failed to create partition directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	paths := make([]string, partitions)
+	files := make([]*os.File, partitions)
+	buffers := make([]*bufio.Writer, partitions)
+	writers := make([]*csv.Writer, partitions)
+	// Close the partition files still open when pass 1 fails; closed files are set to nil
+	defer func() {
+		for _, file := range files {
+			if file != nil {
+				file.Close()
+			}
+		}
+	}()
+	for i := range paths {
+		paths[i] = filepath.Join(dir, fmt.Sprintf("partition-%04d.csv", i))
+		if files[i], err = os.Create(paths[i]); err != nil {
+			return fmt.Errorf("failed to create partition file: %w", err)
+		}
+		buffers[i] = bufio.NewWriterSize(files[i], 64*1024)
+		writers[i] = csv.NewWriter(buffers[i])
+	}
+
+	// Pass 1: spread rows over partitions
+	for {
+		row, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		h := fnv.New32a()
+		h.Write([]byte(strings.Join(row, "\x00")))
+		if err := writers[h.Sum32()%uint32(partitions)].Write(row); err != nil {
+			return fmt.Errorf("failed to write partition: %w", err)
+		}
+	}
+	for i := range writers {
+		writers[i].Flush()
+		if err := writers[i].Error(); err != nil {
+			return fmt.Errorf("failed to write partition: %w", err)
+		}
+		if err := buffers[i].Flush(); err != nil {
+			return fmt.Errorf("failed to write partition: %w", err)
+		}
+		err := files[i].Close()
+		files[i] = nil
+		if err != nil {
+			return fmt.Errorf("failed to close partition: %w", err)
+		}
+	}
+
+	// Pass 2: deduplicate each partition in memory
+	for i, path := range paths {
+		if err := dedupePartitionFile(path, writer, progress); err != nil {
+			return err
+		}
+		progress.partitionDone(i+1, partitions)
+	}
+	return nil
+}
+
+func dedupePartitionFile(path string, writer *csv.Writer, progress *progress) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open partition: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(bufio.NewReaderSize(file, 64*1024))
+	next := func() ([]string, error) {
+		row, err := reader.Read()
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("failed to read partition: %w", err)
+		}
+		return row, err
+	}
+
+	return dedupeInMemory(next, writer, progress)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestDedupePartitioned(t *testing.T) {
+	rows := [][]string{
+		{"Algade", "10", "4000", "Roskilde"},
+		{"Algade", "12", "4000", "Roskilde"},
+		{"Algade", "10", "4000", "Roskilde"},
+		{"Vester, gade", "1", "1620", "København V"},
+		{"Algade", "12", "4000", "Roskilde"},
+	}
+
+	for _, partitions := range []int{1, 3} {
+		i := 0
+		next := func() ([]string, error) {
+			if i == len(rows) {
+				return nil, io.EOF
+			}
+			i++
+			return rows[i-1], nil
+		}
+
+		var buf strings.Builder
+		writer := csv.NewWriter(&buf)
+		progress := newProgress(0, nil)
+
+		var err error
+		if partitions == 1 {
+			err = dedupeInMemory(next, writer, progress)
+		} else {
+			err = dedupePartitioned(next, writer, partitions, t.TempDir(), progress)
+		}
+		if err != nil {
+			t.Fatalf("dedupe with %d partitions failed: %v", partitions, err)
+		}
+		writer.Flush()
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		sort.Strings(lines)
+		expected := []string{`"Vester, gade",1,1620,København V`, "Algade,10,4000,Roskilde", "Algade,12,4000,Roskilde"}
+		if strings.Join(lines, "|") != strings.Join(expected, "|") {
+			t.Errorf("dedupe with %d partitions wrote %q, expected %q", partitions, lines, expected)
+		}
+		if progress.written != 3 {
+			t.Errorf("dedupe with %d partitions counted %d written rows, expected 3", partitions, progress.written)
+		}
+	}
+}
+
+func TestDedupePartitionedClosesFilesOnError(t *testing.T) {
+	openFiles := func() int {
+		entries, err := os.ReadDir("/proc/self/fd")
+		if err != nil {
+			t.Skip("can't count open files without /proc")
+		}
+		return len(entries)
+	}
+	before := openFiles()
+
+	failed := errors.New("read failed")
+	next := func() ([]string, error) { return nil, failed }
+	err := dedupePartitioned(next, csv.NewWriter(io.Discard), 8, t.TempDir(), newProgress(0, nil))
+	if !errors.Is(err, failed) {
+		t.Fatalf("dedupePartitioned() error = %v, expected the read error", err)
+	}
+	if after := openFiles(); after != before {
+		t.Errorf("%d files open after a failed dedupe, expected %d", after, before)
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+)
+
+// countingReader counts the bytes read through it, for progress reporting
+type countingReader struct {
+	r     io.Reader
+	count atomic.Int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.count.Add(int64(n))
+	return n, err
+}
+
+// inputFile is an opened input, transparently decompressed when gzipped
+type inputFile struct {
+	io.Reader
+	file    *os.File
+	gz      *gzip.Reader
+	counter *countingReader
+	size    int64
+}
+
+// openInput opens path for reading. Gzip input is detected from its magic bytes, so both
+// adresser.csv.gz and a renamed download work.
+func openInput(path string) (*inputFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open input file: %w", err)
+	}
+
+	in := &inputFile{file: file, counter: &countingReader{r: file}}
+	if info, err := file.Stat(); err == nil {
+		in.size = info.Size()
+	}
+
+	buffered := bufio.NewReaderSize(in.counter, 1024*1024)
+	if magic, _ := buffered.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to decompress input file: %w", err)
+		}
+		in.gz = gz
+		in.Reader = bufio.NewReaderSize(gz, 1024*1024)
+	} else {
+		in.Reader = buffered
+	}
+	return in, nil
+}
+
+// BytesRead returns how much of the input file (compressed, if gzipped) has been read
+func (in *inputFile) BytesRead() int64 {
+	return in.counter.count.Load()
+}
+
+func (in *inputFile) Close() error {
+	if in.gz != nil {
+		in.gz.Close()
+	}
+	return in.file.Close()
+}
+
+// outputFile is a buffered output, gzip compressed when its name ends in .gz
+type outputFile struct {
+	*bufio.Writer
+	file *os.File
+	gz   *gzip.Writer
+}
+
+func createOutput(path string) (*outputFile, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output file: %w", err)
+	}
+
+	out := &outputFile{file: file}
+	if strings.HasSuffix(path, ".gz") {
+		out.gz = gzip.NewWriter(file)
+		out.Writer = bufio.NewWriterSize(out.gz, 1024*1024)
+	} else {
+		out.Writer = bufio.NewWriterSize(file, 1024*1024)
+	}
+	return out, nil
+}
+
+// Close flushes all buffers and closes the file. Its error must be checked: a failed flush
+// means a truncated output.
+func (out *outputFile) Close() error {
+	if err := out.Flush(); err != nil {
+		out.file.Close()
+		return fmt.Errorf("failed to flush output: %w", err)
+	}
+	if out.gz != nil {
+		if err := out.gz.Close(); err != nil {
+			out.file.Close()
+			return fmt.Errorf("failed to finish gzip output: %w", err)
+		}
+	}
+	return out.file.Close()
+}
+
+// defaultOutputPath derives the output name from the input: adresser.csv.gz -> adresser_deduped.csv
+// next to it, or adresser.idx for the binary index format
+func defaultOutputPath(inputPath, format string) string {
+	base := filepath.Base(inputPath)
+	base = strings.TrimSuffix(base, ".gz")
+	base = strings.TrimSuffix(base, filepath.Ext(base))
+
+	name := base + "_deduped.csv"
+	if format == formatIndex {
+		name = base + ".idx"
+	}
+	return filepath.Join(filepath.Dir(inputPath), name)
+}
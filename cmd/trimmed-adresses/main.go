@@ -1,19 +1,27 @@
 package main
 
 import (
-	"bufio"
 	"encoding/csv"
 	"flag"
+	"fmt"
 	"io"
 	"log"
 	"os"
 	"strings"
+
+	"linkedin-job-scraper/internal/addresses"
+)
+
+// Output formats
+const (
+	formatCSV   = "csv"
+	formatIndex = "index" // Binary index loaded directly by `linkedin-scraper addresses resolve`
 )
 
 // indexOf finds the index of target in slice, or -1 if not found
 func indexOf(slice []string, target string) int {
 	for i, v := range slice {
-		if v == target {
+		if strings.TrimPrefix(v, "\ufeff") == target {
 			return i
 		}
 	}
@@ -21,32 +29,47 @@ func indexOf(slice []string, target string) int {
 }
 
 func main() {
-	// Parse command-line flags for input and output paths
-	inputPath := flag.String("input", "/home/schmidt/Desktop/scrapJob/cmd/trimmed-adresses/adresser.csv", "Path to input CSV file")
-	outputPath := flag.String("output", "/home/schmidt/Desktop/scrapJob/cmd/trimmed-adresses/adresser_deduped.csv", "Path to output CSV file")
+	// Parse command-line flags
+	inputPath := flag.String("input", "", "Path to input CSV file, optionally gzipped (required)")
+	outputPath := flag.String("output", "", "Path to output file, gzipped if it ends in .gz (default: <input>_deduped.csv or <input>.idx)")
+	columnList := flag.String("columns", strings.Join(addresses.Columns, ","), "Comma separated columns to keep (csv format only)")
+	format := flag.String("format", formatCSV, "Output format: csv, or index for the binary address index")
+	partitions := flag.Int("partitions", 64, "Number of on-disk partitions used to deduplicate; 1 deduplicates in memory")
+	tmpDir := flag.String("tmp-dir", os.TempDir(), "Directory for partition files")
+	progressEvery := flag.Int64("progress-every", 1000000, "Report progress every N rows; 0 disables progress reporting")
 	flag.Parse()
 
-	// Open input file
-	inFile, err := os.Open(*inputPath)
-	if err != nil {
-		log.Fatalf("failed to open input file: %v", err)
+	if *inputPath == "" {
+		flag.Usage()
+		log.Fatal("-input is required")
+	}
+	if *format != formatCSV && *format != formatIndex {
+		log.Fatalf("unknown format %q, expected %s or %s", *format, formatCSV, formatIndex)
+	}
+	if *outputPath == "" {
+		*outputPath = defaultOutputPath(*inputPath, *format)
 	}
-	defer inFile.Close()
 
-	// Create a buffered CSV reader
-	reader := csv.NewReader(bufio.NewReaderSize(inFile, 1024*1024))
-	reader.LazyQuotes = true  // allow unescaped quotes
+	// Columns to keep. The index needs exactly the address columns.
+	columns := strings.Split(*columnList, ",")
+	for i := range columns {
+		columns[i] = strings.TrimSpace(columns[i])
+	}
+	if *format == formatIndex {
+		columns = addresses.Columns
+	}
 
-	// Create output file
-	outFile, err := os.Create(*outputPath)
+	// Open input file
+	in, err := openInput(*inputPath)
 	if err != nil {
-		log.Fatalf("failed to create output file: %v", err)
+		log.Fatal(err)
 	}
-	defer outFile.Close()
+	defer in.Close()
 
-	// Create a buffered CSV writer
-	writer := csv.NewWriter(bufio.NewWriterSize(outFile, 1024*1024))
-	defer writer.Flush()
+	// Create a CSV reader
+	reader := csv.NewReader(in)
+	reader.LazyQuotes = true // allow unescaped quotes
+	reader.ReuseRecord = true
 
 	// Read header row
 	header, err := reader.Read()
@@ -54,9 +77,6 @@ func main() {
 		log.Fatalf("failed to read header: %v", err)
 	}
 
-	// Columns to keep
-	columns := []string{"vejnavn", "husnr", "postnr", "postnrnavn"}
-
 	// Determine indices of the required columns
 	indices := make([]int, 0, len(columns))
 	for _, col := range columns {
@@ -67,43 +87,88 @@ func main() {
 		indices = append(indices, idx)
 	}
 
-	// Write trimmed header
+	progress := newProgress(*progressEvery, in)
+
+	// next yields the selected columns of the next valid row
+	next := func() ([]string, error) {
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			if err != nil {
+				log.Printf("warning: skipping line due to error: %v", err)
+				continue
+			}
+			progress.readRow()
+
+			trimmed := make([]string, len(indices))
+			for i, idx := range indices {
+				trimmed[i] = record[idx]
+			}
+			return trimmed, nil
+		}
+	}
+
+	// Create output file
+	out, err := createOutput(*outputPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *format == formatIndex {
+		err = writeIndex(next, out, progress)
+	} else {
+		err = writeCSV(next, out, columns, *partitions, *tmpDir, progress)
+	}
+	if err != nil {
+		out.Close()
+		log.Fatal(err)
+	}
+	if err := out.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	progress.summary()
+	log.Println("Deduplication complete. Output written to", *outputPath)
+}
+
+// writeCSV writes the trimmed header and every unique row
+func writeCSV(next rowSource, out io.Writer, columns []string, partitions int, tmpDir string, progress *progress) error {
+	writer := csv.NewWriter(out)
 	if err := writer.Write(columns); err != nil {
-		log.Fatalf("failed to write header: %v", err)
+		return fmt.Errorf("failed to write header: %w", err)
 	}
 
-	// Map to track seen records and skip duplicates
-	seen := make(map[string]struct{})
+	var err error
+	if partitions <= 1 {
+		err = dedupeInMemory(next, writer, progress)
+	} else {
+		err = dedupePartitioned(next, writer, partitions, tmpDir, progress)
+	}
+	if err != nil {
+		return err
+	}
 
-	// Process each record one by one
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeIndex builds the binary address index. The index deduplicates as it is built, and
+// holds one entry per unique address, the same memory the scraper needs to load it.
+func writeIndex(next rowSource, out io.Writer, progress *progress) error {
+	index := addresses.NewIndex()
 	for {
-		record, err := reader.Read()
+		row, err := next()
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			log.Printf("warning: skipping line due to error: %v", err)
-			continue
-		}
-
-		// Extract only the needed fields
-		trimmed := make([]string, len(indices))
-		for i, idx := range indices {
-			trimmed[i] = record[idx]
-		}
-
-		// Dedupe by key composed of all fields
-		key := strings.Join(trimmed, "|")
-		if _, exists := seen[key]; exists {
-			continue
-		}
-		seen[key] = struct{}{}
-
-		// Write only unique record
-		if err := writer.Write(trimmed); err != nil {
-			log.Fatalf("failed to write record: %v", err)
+			return err
 		}
+		index.Add(addresses.Address{Street: row[0], HouseNumber: row[1], PostalCode: row[2], City: row[3]})
 	}
+	progress.written = int64(index.Addresses())
 
-	log.Println("Deduplication complete. Output written to", *outputPath)
+	return index.WriteBinary(out)
 }
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"log"
+	"time"
+)
+
+// progress logs how far through the input the tool is every `every` rows
+type progress struct {
+	every   int64
+	input   *inputFile
+	read    int64
+	written int64
+	start   time.Time
+}
+
+func newProgress(every int64, input *inputFile) *progress {
+	return &progress{every: every, input: input, start: time.Now()}
+}
+
+// readRow counts an input row and reports progress every p.every rows
+func (p *progress) readRow() {
+	p.read++
+	if p.every <= 0 || p.read%p.every != 0 {
+		return
+	}
+
+	rate := float64(p.read) / time.Since(p.start).Seconds()
+	if p.input.size > 0 {
+		percent := float64(p.input.BytesRead()) / float64(p.input.size) * 100
+		log.Printf("read %d rows (%.0f%% of input, %.0f rows/s)", p.read, percent, rate)
+	} else {
+		log.Printf("read %d rows (%.0f rows/s)", p.read, rate)
+	}
+}
+
+// wrote counts a unique row written to the output
+func (p *progress) wrote() {
+	p.written++
+}
+
+// partitionDone reports the dedupe pass about every tenth of the partitions
+func (p *progress) partitionDone(done, total int) {
+	if p.every <= 0 {
+		return
+	}
+	step := max(1, total/10)
+	if done%step == 0 || done == total {
+		log.Printf("deduplicated %d/%d partitions, %d unique rows so far", done, total, p.written)
+	}
+}
+
+// summary logs the totals when the tool is done
+func (p *progress) summary() {
+	log.Printf("read %d rows, wrote %d unique rows in %s", p.read, p.written, time.Since(p.start).Round(time.Second))
+}
@@ -0,0 +1,176 @@
+package addresses
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testAddresses = `vejnavn,husnr,postnr,postnrnavn
+Vesterbrogade,12,1620,København V
+Vesterbrogade,12A,1620,København V
+H.C. Andersens Boulevard,27,1553,København V
+Algade,10,4000,Roskilde
+Algade,10,9000,Aalborg
+Algade,12,4000,Roskilde
+Søndergade,1,8000,Aarhus C
+`
+
+func loadTestIndex(t *testing.T) *Index {
+	t.Helper()
+	index, err := Load(strings.NewReader(testAddresses))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	return index
+}
+
+func TestLoad(t *testing.T) {
+	index := loadTestIndex(t)
+
+	if index.Addresses() != 7 {
+		t.Errorf("Addresses() = %d, expected 7", index.Addresses())
+	}
+	if index.PostalCodes() != 5 {
+		t.Errorf("PostalCodes() = %d, expected 5", index.PostalCodes())
+	}
+	if city, ok := index.PostalDistrict("4000"); !ok || city != "Roskilde" {
+		t.Errorf("PostalDistrict(4000) = %q, %v", city, ok)
+	}
+	if codes := index.PostalCodesForCity("københavn v"); len(codes) != 2 || codes[0] != "1553" || codes[1] != "1620" {
+		t.Errorf("PostalCodesForCity(københavn v) = %v", codes)
+	}
+
+	if _, err := Load(strings.NewReader("vejnavn,husnr\nAlgade,10\n")); err == nil {
+		t.Error("expected an error for a file without postal codes")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	index := loadTestIndex(t)
+
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"street and number", "Our office is at Vesterbrogade 12 in the heart of the city", "Vesterbrogade 12, 1620 København V"},
+		{"letter house number", "Kontor: Vesterbrogade 12 A, 1. sal", "Vesterbrogade 12A, 1620 København V"},
+		{"punctuated street name", "Find us at H.C. Andersens Boulevard 27", "H.C. Andersens Boulevard 27, 1553 København V"},
+		{"ambiguous street resolved by postal code", "Algade 10, 4000 Roskilde", "Algade 10, 4000 Roskilde"},
+		{"ambiguous street resolved by city", "Arbejdssted: Algade 10, Aalborg", "Algade 10, 9000 Aalborg"},
+		{"ambiguous street without city", "Mød op på Algade 10", ""},
+		{"unknown house number", "Søndergade 99, Aarhus C", ""},
+		{"street without number", "Vi holder til på Vesterbrogade", ""},
+		{"no address", "We are a fast-growing fintech with 120 employees", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, ok := index.Resolve(tt.text)
+			if tt.expected == "" {
+				if ok {
+					t.Errorf("Resolve(%q) = %q, expected no match", tt.text, address)
+				}
+				return
+			}
+			if !ok || address.String() != tt.expected {
+				t.Errorf("Resolve(%q) = %q, %v, expected %q", tt.text, address, ok, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildCentroids(t *testing.T) {
+	register := `id,vejnavn,husnr,postnr,postnrnavn,wgs84koordinat_bredde,wgs84koordinat_længde
+1,Algade,10,4000,Roskilde,55.64,12.08
+2,Algade,12,4000,Roskilde,55.66,12.10
+3,Søndergade,1,8000,Aarhus C,56.157,10.211
+4,Ukendt,1,8000,Aarhus C,,
+`
+
+	var buf strings.Builder
+	count, err := BuildCentroids(strings.NewReader(register), &buf)
+	if err != nil {
+		t.Fatalf("BuildCentroids failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("BuildCentroids wrote %d postal codes, expected 2", count)
+	}
+
+	centroids, err := LoadCentroids(strings.NewReader(buf.String()))
+	if err != nil {
+		t.Fatalf("LoadCentroids failed: %v", err)
+	}
+	roskilde, ok := centroids.ByCity("roskilde")
+	if !ok || roskilde.PostalCode != "4000" || roskilde.Lat != 55.65 || roskilde.Lon != 12.09 {
+		t.Errorf("ByCity(roskilde) = %+v, %v", roskilde, ok)
+	}
+
+	if DefaultCentroids().Len() == 0 {
+		t.Error("the bundled centroid table is empty")
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	index := loadTestIndex(t)
+
+	var buf bytes.Buffer
+	if err := index.WriteBinary(&buf); err != nil {
+		t.Fatalf("WriteBinary failed: %v", err)
+	}
+
+	loaded, err := LoadBinary(&buf)
+	if err != nil {
+		t.Fatalf("LoadBinary failed: %v", err)
+	}
+	if loaded.Addresses() != index.Addresses() || loaded.PostalCodes() != index.PostalCodes() {
+		t.Errorf("loaded %d addresses in %d postal codes, expected %d in %d",
+			loaded.Addresses(), loaded.PostalCodes(), index.Addresses(), index.PostalCodes())
+	}
+	if address, ok := loaded.Resolve("Algade 10, 4000 Roskilde"); !ok || address.String() != "Algade 10, 4000 Roskilde" {
+		t.Errorf("Resolve on loaded index = %q, %v", address, ok)
+	}
+
+	if _, err := LoadBinary(strings.NewReader(testAddresses)); err == nil {
+		t.Error("expected an error loading CSV as a binary index")
+	}
+}
+
+func TestLoadFileFormats(t *testing.T) {
+	dir := t.TempDir()
+
+	csvPath := filepath.Join(dir, "adresser.csv.gz")
+	file, err := os.Create(csvPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gz := gzip.NewWriter(file)
+	gz.Write([]byte(testAddresses))
+	gz.Close()
+	file.Close()
+
+	indexPath := filepath.Join(dir, "adresser.idx")
+	file, err = os.Create(indexPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := loadTestIndex(t).WriteBinary(file); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	for _, path := range []string{csvPath, indexPath} {
+		index, err := LoadFile(path)
+		if err != nil {
+			t.Errorf("LoadFile(%s) failed: %v", filepath.Base(path), err)
+			continue
+		}
+		if index.Addresses() != 7 {
+			t.Errorf("LoadFile(%s) loaded %d addresses, expected 7", filepath.Base(path), index.Addresses())
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package addresses
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// binaryMagic starts every binary index file, followed by a gob encoded binaryIndex
+const binaryMagic = "ADRIDX1\n"
+
+// binaryIndex is the on-disk form of an Index: streets are flattened and their house numbers
+// stored once per postal code as a sorted list
+type binaryIndex struct {
+	PostalCodes map[string]string
+	Streets     []binaryStreet
+}
+
+type binaryStreet struct {
+	Name         string
+	HouseNumbers map[string][]string
+}
+
+// WriteBinary writes the index in the binary format read by LoadBinary. Loading it skips CSV
+// parsing and deduplication, which makes it the fastest way to load the full register.
+func (idx *Index) WriteBinary(w io.Writer) error {
+	data := binaryIndex{PostalCodes: idx.postalCodes}
+	idx.streets.walk(func(s *street) {
+		record := binaryStreet{Name: s.name, HouseNumbers: make(map[string][]string, len(s.houseNumbers))}
+		for postalCode, houseNumbers := range s.houseNumbers {
+			numbers := make([]string, 0, len(houseNumbers))
+			for number := range houseNumbers {
+				numbers = append(numbers, number)
+			}
+			sort.Strings(numbers)
+			record.HouseNumbers[postalCode] = numbers
+		}
+		data.Streets = append(data.Streets, record)
+	})
+	sort.Slice(data.Streets, func(i, j int) bool { return data.Streets[i].Name < data.Streets[j].Name })
+
+	if _, err := io.WriteString(w, binaryMagic); err != nil {
+		return fmt.Errorf("failed to write index header: %w", err)
+	}
+	if err := gob.NewEncoder(w).Encode(&data); err != nil {
+		return fmt.Errorf("failed to write index: %w", err)
+	}
+	return nil
+}
+
+// LoadBinary reads an index written by WriteBinary
+func LoadBinary(r io.Reader) (*Index, error) {
+	magic := make([]byte, len(binaryMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != binaryMagic {
+		return nil, fmt.Errorf("not a binary address index")
+	}
+
+	var data binaryIndex
+	if err := gob.NewDecoder(r).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to read index: %w", err)
+	}
+
+	index := NewIndex()
+	for postalCode, city := range data.PostalCodes {
+		index.Add(Address{PostalCode: postalCode, City: city})
+	}
+	for _, s := range data.Streets {
+		for postalCode, numbers := range s.HouseNumbers {
+			for _, number := range numbers {
+				index.Add(Address{Street: s.Name, HouseNumber: number, PostalCode: postalCode, City: data.PostalCodes[postalCode]})
+			}
+		}
+	}
+	return index, nil
+}
+
+// walk calls fn for every street in the trie
+func (n *trieNode) walk(fn func(*street)) {
+	if n.street != nil {
+		fn(n.street)
+	}
+	for _, child := range n.children {
+		child.walk(fn)
+	}
+}
+
+// openIndexFile opens path for reading, decompressing it when it ends in .gz, and reports
+// whether it holds a binary index rather than CSV
+func openIndexFile(path string) (io.Reader, io.Closer, bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to open address file: %w", err)
+	}
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			file.Close()
+			return nil, nil, false, fmt.Errorf("failed to decompress address file: %w", err)
+		}
+		reader = gz
+	}
+
+	buffered := bufio.NewReaderSize(reader, 1024*1024)
+	magic, _ := buffered.Peek(len(binaryMagic))
+	return buffered, file, bytes.Equal(magic, []byte(binaryMagic)), nil
+}
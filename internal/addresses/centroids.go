@@ -0,0 +1,198 @@
+package addresses
+
+import (
+	"bufio"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// bundledCentroids is a starter table of the larger Danish postal codes. The full table is
+// built from the address register with BuildCentroids (see `addresses centroids`).
+//
+//go:embed postal_centroids.csv
+var bundledCentroids string
+
+// Point is a WGS84 coordinate
+type Point struct {
+	Lat float64
+	Lon float64
+}
+
+// Centroid is the mean position of all addresses in a postal code
+type Centroid struct {
+	PostalCode string
+	City       string
+	Point
+}
+
+// Centroids maps postal codes and district names to their centroids
+type Centroids struct {
+	byCode map[string]Centroid
+	byCity map[string]Centroid
+}
+
+// DefaultCentroids returns the bundled centroid table
+func DefaultCentroids() *Centroids {
+	centroids, err := LoadCentroids(strings.NewReader(bundledCentroids))
+	if err != nil {
+		panic(fmt.Sprintf("bundled postal centroids are invalid: %v", err))
+	}
+	return centroids
+}
+
+// LoadCentroidsFile reads a centroid CSV file, or returns the bundled table when path is empty
+func LoadCentroidsFile(path string) (*Centroids, error) {
+	if path == "" {
+		return DefaultCentroids(), nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open centroid file: %w", err)
+	}
+	defer file.Close()
+
+	return LoadCentroids(bufio.NewReader(file))
+}
+
+// LoadCentroids reads a postnr,postnrnavn,lat,lon CSV with a header row
+func LoadCentroids(r io.Reader) (*Centroids, error) {
+	reader := csv.NewReader(r)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read centroids: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("centroid file is empty")
+	}
+
+	centroids := &Centroids{byCode: make(map[string]Centroid), byCity: make(map[string]Centroid)}
+	for _, record := range records[1:] {
+		if len(record) < 4 {
+			return nil, fmt.Errorf("centroid row %v has %d columns, expected 4", record, len(record))
+		}
+		lat, err := strconv.ParseFloat(record[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid latitude for %s: %w", record[0], err)
+		}
+		lon, err := strconv.ParseFloat(record[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid longitude for %s: %w", record[0], err)
+		}
+		centroids.add(Centroid{PostalCode: record[0], City: record[1], Point: Point{Lat: lat, Lon: lon}})
+	}
+
+	return centroids, nil
+}
+
+func (c *Centroids) add(centroid Centroid) {
+	c.byCode[centroid.PostalCode] = centroid
+
+	// A district name spanning several postal codes ("København V") keeps its lowest code
+	cityKey := strings.Join(tokenize(centroid.City), " ")
+	if existing, ok := c.byCity[cityKey]; !ok || centroid.PostalCode < existing.PostalCode {
+		c.byCity[cityKey] = centroid
+	}
+}
+
+// Len returns the number of postal codes in the table
+func (c *Centroids) Len() int {
+	return len(c.byCode)
+}
+
+// ByPostalCode returns the centroid of a postal code
+func (c *Centroids) ByPostalCode(postalCode string) (Centroid, bool) {
+	centroid, ok := c.byCode[strings.TrimSpace(postalCode)]
+	return centroid, ok
+}
+
+// ByCity returns the centroid of a postal district name, e.g. "Roskilde" or "københavn v"
+func (c *Centroids) ByCity(city string) (Centroid, bool) {
+	centroid, ok := c.byCity[strings.Join(tokenize(city), " ")]
+	return centroid, ok
+}
+
+// BuildCentroids averages the WGS84 coordinates of every address in the full register CSV
+// (columns postnr, postnrnavn, wgs84koordinat_bredde, wgs84koordinat_længde) per postal code
+// and writes a centroid table readable by LoadCentroids
+func BuildCentroids(r io.Reader, w io.Writer) (int, error) {
+	reader := csv.NewReader(r)
+	reader.LazyQuotes = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := []string{"postnr", "postnrnavn", "wgs84koordinat_bredde", "wgs84koordinat_længde"}
+	indices, err := columnIndices(header, columns)
+	if err != nil {
+		return 0, err
+	}
+
+	type sum struct {
+		city     string
+		lat, lon float64
+		count    int
+	}
+	sums := make(map[string]*sum)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read address: %w", err)
+		}
+
+		lat, latErr := strconv.ParseFloat(record[indices[2]], 64)
+		lon, lonErr := strconv.ParseFloat(record[indices[3]], 64)
+		if latErr != nil || lonErr != nil {
+			continue // Addresses without coordinates don't move the centroid
+		}
+
+		postalCode := record[indices[0]]
+		s, ok := sums[postalCode]
+		if !ok {
+			s = &sum{city: record[indices[1]]}
+			sums[postalCode] = s
+		}
+		s.lat += lat
+		s.lon += lon
+		s.count++
+	}
+
+	postalCodes := make([]string, 0, len(sums))
+	for postalCode := range sums {
+		postalCodes = append(postalCodes, postalCode)
+	}
+	sort.Strings(postalCodes)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"postnr", "postnrnavn", "lat", "lon"}); err != nil {
+		return 0, fmt.Errorf("failed to write header: %w", err)
+	}
+	for _, postalCode := range postalCodes {
+		s := sums[postalCode]
+		err := writer.Write([]string{
+			postalCode,
+			s.city,
+			strconv.FormatFloat(s.lat/float64(s.count), 'f', 4, 64),
+			strconv.FormatFloat(s.lon/float64(s.count), 'f', 4, 64),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to write centroid: %w", err)
+		}
+	}
+	writer.Flush()
+
+	return len(postalCodes), writer.Error()
+}
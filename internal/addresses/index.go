@@ -0,0 +1,214 @@
+// Package addresses resolves Danish street addresses in free text against an offline copy
+// of the address register, as produced by cmd/trimmed-adresses.
+package addresses
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Columns are the CSV columns the index is built from, in the order cmd/trimmed-adresses writes them
+var Columns = []string{"vejnavn", "husnr", "postnr", "postnrnavn"}
+
+// Address is a resolved Danish address
+type Address struct {
+	Street      string
+	HouseNumber string
+	PostalCode  string
+	City        string
+}
+
+// String formats the address the way Danish addresses are written, e.g. "Vesterbrogade 12, 1620 København V"
+func (a Address) String() string {
+	if a.Street == "" {
+		return fmt.Sprintf("%s %s", a.PostalCode, a.City)
+	}
+	return fmt.Sprintf("%s %s, %s %s", a.Street, a.HouseNumber, a.PostalCode, a.City)
+}
+
+// street is one street name and the house numbers it has in each postal code
+type street struct {
+	name         string
+	houseNumbers map[string]map[string]struct{} // postal code -> normalised house numbers
+}
+
+// trieNode is a node in the word trie of street names: "h c andersens boulevard" is four levels deep
+type trieNode struct {
+	children map[string]*trieNode
+	street   *street
+}
+
+// Index is the searchable address register: a postal-code map and a word trie of street names
+type Index struct {
+	postalCodes map[string]string // postal code -> postal district name
+	cityCodes   map[string][]string
+	streets     trieNode
+	addresses   int
+}
+
+// NewIndex returns an empty index
+func NewIndex() *Index {
+	return &Index{
+		postalCodes: make(map[string]string),
+		cityCodes:   make(map[string][]string),
+	}
+}
+
+// LoadFile reads an address file into a new index: a CSV as written by cmd/trimmed-adresses
+// or a binary index written with its -format index option, optionally gzip compressed (.gz)
+func LoadFile(path string) (*Index, error) {
+	reader, closer, binary, err := openIndexFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	if binary {
+		return LoadBinary(reader)
+	}
+	return Load(reader)
+}
+
+// Load reads a vejnavn,husnr,postnr,postnrnavn CSV with a header row into a new index.
+// Extra columns are ignored, so the untrimmed register loads too.
+func Load(r io.Reader) (*Index, error) {
+	reader := csv.NewReader(r)
+	reader.LazyQuotes = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	indices, err := columnIndices(header, Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	index := NewIndex()
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read address: %w", err)
+		}
+
+		index.Add(Address{
+			Street:      record[indices[0]],
+			HouseNumber: record[indices[1]],
+			PostalCode:  record[indices[2]],
+			City:        record[indices[3]],
+		})
+	}
+
+	return index, nil
+}
+
+// columnIndices returns the position of each of columns in a CSV header. The register's
+// header may start with a byte order mark.
+func columnIndices(header, columns []string) ([]int, error) {
+	indices := make([]int, len(columns))
+	for i, column := range columns {
+		indices[i] = -1
+		for j, name := range header {
+			if strings.TrimPrefix(strings.TrimSpace(name), "\ufeff") == column {
+				indices[i] = j
+			}
+		}
+		if indices[i] == -1 {
+			return nil, fmt.Errorf("column %s not found in header", column)
+		}
+	}
+	return indices, nil
+}
+
+// Add adds one address to the index
+func (idx *Index) Add(address Address) {
+	postalCode := strings.TrimSpace(address.PostalCode)
+	if postalCode == "" {
+		return
+	}
+
+	if _, ok := idx.postalCodes[postalCode]; !ok {
+		city := strings.TrimSpace(address.City)
+		idx.postalCodes[postalCode] = city
+		cityKey := strings.Join(tokenize(city), " ")
+		idx.cityCodes[cityKey] = append(idx.cityCodes[cityKey], postalCode)
+	}
+
+	words := tokenize(address.Street)
+	if len(words) == 0 {
+		return
+	}
+
+	node := &idx.streets
+	for _, word := range words {
+		if node.children == nil {
+			node.children = make(map[string]*trieNode)
+		}
+		child, ok := node.children[word]
+		if !ok {
+			child = &trieNode{}
+			node.children[word] = child
+		}
+		node = child
+	}
+	if node.street == nil {
+		node.street = &street{name: strings.TrimSpace(address.Street), houseNumbers: make(map[string]map[string]struct{})}
+	}
+
+	houseNumbers, ok := node.street.houseNumbers[postalCode]
+	if !ok {
+		houseNumbers = make(map[string]struct{})
+		node.street.houseNumbers[postalCode] = houseNumbers
+	}
+	houseNumber := normaliseHouseNumber(address.HouseNumber)
+	if _, exists := houseNumbers[houseNumber]; !exists {
+		houseNumbers[houseNumber] = struct{}{}
+		idx.addresses++
+	}
+}
+
+// PostalCodes returns the number of postal codes in the index
+func (idx *Index) PostalCodes() int {
+	return len(idx.postalCodes)
+}
+
+// Addresses returns the number of unique addresses in the index
+func (idx *Index) Addresses() int {
+	return idx.addresses
+}
+
+// PostalDistrict returns the district name of a postal code, e.g. "1620" -> "København V"
+func (idx *Index) PostalDistrict(postalCode string) (string, bool) {
+	city, ok := idx.postalCodes[postalCode]
+	return city, ok
+}
+
+// PostalCodesForCity returns the postal codes of a district name, e.g. "Roskilde" -> ["4000"]
+func (idx *Index) PostalCodesForCity(city string) []string {
+	codes := idx.cityCodes[strings.Join(tokenize(city), " ")]
+	sorted := append([]string(nil), codes...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// tokenize splits text into lowercase words of letters and digits, so "H.C. Andersens Boulevard"
+// and "h c andersens boulevard" give the same words
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// normaliseHouseNumber makes "12 A", "12a" and "12A" equal
+func normaliseHouseNumber(houseNumber string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(houseNumber), ""))
+}
@@ -0,0 +1,158 @@
+package addresses
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// maxCityWords is the longest postal district name looked for in text, e.g. "København V" or "Frederiksberg C"
+const maxCityWords = 3
+
+// streetMatch is a street name followed by a house number found in text
+type streetMatch struct {
+	street      *street
+	houseNumber string
+}
+
+// Resolve finds the first street address in text that exists in the register, e.g.
+// "Our office at Vesterbrogade 12, København V" -> Vesterbrogade 12, 1620 København V.
+// A street and house number found in several postal codes is only resolved when the text
+// names one of them, by postal code or district name; otherwise Resolve reports no match
+// rather than guessing.
+func (idx *Index) Resolve(text string) (Address, bool) {
+	words := tokenize(text)
+	mentioned := idx.mentionedPostalCodes(words)
+
+	for _, match := range idx.findStreets(words) {
+		postalCodes := match.postalCodes()
+		if postalCode, ok := choosePostalCode(postalCodes, mentioned); ok {
+			return Address{
+				Street:      match.street.name,
+				HouseNumber: match.houseNumber,
+				PostalCode:  postalCode,
+				City:        idx.postalCodes[postalCode],
+			}, true
+		}
+	}
+
+	return Address{}, false
+}
+
+// ResolveFirst resolves the first of texts containing a known address, e.g. a job's
+// location line before its description
+func (idx *Index) ResolveFirst(texts ...string) (Address, bool) {
+	for _, text := range texts {
+		if address, ok := idx.Resolve(text); ok {
+			return address, true
+		}
+	}
+	return Address{}, false
+}
+
+// findStreets returns the longest street name at each word position that is followed by a
+// house number the street has, in text order
+func (idx *Index) findStreets(words []string) []streetMatch {
+	var matches []streetMatch
+
+	for start := range words {
+		var longest *street
+		end := start
+
+		node := &idx.streets
+		for i := start; i < len(words); i++ {
+			next, ok := node.children[words[i]]
+			if !ok {
+				break
+			}
+			node = next
+			if node.street != nil {
+				longest, end = node.street, i+1
+			}
+		}
+
+		if longest == nil || end >= len(words) {
+			continue
+		}
+		if houseNumber, ok := houseNumberAt(longest, words, end); ok {
+			matches = append(matches, streetMatch{street: longest, houseNumber: houseNumber})
+		}
+	}
+
+	return matches
+}
+
+// houseNumberAt reads the house number starting at words[i], joining "12 a" to "12A" when
+// the street has that number
+func houseNumberAt(s *street, words []string, i int) (string, bool) {
+	if !startsWithDigit(words[i]) {
+		return "", false
+	}
+
+	candidates := []string{normaliseHouseNumber(words[i])}
+	if i+1 < len(words) && len(words[i+1]) == 1 && unicode.IsLetter(rune(words[i+1][0])) {
+		candidates = append([]string{normaliseHouseNumber(words[i] + words[i+1])}, candidates...)
+	}
+
+	for _, candidate := range candidates {
+		for _, houseNumbers := range s.houseNumbers {
+			if _, ok := houseNumbers[candidate]; ok {
+				return candidate, true
+			}
+		}
+	}
+	return "", false
+}
+
+// postalCodes returns the sorted postal codes in which the street has the matched house number
+func (m streetMatch) postalCodes() []string {
+	var codes []string
+	for postalCode, houseNumbers := range m.street.houseNumbers {
+		if _, ok := houseNumbers[m.houseNumber]; ok {
+			codes = append(codes, postalCode)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// mentionedPostalCodes returns the postal codes named in text, by number or district name
+func (idx *Index) mentionedPostalCodes(words []string) map[string]bool {
+	mentioned := make(map[string]bool)
+
+	for start := range words {
+		if _, ok := idx.postalCodes[words[start]]; ok {
+			mentioned[words[start]] = true
+		}
+		for n := 1; n <= maxCityWords && start+n <= len(words); n++ {
+			for _, postalCode := range idx.cityCodes[strings.Join(words[start:start+n], " ")] {
+				mentioned[postalCode] = true
+			}
+		}
+	}
+
+	return mentioned
+}
+
+// choosePostalCode picks the postal code of a matched address: the only candidate, or the
+// only candidate mentioned in the text
+func choosePostalCode(candidates []string, mentioned map[string]bool) (string, bool) {
+	if len(candidates) == 1 {
+		return candidates[0], true
+	}
+
+	var chosen []string
+	for _, postalCode := range candidates {
+		if mentioned[postalCode] {
+			chosen = append(chosen, postalCode)
+		}
+	}
+	if len(chosen) == 1 {
+		return chosen[0], true
+	}
+	return "", false
+}
+
+func startsWithDigit(word string) bool {
+	return word != "" && word[0] >= '0' && word[0] <= '9'
+}
@@ -0,0 +1,224 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/match"
+	"linkedin-job-scraper/internal/models"
+)
+
+const validRating = `{"overall_score": 82, "location_score": 90, "tech_score": 85, "team_size_score": null, "leadership_score": 70, "summary": "Good fit", "strengths": ["PHP"], "concerns": []}`
+
+func testConfig() *match.Config {
+	return &match.Config{
+		Candidate: match.Candidate{Name: "Senior Developer", PrimarySkills: []string{"PHP", "Laravel"}},
+		Weights:   match.Weights{Location: 25, TechMatch: 35, CompanyFit: 20, SeniorityFit: 15, WorkTypeFit: 5},
+	}
+}
+
+func testJob(id int) Job {
+	return Job{Posting: &models.JobPosting{JobID: id, Title: "Senior PHP Developer", Location: "Roskilde", Description: "Laravel and AWS"}}
+}
+
+func TestParseRating(t *testing.T) {
+	rating, err := ParseRating("```json\n" + validRating + "\n```")
+	if err != nil {
+		t.Fatalf("ParseRating() error = %v", err)
+	}
+	if rating.OverallScore != 82 || *rating.TechScore != 85 || rating.TeamSizeScore != nil || rating.RatingType != RatingType {
+		t.Errorf("ParseRating() = %+v", rating)
+	}
+	if (*rating.Criteria)["summary"] != "Good fit" {
+		t.Errorf("criteria = %v", *rating.Criteria)
+	}
+
+	invalid := []string{
+		``,
+		`not json`,
+		`{"overall_score": 82, "mood": "great"}`,
+		`{"tech_score": 50}`,
+		`{"overall_score": 120}`,
+		`{"overall_score": "high"}`,
+		`{"overall_score": 50} {"overall_score": 60}`,
+	}
+	for _, content := range invalid {
+		if _, err := ParseRating(content); err == nil || !retryable(err) {
+			t.Errorf("ParseRating(%q) error = %v, want retryable invalid response", content, err)
+		}
+	}
+}
+
+// stubServer answers chat completions with the given status codes and contents in turn
+func stubServer(t *testing.T, replies []struct {
+	status  int
+	content string
+}) (*httptest.Server, *[]map[string]interface{}) {
+	var mu sync.Mutex
+	var requests []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, body)
+
+		reply := replies[min(len(requests), len(replies))-1]
+		w.Header().Set("Content-Type", "application/json")
+		if reply.status != http.StatusOK {
+			w.WriteHeader(reply.status)
+			fmt.Fprintf(w, `{"error": {"message": %q, "type": "server_error"}}`, reply.content)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":      "chatcmpl-test",
+			"object":  "chat.completion",
+			"choices": []map[string]interface{}{{"index": 0, "message": map[string]string{"role": "assistant", "content": reply.content}}},
+			"usage":   map[string]int{"prompt_tokens": 1000, "completion_tokens": 100, "total_tokens": 1100},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestRaterRetriesAgainstStubServer(t *testing.T) {
+	server, requests := stubServer(t, []struct {
+		status  int
+		content string
+	}{
+		{http.StatusInternalServerError, "overloaded"},
+		{http.StatusOK, `{"overall_score": 82, "extra": true}`},
+		{http.StatusOK, validRating},
+	})
+
+	provider := NewOpenAIProvider("test-key", server.URL+"/v1", "test-model")
+	rater := NewRater(provider, testConfig(), Options{
+		RetryDelay: time.Millisecond,
+		Pricing:    Pricing{InputPerMillion: 0.15, OutputPerMillion: 0.60},
+	})
+
+	result := rater.Rate(context.Background(), testJob(7))
+	if result.Err != nil {
+		t.Fatalf("Rate() error = %v", result.Err)
+	}
+	if result.Attempts != 3 || result.Rating.JobID != 7 || result.Rating.OverallScore != 82 {
+		t.Errorf("Rate() = %+v, rating %+v", result, result.Rating)
+	}
+	if (*result.Rating.Criteria)["model"] != "test-model" {
+		t.Errorf("criteria = %v", *result.Rating.Criteria)
+	}
+
+	// The failed 500 has no usage; both completions count
+	stats := rater.Stats()
+	if stats.Rated != 1 || stats.Usage != (Usage{PromptTokens: 2000, CompletionTokens: 200}) {
+		t.Errorf("Stats() = %+v", stats)
+	}
+	if want := 2000*0.15/1e6 + 200*0.60/1e6; stats.CostUSD < want-1e-12 || stats.CostUSD > want+1e-12 {
+		t.Errorf("CostUSD = %v, want %v", stats.CostUSD, want)
+	}
+
+	body := (*requests)[0]
+	if body["model"] != "test-model" || body["response_format"].(map[string]interface{})["type"] != "json_object" {
+		t.Errorf("request = %v", body)
+	}
+	prompt := body["messages"].([]interface{})[1].(map[string]interface{})["content"].(string)
+	for _, want := range []string{"Senior PHP Developer", `"primary_skills"`, `"tech_match": 35`, "Laravel and AWS"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("prompt does not contain %q:\n%s", want, prompt)
+		}
+	}
+}
+
+func TestRaterDoesNotRetryClientErrors(t *testing.T) {
+	server, requests := stubServer(t, []struct {
+		status  int
+		content string
+	}{
+		{http.StatusUnauthorized, "invalid api key"},
+	})
+
+	rater := NewRater(NewOpenAIProvider("bad-key", server.URL+"/v1", ""), testConfig(), Options{RetryDelay: time.Millisecond})
+	result := rater.Rate(context.Background(), testJob(1))
+	if result.Err == nil || result.Attempts != 1 || len(*requests) != 1 {
+		t.Errorf("Rate() = %+v, %d requests", result, len(*requests))
+	}
+	if stats := rater.Stats(); stats.Failed != 1 || stats.Rated != 0 {
+		t.Errorf("Stats() = %+v", stats)
+	}
+}
+
+func TestRaterZeroMaxRetries(t *testing.T) {
+	server, requests := stubServer(t, []struct {
+		status  int
+		content string
+	}{
+		{http.StatusInternalServerError, "overloaded"},
+		{http.StatusOK, validRating},
+	})
+
+	rater := NewRater(NewOpenAIProvider("test-key", server.URL+"/v1", ""), testConfig(), Options{MaxRetries: Retries(0), RetryDelay: time.Millisecond})
+	result := rater.Rate(context.Background(), testJob(1))
+	if result.Err == nil || result.Attempts != 1 || len(*requests) != 1 {
+		t.Errorf("Rate() = %+v, %d requests, want one attempt without retries", result, len(*requests))
+	}
+}
+
+// slowProvider records the most requests it has seen in flight at once
+type slowProvider struct {
+	inFlight, maxInFlight int32
+}
+
+func (p *slowProvider) Model() string { return "slow" }
+
+func (p *slowProvider) Complete(ctx context.Context, req Request) (*Completion, error) {
+	n := atomic.AddInt32(&p.inFlight, 1)
+	defer atomic.AddInt32(&p.inFlight, -1)
+	for {
+		max := atomic.LoadInt32(&p.maxInFlight)
+		if n <= max || atomic.CompareAndSwapInt32(&p.maxInFlight, max, n) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	return &Completion{Content: validRating, Usage: Usage{PromptTokens: 10, CompletionTokens: 1}}, nil
+}
+
+func TestRateAllLimitsConcurrency(t *testing.T) {
+	provider := &slowProvider{}
+	rater := NewRater(provider, testConfig(), Options{Concurrency: 3})
+
+	var jobs []Job
+	for i := 1; i <= 12; i++ {
+		jobs = append(jobs, testJob(i))
+	}
+
+	seen := map[int]bool{}
+	rater.RateAll(context.Background(), jobs, func(result Result) {
+		if result.Err != nil {
+			t.Errorf("job %d: %v", result.Job.Posting.JobID, result.Err)
+			return
+		}
+		seen[result.Rating.JobID] = true
+	})
+
+	if len(seen) != len(jobs) {
+		t.Errorf("rated %d jobs, want %d", len(seen), len(jobs))
+	}
+	if provider.maxInFlight > 3 || provider.maxInFlight < 2 {
+		t.Errorf("max in flight = %d, want 2-3", provider.maxInFlight)
+	}
+	if stats := rater.Stats(); stats.Rated != 12 || stats.Usage.Total() != 132 {
+		t.Errorf("Stats() = %+v", stats)
+	}
+}
@@ -0,0 +1,84 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// DefaultModel is used when no model is configured
+const DefaultModel = openai.GPT4oMini
+
+// OpenAIProvider calls an OpenAI-compatible chat completions API
+type OpenAIProvider struct {
+	client *openai.Client
+	model  string
+}
+
+// NewOpenAIProvider creates a provider. baseURL may be empty for api.openai.com, or point
+// at any compatible server, e.g. "http://localhost:11434/v1".
+func NewOpenAIProvider(apiKey, baseURL, model string) *OpenAIProvider {
+	clientConfig := openai.DefaultConfig(apiKey)
+	if baseURL != "" {
+		clientConfig.BaseURL = baseURL
+	}
+	if model == "" {
+		model = DefaultModel
+	}
+	return &OpenAIProvider{client: openai.NewClientWithConfig(clientConfig), model: model}
+}
+
+// Model returns the model name sent with each request
+func (p *OpenAIProvider) Model() string {
+	return p.model
+}
+
+// Complete sends one chat completion request
+func (p *OpenAIProvider) Complete(ctx context.Context, req Request) (*Completion, error) {
+	chatReq := openai.ChatCompletionRequest{
+		Model: p.model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: req.System},
+			{Role: openai.ChatMessageRoleUser, Content: req.Prompt},
+		},
+		MaxTokens: req.MaxTokens,
+	}
+	if req.JSON {
+		chatReq.ResponseFormat = &openai.ChatCompletionResponseFormat{Type: openai.ChatCompletionResponseFormatTypeJSONObject}
+	}
+
+	resp, err := p.client.CreateChatCompletion(ctx, chatReq)
+	if err != nil {
+		return nil, wrapOpenAIError(err)
+	}
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("%w: no choices in response", errInvalidResponse)
+	}
+
+	return &Completion{
+		Content: resp.Choices[0].Message.Content,
+		Usage: Usage{
+			PromptTokens:     resp.Usage.PromptTokens,
+			CompletionTokens: resp.Usage.CompletionTokens,
+		},
+	}, nil
+}
+
+// wrapOpenAIError converts go-openai's errors to a StatusError so retries don't depend on
+// the client library
+func wrapOpenAIError(err error) error {
+	var apiErr *openai.APIError
+	if errors.As(err, &apiErr) {
+		return &StatusError{StatusCode: apiErr.HTTPStatusCode, Err: err}
+	}
+	var reqErr *openai.RequestError
+	if errors.As(err, &reqErr) {
+		return &StatusError{StatusCode: reqErr.HTTPStatusCode, Err: err}
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	return &StatusError{Err: err}
+}
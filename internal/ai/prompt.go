@@ -0,0 +1,93 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"linkedin-job-scraper/internal/match"
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/utils"
+)
+
+// maxDescriptionChars keeps long descriptions from dominating the prompt's token cost
+const maxDescriptionChars = 6000
+
+const ratingSystemPrompt = `You rate how well a job posting fits a candidate.
+Score each aspect from 0 (no fit) to 100 (perfect fit), using the candidate's weights for the overall score:
+- location_score: commute and workplace type against the candidate's work preferences
+- tech_score: overlap between the job's technologies and the candidate's skills
+- team_size_score: the company size against the candidate's preferred company size
+- leadership_score: the role's seniority and responsibilities against the preferred and avoided roles
+Use null for a score you cannot judge from the posting.
+Respond with a single JSON object and nothing else, exactly in this form:
+{"overall_score": 0, "location_score": 0, "tech_score": 0, "team_size_score": 0, "leadership_score": 0, "summary": "one sentence", "strengths": ["..."], "concerns": ["..."]}`
+
+// BuildRatingPrompt returns the system prompt and the user prompt rating job for the
+// candidate in cfg. company may be nil.
+func BuildRatingPrompt(cfg *match.Config, job *models.JobPosting, company *models.Company) (system, prompt string, err error) {
+	profile, err := json.MarshalIndent(struct {
+		Candidate match.Candidate `json:"candidate"`
+		Weights   match.Weights   `json:"weights"`
+	}{cfg.Candidate, cfg.Weights}, "", "  ")
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode candidate profile: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("Candidate profile:\n")
+	b.Write(profile)
+	b.WriteString("\n\nJob posting:\n")
+	writeField(&b, "Title", job.Title)
+	writeField(&b, "Company", job.CompanyName)
+	writeField(&b, "Location", job.Location)
+	writeField(&b, "Work type", firstOf(job.WorkType, job.WorkplaceType))
+	writeField(&b, "Seniority level", firstOf(job.SeniorityLevel))
+	writeField(&b, "Employment type", firstOf(job.EmploymentType))
+	if job.Skills != nil {
+		writeField(&b, "Skills", strings.Join(*job.Skills, ", "))
+	}
+	if job.SalaryAnnualMinDKK != nil || job.SalaryAnnualMaxDKK != nil {
+		writeField(&b, "Yearly salary (DKK)", salaryRange(job.SalaryAnnualMinDKK, job.SalaryAnnualMaxDKK))
+	}
+
+	if company != nil {
+		b.WriteString("\nCompany:\n")
+		writeField(&b, "Name", company.Name)
+		writeField(&b, "Employees", firstOf(company.EmployeeRange))
+		writeField(&b, "Industry", firstOf(company.Industry))
+		writeField(&b, "Headquarters", firstOf(company.Headquarters))
+	}
+
+	b.WriteString("\nDescription:\n")
+	b.WriteString(utils.Truncate(strings.TrimSpace(job.Description), maxDescriptionChars, " …"))
+	b.WriteString("\n")
+
+	return ratingSystemPrompt, b.String(), nil
+}
+
+func writeField(b *strings.Builder, name, value string) {
+	if value = strings.TrimSpace(value); value != "" {
+		fmt.Fprintf(b, "%s: %s\n", name, value)
+	}
+}
+
+func firstOf(values ...*string) string {
+	for _, v := range values {
+		if v != nil && *v != "" {
+			return *v
+		}
+	}
+	return ""
+}
+
+func salaryRange(min, max *int) string {
+	switch {
+	case min != nil && max != nil && *min != *max:
+		return fmt.Sprintf("%d-%d", *min, *max)
+	case min != nil:
+		return fmt.Sprintf("%d", *min)
+	default:
+		return fmt.Sprintf("up to %d", *max)
+	}
+}
@@ -0,0 +1,82 @@
+// Package ai rates job postings with a large language model behind an OpenAI-compatible
+// chat completions API.
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Provider completes a chat prompt. OpenAIProvider is the production implementation;
+// anything speaking the same protocol (Azure, a local server, a test stub) works through it.
+type Provider interface {
+	Complete(ctx context.Context, req Request) (*Completion, error)
+	Model() string
+}
+
+// Request is one prompt
+type Request struct {
+	System    string
+	Prompt    string
+	MaxTokens int
+	JSON      bool // Ask for a JSON object response
+}
+
+// Completion is the model's answer and what it cost
+type Completion struct {
+	Content string
+	Usage   Usage
+}
+
+// Usage counts tokens
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+// Add returns the sum of two usages
+func (u Usage) Add(other Usage) Usage {
+	return Usage{
+		PromptTokens:     u.PromptTokens + other.PromptTokens,
+		CompletionTokens: u.CompletionTokens + other.CompletionTokens,
+	}
+}
+
+// Total is the number of prompt and completion tokens
+func (u Usage) Total() int {
+	return u.PromptTokens + u.CompletionTokens
+}
+
+// StatusError is a failed API call. StatusCode is 0 when no response was received.
+type StatusError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *StatusError) Error() string {
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("request failed: %v", e.Err)
+	}
+	return fmt.Sprintf("API error (status %d): %v", e.StatusCode, e.Err)
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// retryable reports whether a failed call may succeed if repeated: network errors, rate
+// limits and server errors. Invalid requests and authentication errors are not retried.
+func retryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		return errors.Is(err, errInvalidResponse)
+	}
+	return statusErr.StatusCode == 0 ||
+		statusErr.StatusCode == http.StatusTooManyRequests ||
+		statusErr.StatusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,216 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"linkedin-job-scraper/internal/match"
+	"linkedin-job-scraper/internal/models"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Options control retries, parallelism and cost accounting
+type Options struct {
+	MaxRetries  *int          // Extra attempts after a retryable failure, nil for the default
+	RetryDelay  time.Duration // Delay before the first retry, doubled for each further retry
+	Concurrency int           // Jobs rated at the same time
+	MaxTokens   int           // Completion token limit per rating
+	Pricing     Pricing
+}
+
+// DefaultOptions are used for zero fields in the options passed to NewRater
+var DefaultOptions = Options{
+	MaxRetries:  Retries(3),
+	RetryDelay:  time.Second,
+	Concurrency: 4,
+	MaxTokens:   500,
+}
+
+// Pricing is the model's price in USD per million tokens
+type Pricing struct {
+	InputPerMillion  float64
+	OutputPerMillion float64
+}
+
+// Cost returns the price of usage in USD
+func (p Pricing) Cost(u Usage) float64 {
+	return float64(u.PromptTokens)*p.InputPerMillion/1e6 + float64(u.CompletionTokens)*p.OutputPerMillion/1e6
+}
+
+// Job is a job posting to rate and its company, which may be nil
+type Job struct {
+	Posting *models.JobPosting
+	Company *models.Company
+}
+
+// Result is the outcome of rating one job. Usage includes failed attempts.
+type Result struct {
+	Job      Job
+	Rating   *models.JobRating
+	Usage    Usage
+	Attempts int
+	Err      error
+}
+
+// Stats are the running totals of a rater
+type Stats struct {
+	Rated   int
+	Failed  int
+	Usage   Usage
+	CostUSD float64
+}
+
+// Rater rates jobs against a candidate profile with a Provider
+type Rater struct {
+	provider Provider
+	config   *match.Config
+	options  Options
+
+	mu    sync.Mutex
+	stats Stats
+}
+
+// Retries returns n as Options.MaxRetries, where 0 turns retries off
+func Retries(n int) *int {
+	return &n
+}
+
+// NewRater creates a rater. Zero option fields take their value from DefaultOptions.
+func NewRater(provider Provider, cfg *match.Config, options Options) *Rater {
+	if options.MaxRetries == nil {
+		options.MaxRetries = DefaultOptions.MaxRetries
+	}
+	if options.RetryDelay == 0 {
+		options.RetryDelay = DefaultOptions.RetryDelay
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = DefaultOptions.Concurrency
+	}
+	if options.MaxTokens == 0 {
+		options.MaxTokens = DefaultOptions.MaxTokens
+	}
+	return &Rater{provider: provider, config: cfg, options: options}
+}
+
+// Stats returns the totals of all ratings made so far
+func (r *Rater) Stats() Stats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stats
+}
+
+// Rate rates one job, retrying network errors, rate limits, server errors and responses
+// that aren't a valid rating
+func (r *Rater) Rate(ctx context.Context, job Job) Result {
+	result := Result{Job: job}
+	system, prompt, err := BuildRatingPrompt(r.config, job.Posting, job.Company)
+	if err != nil {
+		result.Err = err
+		r.record(result)
+		return result
+	}
+	req := Request{System: system, Prompt: prompt, MaxTokens: r.options.MaxTokens, JSON: true}
+
+	delay := r.options.RetryDelay
+	for {
+		result.Attempts++
+		result.Rating, err = r.attempt(ctx, req, &result.Usage)
+		if err == nil || !retryable(err) || result.Attempts > *r.options.MaxRetries {
+			break
+		}
+
+		logrus.Debugf("🔁 Rating job %d failed (attempt %d), retrying in %s: %v", job.Posting.JobID, result.Attempts, delay, err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		delay *= 2
+	}
+
+	if err != nil {
+		result.Err = fmt.Errorf("failed to rate job %d after %d attempts: %w", job.Posting.JobID, result.Attempts, err)
+	} else {
+		criteria := *result.Rating.Criteria
+		criteria["model"] = r.provider.Model()
+		criteria["prompt_tokens"] = result.Usage.PromptTokens
+		criteria["completion_tokens"] = result.Usage.CompletionTokens
+		result.Rating.JobID = job.Posting.JobID
+		result.Rating.RatedAt = time.Now()
+	}
+	r.record(result)
+	return result
+}
+
+// attempt makes one completion call and parses it, adding the tokens used to usage
+func (r *Rater) attempt(ctx context.Context, req Request, usage *Usage) (*models.JobRating, error) {
+	completion, err := r.provider.Complete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	*usage = usage.Add(completion.Usage)
+
+	rating, err := ParseRating(completion.Content)
+	if err != nil {
+		logrus.Debugf("Invalid rating response: %s", compactJSON(completion.Content))
+		return nil, err
+	}
+	return rating, nil
+}
+
+func (r *Rater) record(result Result) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if result.Err != nil {
+		r.stats.Failed++
+	} else {
+		r.stats.Rated++
+	}
+	r.stats.Usage = r.stats.Usage.Add(result.Usage)
+	r.stats.CostUSD = r.options.Pricing.Cost(r.stats.Usage)
+}
+
+// RateAll rates jobs with at most Options.Concurrency requests in flight. handle is called
+// with each result as it completes, one at a time, so it needn't be safe for concurrent use.
+// Jobs not started when ctx is cancelled are skipped.
+func (r *Rater) RateAll(ctx context.Context, jobs []Job, handle func(Result)) {
+	queue := make(chan Job)
+	results := make(chan Result)
+
+	var wg sync.WaitGroup
+	for i := 0; i < min(r.options.Concurrency, len(jobs)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range queue {
+				results <- r.Rate(ctx, job)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(queue)
+		for _, job := range jobs {
+			select {
+			case queue <- job:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for result := range results {
+		handle(result)
+	}
+}
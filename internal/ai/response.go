@@ -0,0 +1,100 @@
+package ai
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/utils"
+)
+
+// RatingType is the rating_type of ratings made by the model
+const RatingType = "ai"
+
+// errInvalidResponse marks a completion that doesn't follow the requested format. The
+// model may well get it right on the next attempt, so these are retried.
+var errInvalidResponse = errors.New("invalid model response")
+
+// ratingResponse is the JSON object the rating prompt asks for
+type ratingResponse struct {
+	OverallScore    *int     `json:"overall_score"`
+	LocationScore   *int     `json:"location_score"`
+	TechScore       *int     `json:"tech_score"`
+	TeamSizeScore   *int     `json:"team_size_score"`
+	LeadershipScore *int     `json:"leadership_score"`
+	Summary         string   `json:"summary"`
+	Strengths       []string `json:"strengths"`
+	Concerns        []string `json:"concerns"`
+}
+
+// ParseRating parses a rating completion strictly: a single JSON object with only the
+// known fields, an overall score and every score within 0-100. A ```json fence around the
+// object is tolerated, as some compatible servers add one even in JSON mode.
+func ParseRating(content string) (*models.JobRating, error) {
+	content = strings.TrimSpace(content)
+	if strings.HasPrefix(content, "```") {
+		content = strings.TrimPrefix(content, "```json")
+		content = strings.TrimPrefix(content, "```")
+		content = strings.TrimSuffix(strings.TrimSpace(content), "```")
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(content))
+	decoder.DisallowUnknownFields()
+	var resp ratingResponse
+	if err := decoder.Decode(&resp); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidResponse, err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("%w: trailing data after JSON object", errInvalidResponse)
+	}
+
+	if resp.OverallScore == nil {
+		return nil, fmt.Errorf("%w: overall_score is missing", errInvalidResponse)
+	}
+	for name, score := range map[string]*int{
+		"overall_score":    resp.OverallScore,
+		"location_score":   resp.LocationScore,
+		"tech_score":       resp.TechScore,
+		"team_size_score":  resp.TeamSizeScore,
+		"leadership_score": resp.LeadershipScore,
+	} {
+		if score != nil && (*score < 0 || *score > 100) {
+			return nil, fmt.Errorf("%w: %s %d is outside 0-100", errInvalidResponse, name, *score)
+		}
+	}
+
+	criteria := models.RatingCriteria{
+		"summary":   resp.Summary,
+		"strengths": nonNil(resp.Strengths),
+		"concerns":  nonNil(resp.Concerns),
+	}
+	return &models.JobRating{
+		OverallScore:    *resp.OverallScore,
+		LocationScore:   resp.LocationScore,
+		TechScore:       resp.TechScore,
+		TeamSizeScore:   resp.TeamSizeScore,
+		LeadershipScore: resp.LeadershipScore,
+		Criteria:        &criteria,
+		RatingType:      RatingType,
+	}, nil
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// compactJSON is used to log invalid responses on one line
+func compactJSON(content string) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(content)); err != nil {
+		return utils.Truncate(content, 300, " …")
+	}
+	return utils.Truncate(buf.String(), 300, " …")
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"linkedin-job-scraper/internal/config"
@@ -63,6 +64,76 @@ type JobIDsResponse struct {
 	LinkedInJobIDs []int `json:"linkedin_job_ids"`
 }
 
+// JobsResponse represents the response structure for the jobs list endpoint
+type JobsResponse struct {
+	Success bool                `json:"success"`
+	Count   int                 `json:"count"`
+	Jobs    []models.JobPosting `json:"jobs"`
+}
+
+// JobUpdateResponse represents the response from updating a job
+type JobUpdateResponse struct {
+	Success    bool               `json:"success"`
+	Message    string             `json:"message"`
+	JobPosting *models.JobPosting `json:"job_posting"`
+}
+
+// JobFingerprintsResponse represents the response structure for the job fingerprints endpoint
+type JobFingerprintsResponse struct {
+	Success      bool                    `json:"success"`
+	Count        int                     `json:"count"`
+	Fingerprints []models.JobFingerprint `json:"fingerprints"`
+}
+
+// JobSnapshotsResponse represents the response structure for the job snapshots endpoint
+type JobSnapshotsResponse struct {
+	Success   bool                 `json:"success"`
+	Count     int                  `json:"count"`
+	Snapshots []models.JobSnapshot `json:"snapshots"`
+}
+
+// JobSnapshotResponse represents the response from recording a job snapshot
+type JobSnapshotResponse struct {
+	Success  bool                `json:"success"`
+	Message  string              `json:"message"`
+	Snapshot *models.JobSnapshot `json:"snapshot"`
+}
+
+// JobRatingResponse represents the response from saving a job rating
+type JobRatingResponse struct {
+	Success bool              `json:"success"`
+	Message string            `json:"message"`
+	Rating  *models.JobRating `json:"rating"`
+}
+
+// JobRatingsResponse represents the response structure for the job ratings endpoint
+type JobRatingsResponse struct {
+	Success bool               `json:"success"`
+	Count   int                `json:"count"`
+	Ratings []models.JobRating `json:"ratings"`
+}
+
+// ScrapeRunResponse represents the response from recording a scrape run
+type ScrapeRunResponse struct {
+	Success bool              `json:"success"`
+	Message string            `json:"message"`
+	Run     *models.ScrapeRun `json:"run"`
+}
+
+// ScrapeRunsResponse represents the response structure for the scrape runs endpoint
+type ScrapeRunsResponse struct {
+	Success bool               `json:"success"`
+	Count   int                `json:"count"`
+	Runs    []models.ScrapeRun `json:"runs"`
+}
+
+// UserProfilesResponse represents the response structure for the LinkedIn user profiles endpoint
+type UserProfilesResponse struct {
+	Success bool                 `json:"success"`
+	Count   int                  `json:"count"`
+	Users   []models.UserProfile `json:"users"`
+}
+
 // CompanyNamesResponse represents the response structure for company names endpoint
 type CompanyNamesResponse struct {
 	Success      bool     `json:"success"`
@@ -70,15 +141,58 @@ type CompanyNamesResponse struct {
 	CompanyNames []string `json:"company_names"`
 }
 
-// CheckCompanyExists checks if a company exists via API
-func (c *Client) CheckCompanyExists(name string) (*models.Company, error) {
-	data := map[string]string{"name": name}
-	jsonData, err := json.Marshal(data)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+// CompaniesResponse represents the response structure for the companies list endpoint
+type CompaniesResponse struct {
+	Success   bool             `json:"success"`
+	Count     int              `json:"count"`
+	Companies []models.Company `json:"companies"`
+}
+
+// CompanyUpdateResponse represents the response from updating a company
+type CompanyUpdateResponse struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Company *models.Company `json:"company"`
+}
+
+// CompanyMergeResponse represents the response from merging companies
+type CompanyMergeResponse struct {
+	Success   bool            `json:"success"`
+	Message   string          `json:"message"`
+	Company   *models.Company `json:"company"`
+	JobsMoved int             `json:"jobs_moved"`
+	MergedIDs []int           `json:"merged_ids"`
+}
+
+// CompanyAliasesResponse represents the response structure for the company aliases endpoint
+type CompanyAliasesResponse struct {
+	Success bool                  `json:"success"`
+	Count   int                   `json:"count"`
+	Aliases []models.CompanyAlias `json:"aliases"`
+}
+
+// CompanyAliasResponse represents the response from creating a company alias
+type CompanyAliasResponse struct {
+	Success bool                 `json:"success"`
+	Message string               `json:"message"`
+	Alias   *models.CompanyAlias `json:"alias"`
+}
+
+// CheckCompanyExists looks up a company via API. The API matches on the LinkedIn company ID
+// first, then the LinkedIn slug, and only falls back to the display name when neither is given.
+func (c *Client) CheckCompanyExists(company *models.Company) (*models.Company, error) {
+	params := url.Values{}
+	if company.LinkedInCompanyID != "" {
+		params.Add("linkedin_company_id", company.LinkedInCompanyID)
+	}
+	if company.LinkedInSlug != "" {
+		params.Add("linkedin_slug", company.LinkedInSlug)
+	}
+	if company.Name != "" {
+		params.Add("name", company.Name)
 	}
 
-	req, err := http.NewRequest("POST", c.baseURL+"/companies/exists", bytes.NewBuffer(jsonData))
+	req, err := http.NewRequest("GET", c.baseURL+"/companies/exists?"+params.Encode(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -112,9 +226,26 @@ func (c *Client) CheckCompanyExists(name string) (*models.Company, error) {
 	return nil, nil
 }
 
-// CreateCompany creates a new company via API
-func (c *Client) CreateCompany(name string) (*models.Company, error) {
-	data := map[string]string{"name": name}
+// ErrCompanyNameTaken is returned by CreateCompany when the API refuses a company because
+// another LinkedIn company already has its name
+var ErrCompanyNameTaken = errors.New("company name is taken by another LinkedIn company")
+
+// CreateCompany creates a new company via API. A 409 is resolved by the company's LinkedIn
+// identity, falling back to its name only for companies without one.
+func (c *Client) CreateCompany(company *models.Company) (*models.Company, error) {
+	data := map[string]string{
+		"name":      company.Name,
+		"image_url": company.ImageURL,
+	}
+	if company.LinkedInCompanyID != "" {
+		data["linkedin_company_id"] = company.LinkedInCompanyID
+	}
+	if company.LinkedInSlug != "" {
+		data["linkedin_slug"] = company.LinkedInSlug
+	}
+	if company.LinkedInURL != "" {
+		data["linkedin_url"] = company.LinkedInURL
+	}
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
@@ -141,8 +272,22 @@ func (c *Client) CreateCompany(name string) (*models.Company, error) {
 
 	if resp.StatusCode == http.StatusConflict {
 		// Company already exists, try to get it
-		logrus.Debugf("Company already exists, fetching existing company: %s", name)
-		return c.CheckCompanyExists(name)
+		logrus.Debugf("Company already exists, fetching existing company: %s (%s)", company.Name, company.IdentityKey())
+		if !company.HasLinkedInIdentity() {
+			return c.CheckCompanyExists(company)
+		}
+		// Only the LinkedIn identity can tell whether the existing company is this one
+		existing, err := c.CheckCompanyExists(&models.Company{
+			LinkedInCompanyID: company.LinkedInCompanyID,
+			LinkedInSlug:      company.LinkedInSlug,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil || existing.ConflictsWith(company) {
+			return nil, fmt.Errorf("%w: %q", ErrCompanyNameTaken, company.Name)
+		}
+		return existing, nil
 	}
 
 	if resp.StatusCode != http.StatusCreated {
@@ -206,7 +351,7 @@ func (c *Client) CreateJob(job *models.JobPosting) (*models.JobPosting, error) {
 		"location":        job.Location,
 		"description":     job.Description,
 		"apply_url":       job.ApplyURL,
-		"posted_date":     job.PostedDate.Format("2006-01-02"),
+		"posted_date":     job.PostedDate.UTC().Format(time.RFC3339),
 	}
 
 	// Add optional fields
@@ -219,6 +364,69 @@ func (c *Client) CreateJob(job *models.JobPosting) (*models.JobPosting, error) {
 	if job.Skills != nil {
 		apiJob["skills"] = *job.Skills
 	}
+	if job.SkillIDs != nil {
+		apiJob["skill_ids"] = *job.SkillIDs
+	}
+	if job.SkillsSource != nil {
+		apiJob["skills_source"] = *job.SkillsSource
+	}
+	if job.SalaryMin != nil {
+		apiJob["salary_min"] = *job.SalaryMin
+	}
+	if job.SalaryMax != nil {
+		apiJob["salary_max"] = *job.SalaryMax
+	}
+	if job.SalaryCurrency != nil {
+		apiJob["salary_currency"] = *job.SalaryCurrency
+	}
+	if job.SalaryPeriod != nil {
+		apiJob["salary_period"] = *job.SalaryPeriod
+	}
+	if job.SalaryAnnualMinDKK != nil {
+		apiJob["salary_annual_min_dkk"] = *job.SalaryAnnualMinDKK
+	}
+	if job.SalaryAnnualMaxDKK != nil {
+		apiJob["salary_annual_max_dkk"] = *job.SalaryAnnualMaxDKK
+	}
+	if job.SeniorityLevel != nil {
+		apiJob["seniority_level"] = *job.SeniorityLevel
+	}
+	if job.EmploymentType != nil {
+		apiJob["employment_type"] = *job.EmploymentType
+	}
+	if job.JobFunction != nil {
+		apiJob["job_function"] = *job.JobFunction
+	}
+	if job.Industries != nil {
+		apiJob["industries"] = *job.Industries
+	}
+	if job.Fingerprint != nil {
+		apiJob["fingerprint"] = *job.Fingerprint
+	}
+	if job.RepostOfJobID != nil {
+		apiJob["repost_of_job_id"] = *job.RepostOfJobID
+	}
+	if job.PostedDateSource != nil {
+		apiJob["posted_date_source"] = *job.PostedDateSource
+	}
+	if job.LocationCity != nil {
+		apiJob["location_city"] = *job.LocationCity
+	}
+	if job.LocationRegion != nil {
+		apiJob["location_region"] = *job.LocationRegion
+	}
+	if job.LocationCountry != nil {
+		apiJob["location_country"] = *job.LocationCountry
+	}
+	if job.CountryCode != nil {
+		apiJob["country_code"] = *job.CountryCode
+	}
+	if job.PlaceKey != nil {
+		apiJob["place_key"] = *job.PlaceKey
+	}
+	if job.WorkplaceType != nil {
+		apiJob["workplace_type"] = *job.WorkplaceType
+	}
 
 	jsonData, err := json.Marshal(apiJob)
 	if err != nil {
@@ -341,3 +549,763 @@ func (c *Client) GetAllCompanyNames() ([]string, error) {
 
 	return response.CompanyNames, nil
 }
+
+// GetCompanies retrieves the companies matching filter from the API
+func (c *Client) GetCompanies(filter models.CompanyFilter) ([]models.Company, error) {
+	params := url.Values{}
+	if filter.NeedsEnrichment {
+		params.Add("needs_enrichment", "1")
+	}
+	if filter.Name != "" {
+		params.Add("name", filter.Name)
+	}
+	if filter.Limit > 0 {
+		params.Add("limit", fmt.Sprintf("%d", filter.Limit))
+		params.Add("offset", fmt.Sprintf("%d", filter.Offset))
+	}
+
+	req, err := http.NewRequest("GET", c.baseURL+"/companies?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response CompaniesResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure")
+	}
+
+	return response.Companies, nil
+}
+
+// UpdateCompany updates a company's LinkedIn enrichment fields via API
+func (c *Client) UpdateCompany(company *models.Company) (*models.Company, error) {
+	apiCompany := map[string]interface{}{
+		"name": company.Name,
+	}
+
+	// Only send fields we actually know, so partial enrichment doesn't wipe existing data
+	if company.ImageURL != "" {
+		apiCompany["image_url"] = company.ImageURL
+	}
+	if company.LinkedInCompanyID != "" {
+		apiCompany["linkedin_company_id"] = company.LinkedInCompanyID
+	}
+	if company.LinkedInSlug != "" {
+		apiCompany["linkedin_slug"] = company.LinkedInSlug
+	}
+	if company.LinkedInURL != "" {
+		apiCompany["linkedin_url"] = company.LinkedInURL
+	}
+	if company.EmployeeRange != nil {
+		apiCompany["employee_range"] = *company.EmployeeRange
+	}
+	if company.EmployeeCountMin != nil {
+		apiCompany["employee_count_min"] = *company.EmployeeCountMin
+	}
+	if company.EmployeeCountMax != nil {
+		apiCompany["employee_count_max"] = *company.EmployeeCountMax
+	}
+	if company.Industry != nil {
+		apiCompany["industry"] = *company.Industry
+	}
+	if company.Headquarters != nil {
+		apiCompany["headquarters"] = *company.Headquarters
+	}
+	if company.Website != nil {
+		apiCompany["website"] = *company.Website
+	}
+	if company.FollowerCount != nil {
+		apiCompany["follower_count"] = *company.FollowerCount
+	}
+	if company.EnrichedAt != nil {
+		apiCompany["enriched_at"] = company.EnrichedAt.Format(time.RFC3339)
+	}
+
+	jsonData, err := json.Marshal(apiCompany)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/companies/%d", c.baseURL, company.CompanyID), bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response CompanyUpdateResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure: %s", response.Message)
+	}
+
+	return response.Company, nil
+}
+
+// MergeCompanies merges duplicate companies into the canonical company via API.
+// The API moves the duplicates' job postings to the canonical company and deletes the duplicates.
+func (c *Client) MergeCompanies(canonicalID int, duplicateIDs []int) (*CompanyMergeResponse, error) {
+	data := map[string]interface{}{
+		"duplicate_ids": duplicateIDs,
+	}
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/companies/%d/merge", c.baseURL, canonicalID), bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response CompanyMergeResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure: %s", response.Message)
+	}
+
+	return &response, nil
+}
+
+// GetCompanyAliases retrieves the company alias table from the API
+func (c *Client) GetCompanyAliases() ([]models.CompanyAlias, error) {
+	req, err := http.NewRequest("GET", c.baseURL+"/company-aliases", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response CompanyAliasesResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure")
+	}
+
+	return response.Aliases, nil
+}
+
+// CreateCompanyAlias records that a name refers to an existing company via API
+func (c *Client) CreateCompanyAlias(alias *models.CompanyAlias) (*models.CompanyAlias, error) {
+	jsonData, err := json.Marshal(alias)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", c.baseURL+"/company-aliases", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	// An existing alias for the same name is updated by the API
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response CompanyAliasResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure: %s", response.Message)
+	}
+
+	return response.Alias, nil
+}
+
+// UpdateJob updates a job's refreshable fields (applicants, closed date, repost link, address and skills) via API
+func (c *Client) UpdateJob(job *models.JobPosting) (*models.JobPosting, error) {
+	apiJob := map[string]interface{}{
+		"linkedin_job_id": job.LinkedInJobID,
+	}
+	if job.Applicants != nil {
+		apiJob["applicants"] = *job.Applicants
+	}
+	if job.JobPostClosedDate != nil {
+		apiJob["job_post_closed_date"] = job.JobPostClosedDate.Format("2006-01-02")
+	}
+	if job.Fingerprint != nil {
+		apiJob["fingerprint"] = *job.Fingerprint
+	}
+	if job.RepostOfJobID != nil {
+		apiJob["repost_of_job_id"] = *job.RepostOfJobID
+	}
+	if job.OpenaiAdresse != nil {
+		apiJob["openai_adresse"] = *job.OpenaiAdresse
+	}
+	if job.Skills != nil {
+		apiJob["skills"] = *job.Skills
+	}
+	if job.SkillIDs != nil {
+		apiJob["skill_ids"] = *job.SkillIDs
+	}
+	if job.SkillsSource != nil {
+		apiJob["skills_source"] = *job.SkillsSource
+	}
+
+	jsonData, err := json.Marshal(apiJob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/jobs/%d", c.baseURL, job.JobID), bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response JobUpdateResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure: %s", response.Message)
+	}
+
+	return response.JobPosting, nil
+}
+
+// CreateJobSnapshot records the state of a job posting at one point in time via API
+func (c *Client) CreateJobSnapshot(snapshot *models.JobSnapshot) (*models.JobSnapshot, error) {
+	jsonData, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", c.baseURL+"/job-snapshots", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response JobSnapshotResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure: %s", response.Message)
+	}
+
+	return response.Snapshot, nil
+}
+
+// GetJobSnapshots retrieves all snapshots of a job, oldest first
+func (c *Client) GetJobSnapshots(linkedinJobID int) ([]models.JobSnapshot, error) {
+	params := url.Values{}
+	params.Add("linkedin_job_id", fmt.Sprintf("%d", linkedinJobID))
+
+	req, err := http.NewRequest("GET", c.baseURL+"/job-snapshots?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response JobSnapshotsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure")
+	}
+
+	return response.Snapshots, nil
+}
+
+// GetJobFingerprints retrieves the fingerprints of a company's jobs (all companies when companyID is 0)
+func (c *Client) GetJobFingerprints(companyID int64) ([]models.JobFingerprint, error) {
+	params := url.Values{}
+	if companyID > 0 {
+		params.Add("company_id", fmt.Sprintf("%d", companyID))
+	}
+
+	req, err := http.NewRequest("GET", c.baseURL+"/jobs/fingerprints?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response JobFingerprintsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure")
+	}
+
+	return response.Fingerprints, nil
+}
+
+// CreateJobRating saves a job rating via API
+func (c *Client) CreateJobRating(rating *models.JobRating) (*models.JobRating, error) {
+	jsonData, err := json.Marshal(rating)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", c.baseURL+"/job-ratings", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response JobRatingResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure: %s", response.Message)
+	}
+
+	return response.Rating, nil
+}
+
+// GetJobs retrieves the jobs matching filter. The API decides the order: jobs to process
+// (missing data, to refresh) oldest first, other lists newest first.
+func (c *Client) GetJobs(filter models.JobFilter) ([]models.JobPosting, error) {
+	params := url.Values{}
+	if filter.LinkedInJobID != 0 {
+		params.Add("linkedin_job_id", fmt.Sprintf("%d", filter.LinkedInJobID))
+	}
+	if filter.Open {
+		params.Add("open", "1")
+	}
+	if filter.Closed {
+		params.Add("closed", "1")
+	}
+	if !filter.PostedAfter.IsZero() {
+		params.Add("posted_after", filter.PostedAfter.Format("2006-01-02"))
+	}
+	if !filter.PostedBefore.IsZero() {
+		params.Add("posted_before", filter.PostedBefore.Format("2006-01-02"))
+	}
+	if !filter.OpenOrPostedBefore.IsZero() {
+		params.Add("open_or_posted_before", filter.OpenOrPostedBefore.Format("2006-01-02"))
+	}
+	for _, skill := range filter.Skills {
+		params.Add("skills[]", skill)
+	}
+	if filter.CompanyID != 0 {
+		params.Add("company_id", fmt.Sprintf("%d", filter.CompanyID))
+	}
+	if filter.Company != "" {
+		params.Add("company", filter.Company)
+	}
+	if filter.WorkType != "" {
+		params.Add("work_type", filter.WorkType)
+	}
+	if filter.MinRating > 0 {
+		params.Add("min_rating", fmt.Sprintf("%d", filter.MinRating))
+		if filter.RatingType != "" {
+			params.Add("rating_type", filter.RatingType)
+		}
+	}
+	if filter.MissingFingerprint {
+		params.Add("missing_fingerprint", "1")
+	}
+	if filter.MissingAddress {
+		params.Add("missing_address", "1")
+	}
+	if filter.MissingSkillIDs {
+		params.Add("missing_skill_ids", "1")
+	}
+	if filter.UnratedBy != "" {
+		params.Add("unrated", filter.UnratedBy)
+	}
+	if filter.LeastRefreshed {
+		params.Add("order", "least_refreshed")
+	}
+	if filter.Limit > 0 {
+		params.Add("limit", fmt.Sprintf("%d", filter.Limit))
+		params.Add("offset", fmt.Sprintf("%d", filter.Offset))
+	}
+	return c.getJobs(params)
+}
+
+// getJobs calls the jobs list endpoint with query params
+func (c *Client) getJobs(params url.Values) ([]models.JobPosting, error) {
+	req, err := http.NewRequest("GET", c.baseURL+"/jobs?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response JobsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure")
+	}
+
+	return response.Jobs, nil
+}
+
+// GetUserProfiles fetches the LinkedIn user profiles collected by linkedin-user-scraper
+func (c *Client) GetUserProfiles(limit int) ([]models.UserProfile, error) {
+	params := url.Values{}
+	if limit > 0 {
+		params.Add("limit", fmt.Sprintf("%d", limit))
+	}
+
+	req, err := http.NewRequest("GET", c.baseURL+"/linkedin-profile/users?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response UserProfilesResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure")
+	}
+
+	return response.Users, nil
+}
+
+// GetJobRatings retrieves the ratings of a job, one per rating type
+func (c *Client) GetJobRatings(jobID int) ([]models.JobRating, error) {
+	params := url.Values{}
+	params.Add("job_id", fmt.Sprintf("%d", jobID))
+
+	req, err := http.NewRequest("GET", c.baseURL+"/job-ratings?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response JobRatingsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure")
+	}
+
+	return response.Ratings, nil
+}
+
+// CreateScrapeRun records a finished scrape run via API
+func (c *Client) CreateScrapeRun(run *models.ScrapeRun) (*models.ScrapeRun, error) {
+	jsonData, err := json.Marshal(run)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", c.baseURL+"/scrape-runs", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response ScrapeRunResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure: %s", response.Message)
+	}
+
+	return response.Run, nil
+}
+
+// GetScrapeRuns retrieves the most recent scrape runs, newest first
+func (c *Client) GetScrapeRuns(limit int) ([]models.ScrapeRun, error) {
+	params := url.Values{}
+	if limit > 0 {
+		params.Add("limit", fmt.Sprintf("%d", limit))
+	}
+
+	req, err := http.NewRequest("GET", c.baseURL+"/scrape-runs?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var response ScrapeRunsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("API response indicates failure")
+	}
+
+	return response.Runs, nil
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/models"
+)
+
+// conflictServer refuses every new company with a 409 and answers exists lookups with existing
+// if its LinkedIn ID or name is asked for
+func conflictServer(t *testing.T, existing models.Company) (*Client, *[]string) {
+	var lookups []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/companies":
+			w.WriteHeader(http.StatusConflict)
+		case "/companies/exists":
+			lookups = append(lookups, r.URL.RawQuery)
+			query := r.URL.Query()
+			found := query.Get("linkedin_company_id") == existing.LinkedInCompanyID || query.Get("name") == existing.Name
+			response := CompanyExistsResponse{Exists: found}
+			if found {
+				response.Company = &existing
+			}
+			json.NewEncoder(w).Encode(response)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return NewClient(&config.APIConfig{BaseURL: server.URL}), &lookups
+}
+
+func TestCreateCompanyConflict(t *testing.T) {
+	existing := models.Company{CompanyID: 7, Name: "Acme", LinkedInCompanyID: "111"}
+
+	t.Run("same LinkedIn company", func(t *testing.T) {
+		client, lookups := conflictServer(t, existing)
+		company, err := client.CreateCompany(&models.Company{Name: "Acme", LinkedInCompanyID: "111"})
+		if err != nil || company == nil || company.CompanyID != 7 {
+			t.Fatalf("CreateCompany() = %+v, %v, want the existing company", company, err)
+		}
+		if len(*lookups) != 1 || (*lookups)[0] != "linkedin_company_id=111" {
+			t.Errorf("lookups = %v, want one by LinkedIn ID only", *lookups)
+		}
+	})
+
+	t.Run("another LinkedIn company with the name", func(t *testing.T) {
+		client, _ := conflictServer(t, existing)
+		company, err := client.CreateCompany(&models.Company{Name: "Acme", LinkedInCompanyID: "222"})
+		if !errors.Is(err, ErrCompanyNameTaken) {
+			t.Fatalf("CreateCompany() = %+v, %v, want ErrCompanyNameTaken", company, err)
+		}
+	})
+
+	t.Run("no LinkedIn identity", func(t *testing.T) {
+		client, _ := conflictServer(t, existing)
+		company, err := client.CreateCompany(&models.Company{Name: "Acme"})
+		if err != nil || company == nil || company.CompanyID != 7 {
+			t.Fatalf("CreateCompany() = %+v, %v, want the existing company by name", company, err)
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package cache
+
+import "linkedin-job-scraper/internal/models"
+
+// Cache remembers job and company lookups between the scraper and the store.
+// RedisCache shares them between runs; MemoryCache keeps them for one process.
+type Cache interface {
+	JobExistsInCache(linkedinJobID int) (exists bool, found bool)
+	SetJobExists(linkedinJobID int, exists bool)
+	ClearJobExistsCache() error
+
+	GetCompany(identityKey string) (*models.Company, bool)
+	SetCompany(company *models.Company)
+	DeleteCompany(company *models.Company)
+	GetCompanyAlias(normalisedName string) (string, bool)
+	SetCompanyAlias(normalisedName, companyName string)
+	GetCompanyEnrichment(companySlug string) (*models.Company, bool)
+	SetCompanyEnrichment(companySlug string, company *models.Company)
+
+	Close() error
+}
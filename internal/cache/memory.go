@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/models"
+
+	"github.com/sirupsen/logrus"
+)
+
+// MemoryCache is an in-process replacement for RedisCache, used in standalone mode.
+// It honours the same TTLs but is empty at the start of every run.
+type MemoryCache struct {
+	mu            sync.Mutex
+	entries       map[string]memoryEntry
+	jobExistsTTL  time.Duration
+	cacheTTL      time.Duration
+	enrichmentTTL time.Duration
+}
+
+type memoryEntry struct {
+	value     interface{}
+	expiresAt time.Time // Zero for no expiry
+}
+
+// NewMemoryCache creates an empty in-memory cache with the TTLs from the Redis configuration
+func NewMemoryCache(cfg *config.RedisConfig) *MemoryCache {
+	return &MemoryCache{
+		entries:       make(map[string]memoryEntry),
+		jobExistsTTL:  time.Duration(cfg.JobExistsTTL) * time.Second,
+		cacheTTL:      time.Duration(cfg.CacheTTL) * time.Second,
+		enrichmentTTL: time.Duration(cfg.CompanyEnrichmentTTL) * time.Second,
+	}
+}
+
+func (m *MemoryCache) get(key string) (interface{}, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (m *MemoryCache) set(key string, value interface{}, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := memoryEntry{value: value}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+	m.entries[key] = entry
+}
+
+// JobExistsInCache checks if we recently verified that a job exists
+func (m *MemoryCache) JobExistsInCache(linkedinJobID int) (exists bool, found bool) {
+	value, found := m.get(fmt.Sprintf("job_exists:%d", linkedinJobID))
+	if !found {
+		return false, false
+	}
+	return value.(bool), true
+}
+
+// SetJobExists caches the fact that a job exists or doesn't exist
+func (m *MemoryCache) SetJobExists(linkedinJobID int, exists bool) {
+	m.set(fmt.Sprintf("job_exists:%d", linkedinJobID), exists, m.jobExistsTTL)
+}
+
+// ClearJobExistsCache clears all job existence cache entries
+func (m *MemoryCache) ClearJobExistsCache() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cleared := 0
+	for key := range m.entries {
+		if strings.HasPrefix(key, "job_exists:") {
+			delete(m.entries, key)
+			cleared++
+		}
+	}
+	logrus.Infof("🧹 Cleared %d job existence cache entries", cleared)
+	return nil
+}
+
+// GetCompany gets a cached company by identity key
+func (m *MemoryCache) GetCompany(identityKey string) (*models.Company, bool) {
+	value, found := m.get("company:" + identityKey)
+	if !found {
+		return nil, false
+	}
+	company := value.(models.Company)
+	return &company, true
+}
+
+// SetCompany caches a company under each of its identity keys
+func (m *MemoryCache) SetCompany(company *models.Company) {
+	for _, identityKey := range company.IdentityKeys() {
+		m.set("company:"+identityKey, *company, m.cacheTTL)
+	}
+}
+
+// DeleteCompany removes a company from the cache under each of its identity keys
+func (m *MemoryCache) DeleteCompany(company *models.Company) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, identityKey := range company.IdentityKeys() {
+		delete(m.entries, "company:"+identityKey)
+	}
+}
+
+// GetCompanyAlias gets the canonical company name for a normalised alias name
+func (m *MemoryCache) GetCompanyAlias(normalisedName string) (string, bool) {
+	value, found := m.get("company_alias:" + normalisedName)
+	if !found {
+		return "", false
+	}
+	return value.(string), true
+}
+
+// SetCompanyAlias caches the canonical company name for a normalised alias name
+func (m *MemoryCache) SetCompanyAlias(normalisedName, companyName string) {
+	m.set("company_alias:"+normalisedName, companyName, 0)
+}
+
+// GetCompanyEnrichment gets cached LinkedIn page details for a company
+func (m *MemoryCache) GetCompanyEnrichment(companySlug string) (*models.Company, bool) {
+	value, found := m.get("company:enrichment:" + companySlug)
+	if !found {
+		return nil, false
+	}
+	company := value.(models.Company)
+	return &company, true
+}
+
+// SetCompanyEnrichment caches LinkedIn page details for a company
+func (m *MemoryCache) SetCompanyEnrichment(companySlug string, company *models.Company) {
+	m.set("company:enrichment:"+companySlug, *company, m.enrichmentTTL)
+}
+
+// Close releases nothing; the cache is dropped with the process
+func (m *MemoryCache) Close() error {
+	return nil
+}
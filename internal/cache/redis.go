@@ -13,9 +13,10 @@ import (
 )
 
 type RedisCache struct {
-	client       *redis.Client
-	jobExistsTTL time.Duration
-	cacheTTL     time.Duration
+	client        *redis.Client
+	jobExistsTTL  time.Duration
+	cacheTTL      time.Duration
+	enrichmentTTL time.Duration
 }
 
 // NewRedisCache creates a new Redis cache instance
@@ -35,9 +36,10 @@ func NewRedisCache(cfg *config.RedisConfig) *RedisCache {
 	}
 
 	return &RedisCache{
-		client:       client,
-		jobExistsTTL: time.Duration(cfg.JobExistsTTL) * time.Second,
-		cacheTTL:     time.Duration(cfg.CacheTTL) * time.Second,
+		client:        client,
+		jobExistsTTL:  time.Duration(cfg.JobExistsTTL) * time.Second,
+		cacheTTL:      time.Duration(cfg.CacheTTL) * time.Second,
+		enrichmentTTL: time.Duration(cfg.CompanyEnrichmentTTL) * time.Second,
 	}
 }
 
@@ -78,10 +80,10 @@ func (r *RedisCache) SetJobExists(linkedinJobID int, exists bool) {
 	}
 }
 
-// GetCompanyByName gets a cached company by name
-func (r *RedisCache) GetCompanyByName(name string) (*models.Company, bool) {
+// GetCompany gets a cached company by identity key ("linkedin:<id>", "slug:<slug>" or "name:<name>")
+func (r *RedisCache) GetCompany(identityKey string) (*models.Company, bool) {
 	ctx := context.Background()
-	key := fmt.Sprintf("company:name:%s", name)
+	key := fmt.Sprintf("company:%s", identityKey)
 
 	result, err := r.client.Get(ctx, key).Result()
 	if err == redis.Nil {
@@ -98,14 +100,13 @@ func (r *RedisCache) GetCompanyByName(name string) (*models.Company, bool) {
 		return nil, false
 	}
 
-	logrus.Debugf("🎯 Company cache hit: %s", name)
+	logrus.Debugf("🎯 Company cache hit: %s", identityKey)
 	return &company, true
 }
 
-// SetCompany caches a company
+// SetCompany caches a company under each of its identity keys
 func (r *RedisCache) SetCompany(company *models.Company) {
 	ctx := context.Background()
-	key := fmt.Sprintf("company:name:%s", company.Name)
 
 	data, err := json.Marshal(company)
 	if err != nil {
@@ -113,57 +114,114 @@ func (r *RedisCache) SetCompany(company *models.Company) {
 		return
 	}
 
-	err = r.client.Set(ctx, key, data, r.cacheTTL).Err()
+	for _, identityKey := range company.IdentityKeys() {
+		key := fmt.Sprintf("company:%s", identityKey)
+		if err := r.client.Set(ctx, key, data, r.cacheTTL).Err(); err != nil {
+			logrus.Warnf("Redis error setting company: %v", err)
+			return
+		}
+	}
+	logrus.Debugf("🔄 Cached company: %s (ID: %d, %s)", company.Name, company.CompanyID, company.IdentityKey())
+}
+
+// DeleteCompany removes a company from the cache under each of its identity keys
+func (r *RedisCache) DeleteCompany(company *models.Company) {
+	ctx := context.Background()
+
+	var keys []string
+	for _, identityKey := range company.IdentityKeys() {
+		keys = append(keys, fmt.Sprintf("company:%s", identityKey))
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	if err := r.client.Del(ctx, keys...).Err(); err != nil {
+		logrus.Warnf("Redis error deleting company: %v", err)
+	}
+}
+
+// GetCompanyAlias gets the canonical company name for a normalised alias name
+func (r *RedisCache) GetCompanyAlias(normalisedName string) (string, bool) {
+	ctx := context.Background()
+	key := fmt.Sprintf("company_alias:%s", normalisedName)
+
+	result, err := r.client.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return "", false
+	}
 	if err != nil {
-		logrus.Warnf("Redis error setting company: %v", err)
-	} else {
-		logrus.Debugf("🔄 Cached company: %s (ID: %d)", company.Name, company.CompanyID)
+		logrus.Warnf("Redis error getting company alias: %v", err)
+		return "", false
 	}
+
+	return result, true
 }
 
-// InvalidateJobExists removes a job existence cache entry
-func (r *RedisCache) InvalidateJobExists(linkedinJobID int) {
+// SetCompanyAlias caches the canonical company name for a normalised alias name.
+// Aliases don't expire; they are refreshed from the API when company names are preloaded.
+func (r *RedisCache) SetCompanyAlias(normalisedName, companyName string) {
 	ctx := context.Background()
-	key := fmt.Sprintf("job_exists:%d", linkedinJobID)
+	key := fmt.Sprintf("company_alias:%s", normalisedName)
 
-	err := r.client.Del(ctx, key).Err()
+	err := r.client.Set(ctx, key, companyName, 0).Err()
 	if err != nil {
-		logrus.Warnf("Redis error deleting job existence cache: %v", err)
+		logrus.Warnf("Redis error setting company alias: %v", err)
+	} else {
+		logrus.Debugf("🔄 Cached company alias: %s -> %s", normalisedName, companyName)
 	}
 }
 
-// CompanyExistsInCache checks if we recently verified that a company exists
-func (r *RedisCache) CompanyExistsInCache(companyName string) (exists bool, found bool) {
+// GetCompanyEnrichment gets cached LinkedIn page details for a company
+func (r *RedisCache) GetCompanyEnrichment(companySlug string) (*models.Company, bool) {
 	ctx := context.Background()
-	key := fmt.Sprintf("company_exists:%s", companyName)
+	key := fmt.Sprintf("company:enrichment:%s", companySlug)
 
 	result, err := r.client.Get(ctx, key).Result()
 	if err == redis.Nil {
-		return false, false
+		return nil, false
 	}
 	if err != nil {
-		logrus.Warnf("Redis error checking company existence: %v", err)
-		return false, false
+		logrus.Warnf("Redis error getting company enrichment: %v", err)
+		return nil, false
 	}
 
-	exists = result == "true"
-	return exists, true
+	var company models.Company
+	if err := json.Unmarshal([]byte(result), &company); err != nil {
+		logrus.Warnf("Redis error unmarshaling company enrichment: %v", err)
+		return nil, false
+	}
+
+	return &company, true
 }
 
-// SetCompanyExists caches the fact that a company exists or doesn't exist
-func (r *RedisCache) SetCompanyExists(companyName string, exists bool) {
+// SetCompanyEnrichment caches LinkedIn page details for a company
+func (r *RedisCache) SetCompanyEnrichment(companySlug string, company *models.Company) {
 	ctx := context.Background()
-	key := fmt.Sprintf("company_exists:%s", companyName)
-	value := "false"
-	if exists {
-		value = "true"
+	key := fmt.Sprintf("company:enrichment:%s", companySlug)
+
+	data, err := json.Marshal(company)
+	if err != nil {
+		logrus.Warnf("Redis error marshaling company enrichment: %v", err)
+		return
 	}
 
-	err := r.client.Set(ctx, key, value, r.jobExistsTTL).Err()
+	err = r.client.Set(ctx, key, data, r.enrichmentTTL).Err()
 	if err != nil {
-		logrus.Warnf("Redis error setting company existence: %v", err)
+		logrus.Warnf("Redis error setting company enrichment: %v", err)
 	} else {
-		logrus.Debugf("🔄 Cached company existence: %s = %v (TTL: %v)", companyName, exists, r.jobExistsTTL)
+		logrus.Debugf("🔄 Cached company enrichment: %s (TTL: %v)", companySlug, r.enrichmentTTL)
+	}
+}
+
+// InvalidateJobExists removes a job existence cache entry
+func (r *RedisCache) InvalidateJobExists(linkedinJobID int) {
+	ctx := context.Background()
+	key := fmt.Sprintf("job_exists:%d", linkedinJobID)
+
+	err := r.client.Del(ctx, key).Err()
+	if err != nil {
+		logrus.Warnf("Redis error deleting job existence cache: %v", err)
 	}
 }
 
@@ -201,6 +259,29 @@ func (r *RedisCache) LLen(key string) (int, error) {
 	return int(length), nil
 }
 
+// GetBytes gets a binary value stored without expiry, or nil if the key doesn't exist
+func (r *RedisCache) GetBytes(key string) ([]byte, error) {
+	ctx := context.Background()
+	result, err := r.client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Redis Get error: %w", err)
+	}
+	return result, nil
+}
+
+// SetBytes stores a binary value without expiry
+func (r *RedisCache) SetBytes(key string, value []byte) error {
+	ctx := context.Background()
+	err := r.client.Set(ctx, key, value, 0).Err()
+	if err != nil {
+		return fmt.Errorf("Redis Set error: %w", err)
+	}
+	return nil
+}
+
 // GetCacheStats returns some basic cache statistics
 func (r *RedisCache) GetCacheStats() map[string]interface{} {
 	ctx := context.Background()
@@ -0,0 +1,235 @@
+// Package commute estimates travel time between Danish postal codes and turns it into the
+// location score of a job rating.
+package commute
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"linkedin-job-scraper/internal/addresses"
+	"linkedin-job-scraper/internal/models"
+)
+
+// Mode is a way of getting to work
+type Mode string
+
+const (
+	ModeCar             Mode = "car"
+	ModePublicTransport Mode = "public_transport"
+	ModeBike            Mode = "bike"
+	ModeWalk            Mode = "walk"
+)
+
+// modeFactors turn a straight-line distance into a travel time: roads and rails are longer
+// than the straight line (detour), and every trip has a fixed overhead (parking, waiting,
+// walking to the station)
+var modeFactors = map[Mode]struct {
+	detour   float64
+	speedKmh float64
+	overhead time.Duration
+}{
+	ModeCar:             {detour: 1.3, speedKmh: 60, overhead: 5 * time.Minute},
+	ModePublicTransport: {detour: 1.4, speedKmh: 40, overhead: 12 * time.Minute},
+	ModeBike:            {detour: 1.2, speedKmh: 16, overhead: 2 * time.Minute},
+	ModeWalk:            {detour: 1.2, speedKmh: 5, overhead: 0},
+}
+
+// earthRadiusKm is the mean radius used for haversine distances
+const earthRadiusKm = 6371.0
+
+// DistanceKm returns the great-circle distance between two points
+func DistanceKm(a, b addresses.Point) float64 {
+	lat1, lat2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Lon - a.Lon) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// TravelTime estimates the door-to-door time for a straight-line distance with a mode.
+// Unknown modes are treated as car.
+func TravelTime(distanceKm float64, mode Mode) time.Duration {
+	factors, ok := modeFactors[mode]
+	if !ok {
+		factors = modeFactors[ModeCar]
+	}
+	hours := distanceKm * factors.detour / factors.speedKmh
+	return factors.overhead + time.Duration(hours*float64(time.Hour))
+}
+
+// maxCommutePattern matches preferences like "45 minutes from Roskilde", "1 hour from 4000"
+// or "45 min fra Roskilde"
+var maxCommutePattern = regexp.MustCompile(`(?i)^\s*(\d+)\s*(minutes?|mins?|minutter|hours?|timer?)\s+(?:from|fra)\s+(.+?)\s*$`)
+
+// ParseMaxCommute splits a preference like "45 minutes from Roskilde" into its duration and origin
+func ParseMaxCommute(text string) (time.Duration, string, error) {
+	matches := maxCommutePattern.FindStringSubmatch(text)
+	if matches == nil {
+		return 0, "", fmt.Errorf("max commute %q is not like \"45 minutes from Roskilde\"", text)
+	}
+
+	amount, _ := strconv.Atoi(matches[1])
+	unit := time.Minute
+	if strings.HasPrefix(strings.ToLower(matches[2]), "h") || strings.HasPrefix(strings.ToLower(matches[2]), "t") {
+		unit = time.Hour
+	}
+	return time.Duration(amount) * unit, matches[3], nil
+}
+
+// englishCityNames maps the English names LinkedIn uses to postal district names
+var englishCityNames = map[string]string{
+	"copenhagen": "København K",
+	"aarhus":     "Aarhus C",
+	"odense":     "Odense C",
+	"randers":    "Randers C",
+	"lyngby":     "Kongens Lyngby",
+}
+
+// postalCodePattern finds a Danish postal code followed by its district, e.g. "1620 København V"
+var postalCodePattern = regexp.MustCompile(`\b(\d{4})\s+\p{L}`)
+
+// Estimator scores jobs by the estimated commute from a candidate's home
+type Estimator struct {
+	centroids  *addresses.Centroids
+	home       addresses.Centroid
+	maxCommute time.Duration
+	mode       Mode
+}
+
+// NewEstimator creates an estimator for a home given as postal code or district name
+func NewEstimator(centroids *addresses.Centroids, home string, maxCommute time.Duration, mode Mode) (*Estimator, error) {
+	if maxCommute <= 0 {
+		return nil, fmt.Errorf("max commute must be positive, got %s", maxCommute)
+	}
+
+	e := &Estimator{centroids: centroids, maxCommute: maxCommute, mode: mode}
+	homeCentroid, ok := e.lookup(home)
+	if !ok {
+		return nil, fmt.Errorf("unknown home location %q", home)
+	}
+	e.home = homeCentroid
+	return e, nil
+}
+
+// Home returns the centroid commutes are measured from
+func (e *Estimator) Home() addresses.Centroid {
+	return e.home
+}
+
+// lookup finds the centroid of a postal code, "1620 København V", or a district or city name
+func (e *Estimator) lookup(place string) (addresses.Centroid, bool) {
+	place = strings.TrimSpace(place)
+	if centroid, ok := e.centroids.ByPostalCode(place); ok {
+		return centroid, true
+	}
+	if matches := postalCodePattern.FindStringSubmatch(place); matches != nil {
+		if centroid, ok := e.centroids.ByPostalCode(matches[1]); ok {
+			return centroid, true
+		}
+	}
+
+	// "Roskilde, Denmark" -> "Roskilde"
+	city := strings.TrimSpace(strings.Split(place, ",")[0])
+	if centroid, ok := e.centroids.ByCity(city); ok {
+		return centroid, true
+	}
+	if name, ok := englishCityNames[strings.ToLower(city)]; ok {
+		return e.centroids.ByCity(name)
+	}
+	return addresses.Centroid{}, false
+}
+
+// Estimate is the commute to one job
+type Estimate struct {
+	Destination addresses.Centroid
+	DistanceKm  float64
+	TravelTime  time.Duration
+}
+
+// EstimateJob estimates the commute to a job from its resolved address, falling back to
+// its city. It reports false when the job's location is not in the centroid table.
+func (e *Estimator) EstimateJob(job *models.JobPosting) (Estimate, bool) {
+	var places []string
+	if job.OpenaiAdresse != nil {
+		places = append(places, *job.OpenaiAdresse)
+	}
+	if job.LocationCity != nil {
+		places = append(places, *job.LocationCity)
+	}
+	places = append(places, job.Location)
+
+	for _, place := range places {
+		if destination, ok := e.lookup(place); ok {
+			distance := DistanceKm(e.home.Point, destination.Point)
+			return Estimate{Destination: destination, DistanceKm: distance, TravelTime: TravelTime(distance, e.mode)}, true
+		}
+	}
+	return Estimate{}, false
+}
+
+// Score turns a travel time into a 0-100 location score: 100 next door, 60 at the maximum
+// commute, falling to 0 at twice the maximum
+func (e *Estimator) Score(travelTime time.Duration) int {
+	ratio := float64(travelTime) / float64(e.maxCommute)
+	var score float64
+	if ratio <= 1 {
+		score = 100 - 40*ratio
+	} else {
+		score = 60 * (2 - ratio)
+	}
+	return int(math.Round(math.Max(0, math.Min(100, score))))
+}
+
+// LocationScore scores a job's location for the candidate. Remote jobs always score 100 and
+// hybrid jobs lose half as much for distance, as the trip isn't daily. Returns nil when the
+// job's location is unknown.
+func (e *Estimator) LocationScore(job *models.JobPosting) *int {
+	if jobWorkType(job) == models.WorkTypeRemote {
+		score := 100
+		return &score
+	}
+
+	estimate, ok := e.EstimateJob(job)
+	if !ok {
+		return nil
+	}
+
+	score := e.Score(estimate.TravelTime)
+	if jobWorkType(job) == models.WorkTypeHybrid {
+		score = 100 - (100-score)/2
+	}
+	return &score
+}
+
+// ApplyTo sets the rating's location score and records the commute estimate in its criteria
+func (e *Estimator) ApplyTo(rating *models.JobRating, job *models.JobPosting) {
+	rating.LocationScore = e.LocationScore(job)
+
+	estimate, ok := e.EstimateJob(job)
+	if !ok {
+		return
+	}
+	if rating.Criteria == nil {
+		criteria := models.RatingCriteria{}
+		rating.Criteria = &criteria
+	}
+	(*rating.Criteria)["commute_minutes"] = int(math.Round(estimate.TravelTime.Minutes()))
+	(*rating.Criteria)["commute_km"] = math.Round(estimate.DistanceKm*10) / 10
+	(*rating.Criteria)["commute_mode"] = string(e.mode)
+}
+
+// jobWorkType prefers the work type shown on the job page over the one in the location line
+func jobWorkType(job *models.JobPosting) string {
+	if job.WorkType != nil && *job.WorkType != "" {
+		return *job.WorkType
+	}
+	if job.WorkplaceType != nil {
+		return *job.WorkplaceType
+	}
+	return ""
+}
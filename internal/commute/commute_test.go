@@ -0,0 +1,113 @@
+package commute
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/addresses"
+	"linkedin-job-scraper/internal/models"
+)
+
+func TestDistanceKm(t *testing.T) {
+	roskilde := addresses.Point{Lat: 55.6415, Lon: 12.0803}
+	copenhagen := addresses.Point{Lat: 55.6786, Lon: 12.5790}
+
+	// Roskilde to Copenhagen is about 31 km in a straight line
+	if distance := DistanceKm(roskilde, copenhagen); math.Abs(distance-31.6) > 1 {
+		t.Errorf("DistanceKm(Roskilde, Copenhagen) = %.1f, expected about 31.6", distance)
+	}
+	if distance := DistanceKm(roskilde, roskilde); distance != 0 {
+		t.Errorf("DistanceKm to itself = %f, expected 0", distance)
+	}
+}
+
+func TestParseMaxCommute(t *testing.T) {
+	tests := []struct {
+		input    string
+		duration time.Duration
+		origin   string
+	}{
+		{"45 minutes from Roskilde", 45 * time.Minute, "Roskilde"},
+		{"1 hour from 2100", time.Hour, "2100"},
+		{"30 min fra København V", 30 * time.Minute, "København V"},
+	}
+
+	for _, tt := range tests {
+		duration, origin, err := ParseMaxCommute(tt.input)
+		if err != nil {
+			t.Errorf("ParseMaxCommute(%q) failed: %v", tt.input, err)
+			continue
+		}
+		if duration != tt.duration || origin != tt.origin {
+			t.Errorf("ParseMaxCommute(%q) = %s, %q, expected %s, %q", tt.input, duration, origin, tt.duration, tt.origin)
+		}
+	}
+
+	if _, _, err := ParseMaxCommute("close to home"); err == nil {
+		t.Error("expected an error for an unparseable max commute")
+	}
+}
+
+func TestLocationScore(t *testing.T) {
+	estimator, err := NewEstimator(addresses.DefaultCentroids(), "Roskilde, Denmark", 45*time.Minute, ModeCar)
+	if err != nil {
+		t.Fatalf("NewEstimator failed: %v", err)
+	}
+	if estimator.Home().PostalCode != "4000" {
+		t.Errorf("home = %s, expected 4000", estimator.Home().PostalCode)
+	}
+
+	strPtr := func(s string) *string { return &s }
+	remote, hybrid := models.WorkTypeRemote, models.WorkTypeHybrid
+
+	tests := []struct {
+		name     string
+		job      *models.JobPosting
+		expected *int
+	}{
+		// Roskilde to Copenhagen is about 31 km, a 45 minute drive by the estimate
+		{"same town", &models.JobPosting{Location: "Roskilde, Region Zealand, Denmark"}, intPtr(96)},
+		{"resolved address", &models.JobPosting{Location: "Denmark", OpenaiAdresse: strPtr("Vesterbrogade 12, 1620 København V")}, intPtr(61)},
+		{"english city name", &models.JobPosting{Location: "Copenhagen, Capital Region of Denmark"}, intPtr(59)},
+		{"beyond max commute", &models.JobPosting{Location: "Aarhus C"}, intPtr(0)},
+		{"hybrid halves the penalty", &models.JobPosting{Location: "Copenhagen", WorkType: &hybrid}, intPtr(80)},
+		{"remote", &models.JobPosting{Location: "Aalborg", WorkType: &remote}, intPtr(100)},
+		{"unknown location", &models.JobPosting{Location: "Berlin, Germany"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := estimator.LocationScore(tt.job)
+			switch {
+			case tt.expected == nil && score != nil:
+				t.Errorf("LocationScore = %d, expected nil", *score)
+			case tt.expected != nil && score == nil:
+				t.Errorf("LocationScore = nil, expected %d", *tt.expected)
+			case tt.expected != nil && *score != *tt.expected:
+				t.Errorf("LocationScore = %d, expected %d", *score, *tt.expected)
+			}
+		})
+	}
+}
+
+func TestApplyTo(t *testing.T) {
+	estimator, err := NewEstimator(addresses.DefaultCentroids(), "4000", 45*time.Minute, ModePublicTransport)
+	if err != nil {
+		t.Fatalf("NewEstimator failed: %v", err)
+	}
+
+	rating := &models.JobRating{}
+	estimator.ApplyTo(rating, &models.JobPosting{Location: "København K"})
+
+	if rating.LocationScore == nil {
+		t.Fatal("expected a location score")
+	}
+	if rating.Criteria == nil || (*rating.Criteria)["commute_mode"] != "public_transport" {
+		t.Errorf("expected commute details in criteria, got %v", rating.Criteria)
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
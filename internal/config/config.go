@@ -10,7 +10,17 @@ type Config struct {
 	Scraper  ScraperConfig
 	Redis    RedisConfig
 	API      APIConfig
+	Addresses AddressesConfig
+	AI       AIConfig
+	Embeddings EmbeddingsConfig
+	Skills   SkillsConfig
+	Storage  StorageConfig
+	Database DatabaseConfig
 	LogLevel string
+
+	// Standalone runs without Redis, the Laravel API and MySQL: jobs, companies, ratings
+	// and the queue are kept in the SQLite file and the cache in memory
+	Standalone bool
 }
 
 type LinkedInConfig struct {
@@ -25,6 +35,7 @@ type ScraperConfig struct {
 	HeadlessBrowser       bool
 	UserDataDir           string
 	ChromeExecutablePath  string
+	EnrichCompanies       bool // Visit the LinkedIn page of newly created companies
 }
 
 type RedisConfig struct {
@@ -34,6 +45,7 @@ type RedisConfig struct {
 	DB          int
 	CacheTTL    int
 	JobExistsTTL int
+	CompanyEnrichmentTTL int
 }
 
 type APIConfig struct {
@@ -41,8 +53,48 @@ type APIConfig struct {
 	APIKey  string
 }
 
+type AddressesConfig struct {
+	IndexPath     string // Deduplicated address CSV or binary index from cmd/trimmed-adresses
+	CentroidsPath string // Postal code centroid CSV from `addresses centroids`, empty for the bundled table
+}
+
+type AIConfig struct {
+	APIKey               string
+	BaseURL              string // Empty for api.openai.com, or any OpenAI-compatible server
+	Model                string
+	Concurrency          int     // Ratings in flight at the same time
+	MaxRetries           int     // Retries after rate limits, server errors and invalid responses
+	InputCostPerMillion  float64 // USD per million prompt tokens, for cost reporting
+	OutputCostPerMillion float64 // USD per million completion tokens
+}
+
+type EmbeddingsConfig struct {
+	Provider  string // openai (uses the AI API key and base URL) or hashing (local, no API)
+	Model     string
+	Store     string // file or redis
+	IndexPath string // Index file when Store is file
+}
+
+type SkillsConfig struct {
+	TaxonomyPath string // Canonical skills, aliases and categories used to normalise skill labels
+}
+
+type StorageConfig struct {
+	Backend    string // api (Laravel), mysql, sqlite or jsonl
+	SQLitePath string // Database file when Backend is sqlite
+	JSONLDir   string // Directory of jobs.jsonl and companies.jsonl when Backend is jsonl
+}
+
+type DatabaseConfig struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+	Name     string
+}
+
 func Load() *Config {
-	return &Config{
+	cfg := &Config{
 		LinkedIn: LinkedInConfig{
 			Email:    getEnv("LINKEDIN_EMAIL", ""),
 			Password: getEnv("LINKEDIN_PASSWORD", ""),
@@ -54,6 +106,7 @@ func Load() *Config {
 			HeadlessBrowser:       getEnvAsBool("HEADLESS_BROWSER", true), // Already defaults to true (headless)
 			UserDataDir:           getEnv("USER_DATA_DIR", "./chrome-profile"),
 			ChromeExecutablePath:  getEnv("CHROME_EXECUTABLE_PATH", "/usr/bin/chromium"),
+			EnrichCompanies:       getEnvAsBool("ENRICH_COMPANIES", true),
 		},
 		Redis: RedisConfig{
 			Host:         getEnv("REDIS_HOST", "127.0.0.1"),
@@ -62,13 +115,57 @@ func Load() *Config {
 			DB:           getEnvAsInt("REDIS_DB", 0),
 			CacheTTL:     getEnvAsInt("REDIS_CACHE_TTL", 300),
 			JobExistsTTL: getEnvAsInt("REDIS_JOB_EXISTS_TTL", 120),
+			CompanyEnrichmentTTL: getEnvAsInt("REDIS_COMPANY_ENRICHMENT_TTL", 604800), // 7 days
 		},
 		API: APIConfig{
 			BaseURL: getEnv("API_BASE_URL", "http://localhost:8082/api"),
 			APIKey:  getEnv("API_KEY", ""),
 		},
+		Addresses: AddressesConfig{
+			IndexPath:     getEnv("ADDRESS_INDEX_PATH", "cmd/trimmed-adresses/adresser_deduped.csv"),
+			CentroidsPath: getEnv("POSTAL_CENTROIDS_PATH", ""),
+		},
+		AI: AIConfig{
+			APIKey:               getEnv("OPENAI_API_KEY", ""),
+			BaseURL:              getEnv("OPENAI_BASE_URL", ""),
+			Model:                getEnv("OPENAI_MODEL", "gpt-4o-mini"),
+			Concurrency:          getEnvAsInt("AI_CONCURRENCY", 4),
+			MaxRetries:           getEnvAsInt("AI_MAX_RETRIES", 3),
+			InputCostPerMillion:  getEnvAsFloat("OPENAI_INPUT_COST_PER_1M", 0.15),
+			OutputCostPerMillion: getEnvAsFloat("OPENAI_OUTPUT_COST_PER_1M", 0.60),
+		},
+		Embeddings: EmbeddingsConfig{
+			Provider:  getEnv("EMBEDDING_PROVIDER", "openai"),
+			Model:     getEnv("EMBEDDING_MODEL", "text-embedding-3-small"),
+			Store:     getEnv("EMBEDDING_STORE", "file"),
+			IndexPath: getEnv("EMBEDDING_INDEX_PATH", "embeddings.idx"),
+		},
+		Skills: SkillsConfig{
+			TaxonomyPath: getEnv("SKILL_TAXONOMY_PATH", "skill_taxonomy.json"),
+		},
+		Storage: StorageConfig{
+			Backend:    getEnv("STORAGE_BACKEND", "api"),
+			SQLitePath: getEnv("SQLITE_PATH", "linkedin_jobs.db"),
+			JSONLDir:   getEnv("JSONL_DIR", "data"),
+		},
+		Database: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "127.0.0.1"),
+			Port:     getEnv("DB_PORT", "3307"),
+			User:     getEnv("DB_USER", "root"),
+			Password: getEnv("DB_PASSWORD", ""),
+			Name:     getEnv("DB_NAME", "linkedin_jobs"),
+		},
 		LogLevel: getEnv("LOG_LEVEL", "info"),
+		Standalone: getEnvAsBool("STANDALONE", false),
+	}
+
+	if cfg.Standalone {
+		cfg.Storage.Backend = "sqlite"
+		if cfg.Embeddings.Store == "redis" {
+			cfg.Embeddings.Store = "file"
+		}
 	}
+	return cfg
 }
 
 func getEnv(key, defaultVal string) string {
@@ -86,6 +183,14 @@ func getEnvAsInt(key string, defaultVal int) int {
 	return defaultVal
 }
 
+func getEnvAsFloat(key string, defaultVal float64) float64 {
+	strVal := getEnv(key, "")
+	if value, err := strconv.ParseFloat(strVal, 64); err == nil {
+		return value
+	}
+	return defaultVal
+}
+
 func getEnvAsBool(key string, defaultVal bool) bool {
 	strVal := getEnv(key, "")
 	if value, err := strconv.ParseBool(strVal); err == nil {
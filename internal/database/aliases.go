@@ -0,0 +1,59 @@
+package database
+
+import (
+	"fmt"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// CompanyAliasRepository handles company alias operations
+type CompanyAliasRepository struct {
+	db *DB
+}
+
+// NewCompanyAliasRepository creates a new company alias repository
+func NewCompanyAliasRepository(db *DB) *CompanyAliasRepository {
+	return &CompanyAliasRepository{db: db}
+}
+
+// Save records an alias, pointing an existing alias with the same normalised name at the new company
+func (r *CompanyAliasRepository) Save(alias *models.CompanyAlias) (*models.CompanyAlias, error) {
+	query := `INSERT INTO company_aliases (alias, normalised_name, company_id) VALUES (?, ?, ?)` +
+		r.db.upsert("normalised_name", "alias", "company_id")
+	if _, err := r.db.Exec(query, alias.Alias, alias.NormalisedName, alias.CompanyID); err != nil {
+		return nil, fmt.Errorf("failed to save company alias: %w", err)
+	}
+
+	saved := *alias
+	err := r.db.QueryRow(`SELECT alias_id FROM company_aliases WHERE normalised_name = ?`, alias.NormalisedName).
+		Scan(&saved.AliasID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alias id: %w", err)
+	}
+	return &saved, nil
+}
+
+// List returns all aliases with their canonical company name
+func (r *CompanyAliasRepository) List() ([]models.CompanyAlias, error) {
+	query := `
+		SELECT a.alias_id, a.alias, a.normalised_name, a.company_id, c.name
+		FROM company_aliases a
+		JOIN companies c ON a.company_id = c.company_id
+		ORDER BY a.alias_id
+	`
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var aliases []models.CompanyAlias
+	for rows.Next() {
+		var a models.CompanyAlias
+		if err := rows.Scan(&a.AliasID, &a.Alias, &a.NormalisedName, &a.CompanyID, &a.CompanyName); err != nil {
+			return nil, fmt.Errorf("failed to scan company alias: %w", err)
+		}
+		aliases = append(aliases, a)
+	}
+	return aliases, rows.Err()
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"linkedin-job-scraper/internal/config"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Drivers supported by Open
+const (
+	DriverMySQL  = "mysql"
+	DriverSQLite = "sqlite3"
+)
+
+// DB is a database connection used by the repositories
+type DB struct {
+	*sql.DB
+	Driver string
+}
+
+// OpenMySQL connects to the MySQL database behind the Laravel API. The Laravel migrations
+// create the schema; MigrateMySQL adds what the repositories need on top of it. Returns an
+// error listing the missing tables and columns if it hasn't been run.
+func OpenMySQL(cfg *config.DatabaseConfig) (*DB, error) {
+	db, err := connectMySQL(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	missing, err := missingMySQLSchema(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	if !missing.empty() {
+		db.Close()
+		return nil, fmt.Errorf("MySQL schema lacks %s, run `linkedin-scraper migrate` first", missing)
+	}
+	return &DB{DB: db, Driver: DriverMySQL}, nil
+}
+
+func connectMySQL(cfg *config.DatabaseConfig) (*sql.DB, error) {
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&charset=utf8mb4&loc=UTC",
+		cfg.User, cfg.Password, cfg.Host, cfg.Port, cfg.Name)
+
+	db, err := sql.Open(DriverMySQL, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open MySQL database: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to MySQL at %s:%s: %w", cfg.Host, cfg.Port, err)
+	}
+	return db, nil
+}
+
+// OpenSQLite opens or creates an SQLite database file and creates any missing tables
+func OpenSQLite(path string) (*DB, error) {
+	db, err := sql.Open(DriverSQLite, path+"?_foreign_keys=on&_busy_timeout=5000")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SQLite database: %w", err)
+	}
+	// SQLite allows one writer at a time; sharing one connection avoids "database is locked"
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create SQLite schema in %s: %w", path, err)
+	}
+	return &DB{DB: db, Driver: DriverSQLite}, nil
+}
+
+// upsert returns the clause that turns an INSERT into an update of columns when a row with the
+// same conflict key (a unique index) already exists
+func (db *DB) upsert(conflict string, columns ...string) string {
+	assignments := make([]string, len(columns))
+	for i, column := range columns {
+		if db.Driver == DriverSQLite {
+			assignments[i] = column + " = excluded." + column
+		} else {
+			assignments[i] = column + " = VALUES(" + column + ")"
+		}
+	}
+	if db.Driver == DriverSQLite {
+		return " ON CONFLICT (" + conflict + ") DO UPDATE SET " + strings.Join(assignments, ", ")
+	}
+	return " ON DUPLICATE KEY UPDATE " + strings.Join(assignments, ", ")
+}
@@ -0,0 +1,214 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"linkedin-job-scraper/internal/config"
+
+	"github.com/sirupsen/logrus"
+)
+
+// mysqlColumn is a column the repositories write that the Laravel migrations don't create
+type mysqlColumn struct {
+	table, name, definition string
+}
+
+// mysqlColumns extend the Laravel tables (see backups/*.sql) with the columns written by the
+// repositories, in the order they are added
+var mysqlColumns = []mysqlColumn{
+	{"companies", "image_url", "VARCHAR(2048) NOT NULL DEFAULT ''"},
+	{"companies", "linkedin_company_id", "VARCHAR(32) NULL UNIQUE"},
+	{"companies", "linkedin_slug", "VARCHAR(255) NULL UNIQUE"},
+	{"companies", "linkedin_url", "VARCHAR(512) NOT NULL DEFAULT ''"},
+	{"companies", "employee_range", "VARCHAR(50) NULL"},
+	{"companies", "employee_count_min", "INT NULL"},
+	{"companies", "employee_count_max", "INT NULL"},
+	{"companies", "industry", "VARCHAR(255) NULL"},
+	{"companies", "headquarters", "VARCHAR(255) NULL"},
+	{"companies", "website", "VARCHAR(2048) NULL"},
+	{"companies", "follower_count", "INT NULL"},
+	{"companies", "enriched_at", "DATETIME NULL"},
+
+	{"job_postings", "posted_date_source", "VARCHAR(20) NULL"},
+	{"job_postings", "skill_ids", "JSON NULL"},
+	{"job_postings", "skills_source", "VARCHAR(20) NULL"},
+	{"job_postings", "job_post_closed_date", "DATETIME NULL"},
+	{"job_postings", "salary_min", "DOUBLE NULL"},
+	{"job_postings", "salary_max", "DOUBLE NULL"},
+	{"job_postings", "salary_currency", "VARCHAR(3) NULL"},
+	{"job_postings", "salary_period", "VARCHAR(10) NULL"},
+	{"job_postings", "salary_annual_min_dkk", "INT NULL"},
+	{"job_postings", "salary_annual_max_dkk", "INT NULL"},
+	{"job_postings", "seniority_level", "VARCHAR(50) NULL"},
+	{"job_postings", "employment_type", "VARCHAR(50) NULL"},
+	{"job_postings", "job_function", "VARCHAR(500) NULL"},
+	{"job_postings", "industries", "VARCHAR(500) NULL"},
+	{"job_postings", "location_city", "VARCHAR(255) NULL"},
+	{"job_postings", "location_region", "VARCHAR(255) NULL"},
+	{"job_postings", "location_country", "VARCHAR(255) NULL"},
+	{"job_postings", "country_code", "VARCHAR(2) NULL"},
+	{"job_postings", "place_key", "VARCHAR(255) NULL"},
+	{"job_postings", "workplace_type", "VARCHAR(20) NULL"},
+	{"job_postings", "fingerprint", "VARCHAR(16) NULL"},
+	{"job_postings", "repost_of_job_id", "INT NULL"},
+}
+
+// mysqlTables are the tables of the MySQL backend that the Laravel migrations don't create
+var mysqlTables = map[string]string{
+	"company_aliases": `
+		CREATE TABLE IF NOT EXISTS company_aliases (
+			alias_id        INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			alias           VARCHAR(255) NOT NULL,
+			normalised_name VARCHAR(255) NOT NULL UNIQUE,
+			company_id      INT NOT NULL,
+			created_at      TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at      TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+			FOREIGN KEY (company_id) REFERENCES companies (company_id) ON DELETE CASCADE
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci`,
+	"job_snapshots": `
+		CREATE TABLE IF NOT EXISTS job_snapshots (
+			snapshot_id      INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			job_id           INT NOT NULL,
+			linkedin_job_id  BIGINT NOT NULL,
+			captured_at      DATETIME NOT NULL,
+			applicants       INT NULL,
+			title            VARCHAR(255) NOT NULL,
+			description_hash VARCHAR(64) NOT NULL,
+			work_type        VARCHAR(50) NULL,
+			is_open          TINYINT(1) NOT NULL,
+			KEY idx_linkedin_job_id (linkedin_job_id),
+			FOREIGN KEY (job_id) REFERENCES job_postings (job_id) ON DELETE CASCADE
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci`,
+	"scrape_runs": `
+		CREATE TABLE IF NOT EXISTS scrape_runs (
+			run_id      INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			command     VARCHAR(50) NOT NULL,
+			arguments   TEXT NULL,
+			started_at  DATETIME NOT NULL,
+			finished_at DATETIME NOT NULL,
+			status      VARCHAR(20) NOT NULL,
+			error       TEXT NULL,
+			KEY idx_started_at (started_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci`,
+}
+
+// mysqlSchema is what the Laravel schema lacks for the MySQL backend
+type mysqlSchema struct {
+	tables           []string
+	columns          []mysqlColumn
+	uniqueNameIndex  string // Different LinkedIn companies can share a name
+	postedDateIsDate bool   // posted_date must hold the exact time a job was posted
+}
+
+func (m *mysqlSchema) empty() bool {
+	return len(m.tables) == 0 && len(m.columns) == 0 && m.uniqueNameIndex == "" && !m.postedDateIsDate
+}
+
+// String lists the missing tables and columns
+func (m *mysqlSchema) String() string {
+	var missing []string
+	for _, table := range m.tables {
+		missing = append(missing, "table "+table)
+	}
+	for _, column := range m.columns {
+		missing = append(missing, column.table+"."+column.name)
+	}
+	if m.uniqueNameIndex != "" {
+		missing = append(missing, "non-unique companies.name")
+	}
+	if m.postedDateIsDate {
+		missing = append(missing, "DATETIME job_postings.posted_date")
+	}
+	return strings.Join(missing, ", ")
+}
+
+// missingMySQLSchema compares the database with the tables and columns the repositories use
+func missingMySQLSchema(db *sql.DB) (*mysqlSchema, error) {
+	rows, err := db.Query(`
+		SELECT table_name, column_name, data_type FROM information_schema.columns
+		WHERE table_schema = DATABASE()
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read MySQL schema: %w", err)
+	}
+	defer rows.Close()
+
+	tables := make(map[string]bool)
+	columns := make(map[string]string)
+	for rows.Next() {
+		var table, column, dataType string
+		if err := rows.Scan(&table, &column, &dataType); err != nil {
+			return nil, fmt.Errorf("failed to read MySQL schema: %w", err)
+		}
+		tables[table] = true
+		columns[table+"."+column] = dataType
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read MySQL schema: %w", err)
+	}
+
+	missing := &mysqlSchema{postedDateIsDate: columns["job_postings.posted_date"] == "date"}
+	for table := range mysqlTables {
+		if !tables[table] {
+			missing.tables = append(missing.tables, table)
+		}
+	}
+	sort.Strings(missing.tables)
+	for _, column := range mysqlColumns {
+		if _, ok := columns[column.table+"."+column.name]; !ok {
+			missing.columns = append(missing.columns, column)
+		}
+	}
+
+	err = db.QueryRow(`
+		SELECT index_name FROM information_schema.statistics
+		WHERE table_schema = DATABASE() AND table_name = 'companies' AND column_name = 'name' AND non_unique = 0
+		LIMIT 1
+	`).Scan(&missing.uniqueNameIndex)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to read MySQL indexes: %w", err)
+	}
+	return missing, nil
+}
+
+// MigrateMySQL adds the tables and columns the MySQL backend writes to the schema created by
+// the Laravel migrations. It only changes what is missing, so it can be run repeatedly.
+func MigrateMySQL(cfg *config.DatabaseConfig) error {
+	db, err := connectMySQL(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	missing, err := missingMySQLSchema(db)
+	if err != nil {
+		return err
+	}
+
+	var statements []string
+	for _, column := range missing.columns {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", column.table, column.name, column.definition))
+	}
+	if missing.uniqueNameIndex != "" {
+		// Laravel's idx_name keeps name lookups fast
+		statements = append(statements, "ALTER TABLE companies DROP INDEX `"+missing.uniqueNameIndex+"`")
+	}
+	if missing.postedDateIsDate {
+		statements = append(statements, "ALTER TABLE job_postings MODIFY posted_date DATETIME NULL")
+	}
+	for _, table := range missing.tables {
+		statements = append(statements, mysqlTables[table])
+	}
+
+	for _, statement := range statements {
+		logrus.Debugf("🗄️  %s", strings.Join(strings.Fields(statement), " "))
+		if _, err := db.Exec(statement); err != nil {
+			return fmt.Errorf("failed to migrate MySQL schema: %w", err)
+		}
+	}
+	logrus.Infof("✅ MySQL schema up to date (%d changes applied)", len(statements))
+	return nil
+}
@@ -0,0 +1,84 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// RatingRepository handles job rating operations
+type RatingRepository struct {
+	db *DB
+}
+
+// NewRatingRepository creates a new rating repository
+func NewRatingRepository(db *DB) *RatingRepository {
+	return &RatingRepository{db: db}
+}
+
+// Save creates a rating, replacing an earlier rating of the same type for the job
+func (r *RatingRepository) Save(rating *models.JobRating) (*models.JobRating, error) {
+	saved := *rating
+	if saved.RatedAt.IsZero() {
+		saved.RatedAt = time.Now()
+	}
+
+	query := `
+		INSERT INTO job_ratings (job_id, overall_score, location_score, tech_score, team_size_score,
+		                         leadership_score, criteria, rating_type, rated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)` +
+		r.db.upsert("job_id, rating_type", "overall_score", "location_score", "tech_score", "team_size_score",
+			"leadership_score", "criteria", "rated_at")
+	_, err := r.db.Exec(query,
+		saved.JobID,
+		saved.OverallScore,
+		saved.LocationScore,
+		saved.TechScore,
+		saved.TeamSizeScore,
+		saved.LeadershipScore,
+		saved.Criteria,
+		saved.RatingType,
+		saved.RatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save rating: %w", err)
+	}
+
+	// LastInsertId isn't reliable when the row was updated
+	err = r.db.QueryRow(`SELECT rating_id FROM job_ratings WHERE job_id = ? AND rating_type = ?`,
+		saved.JobID, saved.RatingType).Scan(&saved.RatingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rating id: %w", err)
+	}
+	return &saved, nil
+}
+
+// ListByJobID returns the ratings of a job, one per rating type
+func (r *RatingRepository) ListByJobID(jobID int) ([]models.JobRating, error) {
+	query := `
+		SELECT rating_id, job_id, overall_score, location_score, tech_score, team_size_score,
+		       leadership_score, criteria, rating_type, rated_at
+		FROM job_ratings
+		WHERE job_id = ?
+		ORDER BY rating_type
+	`
+	rows, err := r.db.Query(query, jobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ratings []models.JobRating
+	for rows.Next() {
+		var rating models.JobRating
+		err := rows.Scan(&rating.RatingID, &rating.JobID, &rating.OverallScore, &rating.LocationScore,
+			&rating.TechScore, &rating.TeamSizeScore, &rating.LeadershipScore, &rating.Criteria,
+			&rating.RatingType, &rating.RatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, rating)
+	}
+	return ratings, rows.Err()
+}
@@ -4,11 +4,53 @@ import (
 	"database/sql"
 	"fmt"
 	"linkedin-job-scraper/internal/models"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
 )
 
+// companyColumns are the companies columns read by scanCompany, in order
+const companyColumns = `c.company_id, c.name, COALESCE(c.image_url, ''), COALESCE(c.linkedin_company_id, ''),
+	COALESCE(c.linkedin_slug, ''), COALESCE(c.linkedin_url, ''), c.employee_range, c.employee_count_min,
+	c.employee_count_max, c.industry, c.headquarters, c.website, c.follower_count, c.enriched_at`
+
+// scanner is a *sql.Row or *sql.Rows
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// nullIfEmpty stores an unknown LinkedIn identity as NULL, so the unique keys on the
+// identity columns only apply to known values
+func nullIfEmpty(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func scanCompany(row scanner) (*models.Company, error) {
+	var company models.Company
+	err := row.Scan(
+		&company.CompanyID,
+		&company.Name,
+		&company.ImageURL,
+		&company.LinkedInCompanyID,
+		&company.LinkedInSlug,
+		&company.LinkedInURL,
+		&company.EmployeeRange,
+		&company.EmployeeCountMin,
+		&company.EmployeeCountMax,
+		&company.Industry,
+		&company.Headquarters,
+		&company.Website,
+		&company.FollowerCount,
+		&company.EnrichedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &company, nil
+}
+
 // CompanyRepository handles company-related database operations
 type CompanyRepository struct {
 	db *DB
@@ -29,16 +71,34 @@ func (r *CompanyRepository) CreateOrGet(name string) (*models.Company, error) {
 
 	// If not found, create new one
 	if err == sql.ErrNoRows {
-		return r.Create(name)
+		return r.Create(&models.Company{Name: name})
 	}
 
 	return nil, err
 }
 
 // Create creates a new company
-func (r *CompanyRepository) Create(name string) (*models.Company, error) {
-	query := `INSERT INTO companies (name) VALUES (?)`
-	result, err := r.db.Exec(query, name)
+func (r *CompanyRepository) Create(company *models.Company) (*models.Company, error) {
+	query := `
+		INSERT INTO companies (name, image_url, linkedin_company_id, linkedin_slug, linkedin_url, employee_range,
+		                       employee_count_min, employee_count_max, industry, headquarters, website, follower_count, enriched_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	result, err := r.db.Exec(query,
+		company.Name,
+		company.ImageURL,
+		nullIfEmpty(company.LinkedInCompanyID),
+		nullIfEmpty(company.LinkedInSlug),
+		company.LinkedInURL,
+		company.EmployeeRange,
+		company.EmployeeCountMin,
+		company.EmployeeCountMax,
+		company.Industry,
+		company.Headquarters,
+		company.Website,
+		company.FollowerCount,
+		company.EnrichedAt,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create company: %w", err)
 	}
@@ -48,32 +108,222 @@ func (r *CompanyRepository) Create(name string) (*models.Company, error) {
 		return nil, fmt.Errorf("failed to get last insert id: %w", err)
 	}
 
-	return &models.Company{
-		CompanyID: int(id),
-		Name:      name,
-	}, nil
+	created := *company
+	created.CompanyID = int(id)
+	return &created, nil
+}
+
+// Update saves all columns of an existing company
+func (r *CompanyRepository) Update(company *models.Company) error {
+	query := `
+		UPDATE companies SET name = ?, image_url = ?, linkedin_company_id = ?, linkedin_slug = ?, linkedin_url = ?,
+		       employee_range = ?, employee_count_min = ?, employee_count_max = ?, industry = ?, headquarters = ?,
+		       website = ?, follower_count = ?, enriched_at = ?, updated_at = ?
+		WHERE company_id = ?
+	`
+	_, err := r.db.Exec(query,
+		company.Name,
+		company.ImageURL,
+		nullIfEmpty(company.LinkedInCompanyID),
+		nullIfEmpty(company.LinkedInSlug),
+		company.LinkedInURL,
+		company.EmployeeRange,
+		company.EmployeeCountMin,
+		company.EmployeeCountMax,
+		company.Industry,
+		company.Headquarters,
+		company.Website,
+		company.FollowerCount,
+		company.EnrichedAt,
+		time.Now(),
+		company.CompanyID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update company: %w", err)
+	}
+	return nil
 }
 
 // GetByName finds a company by name
 func (r *CompanyRepository) GetByName(name string) (*models.Company, error) {
-	query := `SELECT company_id, name FROM companies WHERE name = ?`
-	var company models.Company
-	err := r.db.QueryRow(query, name).Scan(&company.CompanyID, &company.Name)
+	query := `SELECT ` + companyColumns + ` FROM companies c WHERE c.name = ?`
+	return scanCompany(r.db.QueryRow(query, name))
+}
+
+// GetByID finds a company by ID
+func (r *CompanyRepository) GetByID(id int) (*models.Company, error) {
+	query := `SELECT ` + companyColumns + ` FROM companies c WHERE c.company_id = ?`
+	return scanCompany(r.db.QueryRow(query, id))
+}
+
+// Find looks up a company by its LinkedIn company ID, then its LinkedIn slug, then its name.
+// Returns sql.ErrNoRows if none matches.
+func (r *CompanyRepository) Find(ref *models.Company) (*models.Company, error) {
+	lookups := []struct {
+		column, value string
+	}{
+		{"linkedin_company_id", ref.LinkedInCompanyID},
+		{"linkedin_slug", ref.LinkedInSlug},
+		{"name", ref.Name},
+	}
+	for _, lookup := range lookups {
+		if lookup.value == "" {
+			continue
+		}
+		query := `SELECT ` + companyColumns + ` FROM companies c WHERE c.` + lookup.column + ` = ? ORDER BY c.company_id LIMIT 1`
+		company, err := scanCompany(r.db.QueryRow(query, lookup.value))
+		if err != sql.ErrNoRows {
+			return company, err
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+// List returns companies by ID
+func (r *CompanyRepository) List(filter models.CompanyFilter) ([]models.Company, error) {
+	var where []string
+	var args []interface{}
+	if filter.NeedsEnrichment {
+		where = append(where, "COALESCE(c.linkedin_url, '') <> '' AND c.enriched_at IS NULL")
+	}
+	if filter.Name != "" {
+		where = append(where, "LOWER(c.name) LIKE ?")
+		args = append(args, "%"+strings.ToLower(filter.Name)+"%")
+	}
+
+	query := `SELECT ` + companyColumns + ` FROM companies c`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, " AND ")
+	}
+	query += ` ORDER BY c.company_id`
+	if filter.Limit > 0 {
+		query += ` LIMIT ? OFFSET ?`
+		args = append(args, filter.Limit, filter.Offset)
+	}
+
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
-	return &company, nil
+	defer rows.Close()
+
+	var companies []models.Company
+	for rows.Next() {
+		company, err := scanCompany(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan company: %w", err)
+		}
+		companies = append(companies, *company)
+	}
+	return companies, rows.Err()
 }
 
-// GetByID finds a company by ID
-func (r *CompanyRepository) GetByID(id int) (*models.Company, error) {
-	query := `SELECT company_id, name FROM companies WHERE company_id = ?`
-	var company models.Company
-	err := r.db.QueryRow(query, id).Scan(&company.CompanyID, &company.Name)
+// Merge moves the jobs and aliases of the duplicate companies to the canonical company and
+// deletes the duplicates. Returns the number of jobs moved.
+func (r *CompanyRepository) Merge(canonicalID int, duplicateIDs []int) (int, error) {
+	if len(duplicateIDs) == 0 {
+		return 0, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(duplicateIDs)), ", ")
+	args := []interface{}{canonicalID}
+	for _, id := range duplicateIDs {
+		args = append(args, id)
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`UPDATE job_postings SET company_id = ? WHERE company_id IN (`+placeholders+`)`, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to move jobs: %w", err)
+	}
+	moved, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if _, err := tx.Exec(`UPDATE company_aliases SET company_id = ? WHERE company_id IN (`+placeholders+`)`, args...); err != nil {
+		return 0, fmt.Errorf("failed to move company aliases: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM companies WHERE company_id IN (`+placeholders+`)`, args[1:]...); err != nil {
+		return 0, fmt.Errorf("failed to delete merged companies: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit merge: %w", err)
+	}
+	return int(moved), nil
+}
+
+// Names returns the names of all companies
+func (r *CompanyRepository) Names() ([]string, error) {
+	rows, err := r.db.Query(`SELECT name FROM companies`)
 	if err != nil {
 		return nil, err
 	}
-	return &company, nil
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+// jobColumns are the job_postings columns written by JobPostingRepository, in the order of jobValues
+const jobColumns = `linkedin_job_id, title, company_id, location, description, apply_url, posted_date, posted_date_source,
+	applicants, work_type, skills, skill_ids, skills_source, openai_adresse, job_post_closed_date,
+	salary_min, salary_max, salary_currency, salary_period, salary_annual_min_dkk, salary_annual_max_dkk,
+	seniority_level, employment_type, job_function, industries,
+	location_city, location_region, location_country, country_code, place_key, workplace_type,
+	fingerprint, repost_of_job_id`
+
+// jobSelect reads the columns scanned by scanJob, with the company name joined
+const jobSelect = `
+	SELECT jp.job_id, jp.linkedin_job_id, jp.title, jp.company_id, COALESCE(jp.location, ''),
+	       COALESCE(jp.description, ''), COALESCE(jp.apply_url, ''), jp.posted_date, jp.posted_date_source,
+	       jp.applicants, jp.work_type, jp.skills, jp.skill_ids, jp.skills_source, jp.openai_adresse, jp.job_post_closed_date,
+	       jp.salary_min, jp.salary_max, jp.salary_currency, jp.salary_period, jp.salary_annual_min_dkk, jp.salary_annual_max_dkk,
+	       jp.seniority_level, jp.employment_type, jp.job_function, jp.industries,
+	       jp.location_city, jp.location_region, jp.location_country, jp.country_code, jp.place_key, jp.workplace_type,
+	       jp.fingerprint, jp.repost_of_job_id, COALESCE(c.name, ''), COALESCE(c.image_url, '')
+	FROM job_postings jp
+	LEFT JOIN companies c ON jp.company_id = c.company_id
+`
+
+// jobValues returns the values of jobColumns
+func jobValues(job *models.JobPosting) []interface{} {
+	return []interface{}{
+		job.LinkedInJobID, job.Title, job.CompanyID, job.Location, job.Description, job.ApplyURL, job.PostedDate, job.PostedDateSource,
+		job.Applicants, job.WorkType, job.Skills, job.SkillIDs, job.SkillsSource, job.OpenaiAdresse, job.JobPostClosedDate,
+		job.SalaryMin, job.SalaryMax, job.SalaryCurrency, job.SalaryPeriod, job.SalaryAnnualMinDKK, job.SalaryAnnualMaxDKK,
+		job.SeniorityLevel, job.EmploymentType, job.JobFunction, job.Industries,
+		job.LocationCity, job.LocationRegion, job.LocationCountry, job.CountryCode, job.PlaceKey, job.WorkplaceType,
+		job.Fingerprint, job.RepostOfJobID,
+	}
+}
+
+func scanJob(row scanner) (*models.JobPosting, error) {
+	var job models.JobPosting
+	err := row.Scan(
+		&job.JobID, &job.LinkedInJobID, &job.Title, &job.CompanyID, &job.Location,
+		&job.Description, &job.ApplyURL, &job.PostedDate, &job.PostedDateSource,
+		&job.Applicants, &job.WorkType, &job.Skills, &job.SkillIDs, &job.SkillsSource, &job.OpenaiAdresse, &job.JobPostClosedDate,
+		&job.SalaryMin, &job.SalaryMax, &job.SalaryCurrency, &job.SalaryPeriod, &job.SalaryAnnualMinDKK, &job.SalaryAnnualMaxDKK,
+		&job.SeniorityLevel, &job.EmploymentType, &job.JobFunction, &job.Industries,
+		&job.LocationCity, &job.LocationRegion, &job.LocationCountry, &job.CountryCode, &job.PlaceKey, &job.WorkplaceType,
+		&job.Fingerprint, &job.RepostOfJobID, &job.CompanyName, &job.CompanyImageURL,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
 }
 
 // JobPostingRepository handles job posting-related database operations
@@ -88,22 +338,9 @@ func NewJobPostingRepository(db *DB) *JobPostingRepository {
 
 // Create creates a new job posting
 func (r *JobPostingRepository) Create(job *models.JobPosting) (*models.JobPosting, error) {
-	query := `
-		INSERT INTO job_postings (linkedin_job_id, title, company_id, location, description, apply_url, posted_date, applicants, work_type, skills)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`
-	result, err := r.db.Exec(query,
-		job.LinkedInJobID,
-		job.Title,
-		job.CompanyID,
-		job.Location,
-		job.Description,
-		job.ApplyURL,
-		job.PostedDate,
-		job.Applicants,
-		job.WorkType,
-		job.Skills,
-	)
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", strings.Count(jobColumns, ",")+1), ", ")
+	query := `INSERT INTO job_postings (` + jobColumns + `) VALUES (` + placeholders + `)`
+	result, err := r.db.Exec(query, jobValues(job)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create job posting: %w", err)
 	}
@@ -117,6 +354,21 @@ func (r *JobPostingRepository) Create(job *models.JobPosting) (*models.JobPostin
 	return job, nil
 }
 
+// Update saves all columns of an existing job posting
+func (r *JobPostingRepository) Update(job *models.JobPosting) error {
+	var assignments []string
+	for _, column := range strings.Split(jobColumns, ",") {
+		assignments = append(assignments, strings.TrimSpace(column)+" = ?")
+	}
+	query := `UPDATE job_postings SET ` + strings.Join(assignments, ", ") + `, updated_at = ? WHERE job_id = ?`
+
+	args := append(jobValues(job), time.Now(), job.JobID)
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("failed to update job posting: %w", err)
+	}
+	return nil
+}
+
 // ExistsLinkedInJobID checks if a LinkedIn job ID already exists
 func (r *JobPostingRepository) ExistsLinkedInJobID(linkedinJobID int64) (bool, error) {
 	query := `SELECT COUNT(*) FROM job_postings WHERE linkedin_job_id = ?`
@@ -130,45 +382,12 @@ func (r *JobPostingRepository) ExistsLinkedInJobID(linkedinJobID int64) (bool, e
 
 // GetByLinkedInJobID finds a job posting by LinkedIn job ID
 func (r *JobPostingRepository) GetByLinkedInJobID(linkedinJobID int64) (*models.JobPosting, error) {
-	query := `
-		SELECT jp.job_id, jp.linkedin_job_id, jp.title, jp.company_id, jp.location, 
-		       jp.description, jp.apply_url, jp.posted_date, jp.applicants, jp.work_type, jp.skills, c.name as company_name
-		FROM job_postings jp
-		JOIN companies c ON jp.company_id = c.company_id
-		WHERE jp.linkedin_job_id = ?
-	`
-	var job models.JobPosting
-	err := r.db.QueryRow(query, linkedinJobID).Scan(
-		&job.JobID,
-		&job.LinkedInJobID,
-		&job.Title,
-		&job.CompanyID,
-		&job.Location,
-		&job.Description,
-		&job.ApplyURL,
-		&job.PostedDate,
-		&job.Applicants,
-		&job.WorkType,
-		&job.Skills,
-		&job.CompanyName,
-	)
-	if err != nil {
-		return nil, err
-	}
-	return &job, nil
+	return scanJob(r.db.QueryRow(jobSelect+`WHERE jp.linkedin_job_id = ?`, linkedinJobID))
 }
 
 // GetRecent returns recent job postings
 func (r *JobPostingRepository) GetRecent(limit int) ([]*models.JobPosting, error) {
-	query := `
-		SELECT jp.job_id, jp.linkedin_job_id, jp.title, jp.company_id, jp.location, 
-		       jp.description, jp.apply_url, jp.posted_date, jp.applicants, jp.work_type, jp.skills, c.name as company_name
-		FROM job_postings jp
-		JOIN companies c ON jp.company_id = c.company_id
-		ORDER BY jp.created_at DESC
-		LIMIT ?
-	`
-	rows, err := r.db.Query(query, limit)
+	rows, err := r.db.Query(jobSelect+`ORDER BY jp.created_at DESC LIMIT ?`, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -176,31 +395,171 @@ func (r *JobPostingRepository) GetRecent(limit int) ([]*models.JobPosting, error
 
 	var jobs []*models.JobPosting
 	for rows.Next() {
-		var job models.JobPosting
-		err := rows.Scan(
-			&job.JobID,
-			&job.LinkedInJobID,
-			&job.Title,
-			&job.CompanyID,
-			&job.Location,
-			&job.Description,
-			&job.ApplyURL,
-			&job.PostedDate,
-			&job.Applicants,
-			&job.WorkType,
-			&job.Skills,
-			&job.CompanyName,
-		)
+		job, err := scanJob(rows)
 		if err != nil {
 			logrus.Error("Error scanning job posting: ", err)
 			continue
 		}
-		jobs = append(jobs, &job)
+		jobs = append(jobs, job)
 	}
 
 	return jobs, nil
 }
 
+// List returns the job postings matching filter
+func (r *JobPostingRepository) List(filter models.JobFilter) ([]models.JobPosting, error) {
+	var where []string
+	var args []interface{}
+	if filter.LinkedInJobID != 0 {
+		where = append(where, "jp.linkedin_job_id = ?")
+		args = append(args, filter.LinkedInJobID)
+	}
+	if filter.Open {
+		where = append(where, "jp.job_post_closed_date IS NULL")
+	}
+	if filter.Closed {
+		where = append(where, "jp.job_post_closed_date IS NOT NULL")
+	}
+	if !filter.PostedAfter.IsZero() {
+		where = append(where, "jp.posted_date >= ?")
+		args = append(args, filter.PostedAfter)
+	}
+	if !filter.PostedBefore.IsZero() {
+		where = append(where, "jp.posted_date < ?")
+		args = append(args, filter.PostedBefore)
+	}
+	if !filter.OpenOrPostedBefore.IsZero() {
+		where = append(where, "(jp.job_post_closed_date IS NULL OR jp.posted_date < ?)")
+		args = append(args, filter.OpenOrPostedBefore)
+	}
+	for _, skill := range filter.Skills {
+		// Skill lists are JSON arrays of strings, so match the quoted value
+		pattern := "%" + strconv.Quote(strings.ToLower(skill)) + "%"
+		where = append(where, "(LOWER(jp.skill_ids) LIKE ? OR LOWER(jp.skills) LIKE ?)")
+		args = append(args, pattern, pattern)
+	}
+	if filter.CompanyID != 0 {
+		where = append(where, "jp.company_id = ?")
+		args = append(args, filter.CompanyID)
+	}
+	if filter.Company != "" {
+		where = append(where, "LOWER(c.name) LIKE ?")
+		args = append(args, "%"+strings.ToLower(filter.Company)+"%")
+	}
+	if filter.WorkType != "" {
+		where = append(where, "LOWER(jp.work_type) = ?")
+		args = append(args, strings.ToLower(filter.WorkType))
+	}
+	if filter.MinRating > 0 {
+		rated := "EXISTS (SELECT 1 FROM job_ratings r WHERE r.job_id = jp.job_id AND r.overall_score >= ?"
+		args = append(args, filter.MinRating)
+		if filter.RatingType != "" {
+			rated += " AND r.rating_type = ?"
+			args = append(args, filter.RatingType)
+		}
+		where = append(where, rated+")")
+	}
+	if filter.MissingFingerprint {
+		where = append(where, "jp.fingerprint IS NULL")
+	}
+	if filter.MissingAddress {
+		where = append(where, "jp.openai_adresse IS NULL")
+	}
+	if filter.MissingSkillIDs {
+		where = append(where, "jp.skill_ids IS NULL")
+	}
+	if filter.UnratedBy != "" {
+		where = append(where, "NOT EXISTS (SELECT 1 FROM job_ratings r WHERE r.job_id = jp.job_id AND r.rating_type = ?)")
+		args = append(args, filter.UnratedBy)
+	}
+
+	query := jobSelect
+	if len(where) > 0 {
+		query += `WHERE ` + strings.Join(where, " AND ")
+	}
+	switch {
+	case filter.LeastRefreshed:
+		// NULL, never snapshotted, sorts first in both SQLite and MySQL
+		query += ` ORDER BY (SELECT MAX(s.captured_at) FROM job_snapshots s WHERE s.linkedin_job_id = jp.linkedin_job_id),
+			jp.posted_date, jp.job_id`
+	case filter.NewestFirst:
+		query += ` ORDER BY jp.posted_date DESC, jp.job_id DESC`
+	default:
+		query += ` ORDER BY jp.posted_date, jp.job_id`
+	}
+	if filter.Limit > 0 {
+		query += ` LIMIT ? OFFSET ?`
+		args = append(args, filter.Limit, filter.Offset)
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []models.JobPosting
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job posting: %w", err)
+		}
+		jobs = append(jobs, *job)
+	}
+	return jobs, rows.Err()
+}
+
+// LinkedInJobIDs returns the LinkedIn job IDs of all job postings
+func (r *JobPostingRepository) LinkedInJobIDs() ([]int, error) {
+	rows, err := r.db.Query(`SELECT linkedin_job_id FROM job_postings`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// Fingerprints returns the fingerprinted jobs of a company (all companies when companyID is 0)
+func (r *JobPostingRepository) Fingerprints(companyID int64) ([]models.JobFingerprint, error) {
+	query := `
+		SELECT job_id, linkedin_job_id, company_id, title, fingerprint, posted_date, repost_of_job_id
+		FROM job_postings
+		WHERE fingerprint IS NOT NULL
+	`
+	var args []interface{}
+	if companyID > 0 {
+		query += ` AND company_id = ?`
+		args = append(args, companyID)
+	}
+	query += ` ORDER BY posted_date, job_id`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fingerprints []models.JobFingerprint
+	for rows.Next() {
+		var f models.JobFingerprint
+		err := rows.Scan(&f.JobID, &f.LinkedInJobID, &f.CompanyID, &f.Title, &f.Fingerprint, &f.PostedDate, &f.RepostOfJobID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job fingerprint: %w", err)
+		}
+		fingerprints = append(fingerprints, f)
+	}
+	return fingerprints, rows.Err()
+}
+
 // JobQueueRepository handles job queue operations
 type JobQueueRepository struct {
 	db *DB
@@ -220,11 +579,40 @@ func (r *JobQueueRepository) Add(jobID int) error {
 
 // UpdateStatus updates the status of a job in the queue
 func (r *JobQueueRepository) UpdateStatus(jobID int, status int) error {
-	query := `UPDATE job_queue SET status_code = ? WHERE job_id = ?`
-	_, err := r.db.Exec(query, status, jobID)
+	query := `UPDATE job_queue SET status_code = ?, updated_at = ? WHERE job_id = ?`
+	_, err := r.db.Exec(query, status, time.Now(), jobID)
 	return err
 }
 
+// Claim marks the oldest pending job in progress and returns its job ID, or sql.ErrNoRows if
+// none is pending. It is a single statement, so two processes can't claim the same job.
+func (r *JobQueueRepository) Claim() (int, error) {
+	query := `
+		UPDATE job_queue SET status_code = ?, updated_at = ?
+		WHERE queue_id = (
+			SELECT queue_id FROM job_queue
+			WHERE status_code = ?
+			ORDER BY queued_at ASC, queue_id ASC
+			LIMIT 1
+		)
+		RETURNING job_id
+	`
+	var jobID int
+	err := r.db.QueryRow(query, models.StatusInProgress, time.Now(), models.StatusPending).Scan(&jobID)
+	return jobID, err
+}
+
+// RequeueStale makes jobs that were claimed before the given time pending again, for when the
+// process scraping them died. Returns the number of jobs requeued.
+func (r *JobQueueRepository) RequeueStale(claimedBefore time.Time) (int64, error) {
+	query := `UPDATE job_queue SET status_code = ?, updated_at = ? WHERE status_code = ? AND updated_at < ?`
+	result, err := r.db.Exec(query, models.StatusPending, time.Now(), models.StatusInProgress, claimedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 // GetPending returns pending jobs from the queue
 func (r *JobQueueRepository) GetPending(limit int) ([]*models.JobQueue, error) {
 	query := `
@@ -252,3 +640,43 @@ func (r *JobQueueRepository) GetPending(limit int) ([]*models.JobQueue, error) {
 
 	return jobs, nil
 }
+
+// Status returns the queue status of a job. Returns sql.ErrNoRows if the job was never queued.
+func (r *JobQueueRepository) Status(jobID int) (int, error) {
+	var status int
+	err := r.db.QueryRow(`SELECT status_code FROM job_queue WHERE job_id = ?`, jobID).Scan(&status)
+	return status, err
+}
+
+// Requeue makes a finished job pending again
+func (r *JobQueueRepository) Requeue(jobID int) error {
+	query := `UPDATE job_queue SET status_code = ?, queued_at = ?, updated_at = ? WHERE job_id = ?`
+	now := time.Now()
+	_, err := r.db.Exec(query, models.StatusPending, now, now, jobID)
+	return err
+}
+
+// CountByStatus returns the number of queued jobs per status code
+func (r *JobQueueRepository) CountByStatus() (map[int]int, error) {
+	rows, err := r.db.Query(`SELECT status_code, COUNT(*) FROM job_queue GROUP BY status_code`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[int]int)
+	for rows.Next() {
+		var status, count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+		counts[status] = count
+	}
+	return counts, rows.Err()
+}
+
+// Clear removes every job from the queue
+func (r *JobQueueRepository) Clear() error {
+	_, err := r.db.Exec(`DELETE FROM job_queue`)
+	return err
+}
@@ -0,0 +1,78 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// RunRepository handles scrape run history
+type RunRepository struct {
+	db *DB
+}
+
+// NewRunRepository creates a new run repository
+func NewRunRepository(db *DB) *RunRepository {
+	return &RunRepository{db: db}
+}
+
+// Create records a finished run
+func (r *RunRepository) Create(run *models.ScrapeRun) (*models.ScrapeRun, error) {
+	query := `
+		INSERT INTO scrape_runs (command, arguments, started_at, finished_at, status, error)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	result, err := r.db.Exec(query,
+		run.Command,
+		run.Arguments,
+		run.StartedAt,
+		run.FinishedAt,
+		run.Status,
+		sql.NullString{String: run.Error, Valid: run.Error != ""},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scrape run: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	created := *run
+	created.RunID = int(id)
+	return &created, nil
+}
+
+// List returns the most recent runs, newest first
+func (r *RunRepository) List(limit int) ([]models.ScrapeRun, error) {
+	query := `
+		SELECT run_id, command, COALESCE(arguments, ''), started_at, finished_at, status, COALESCE(error, '')
+		FROM scrape_runs
+		ORDER BY started_at DESC, run_id DESC
+	`
+	var args []interface{}
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runs []models.ScrapeRun
+	for rows.Next() {
+		var run models.ScrapeRun
+		err := rows.Scan(&run.RunID, &run.Command, &run.Arguments, &run.StartedAt, &run.FinishedAt,
+			&run.Status, &run.Error)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scrape run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
@@ -0,0 +1,127 @@
+package database
+
+// sqliteSchema mirrors the tables the Laravel migrations create in MySQL, for running
+// without the Laravel stack. Every statement is idempotent.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS companies (
+	company_id          INTEGER PRIMARY KEY AUTOINCREMENT,
+	name                TEXT NOT NULL,
+	image_url           TEXT NOT NULL DEFAULT '',
+	linkedin_company_id TEXT UNIQUE,
+	linkedin_slug       TEXT UNIQUE,
+	linkedin_url        TEXT NOT NULL DEFAULT '',
+	employee_range      TEXT,
+	employee_count_min  INTEGER,
+	employee_count_max  INTEGER,
+	industry            TEXT,
+	headquarters        TEXT,
+	website             TEXT,
+	follower_count      INTEGER,
+	enriched_at         DATETIME,
+	created_at          DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at          DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_companies_name ON companies (name);
+
+CREATE TABLE IF NOT EXISTS job_postings (
+	job_id                INTEGER PRIMARY KEY AUTOINCREMENT,
+	linkedin_job_id       INTEGER NOT NULL UNIQUE,
+	title                 TEXT NOT NULL,
+	company_id            INTEGER NOT NULL REFERENCES companies (company_id) ON DELETE CASCADE,
+	location              TEXT,
+	description           TEXT,
+	apply_url             TEXT,
+	posted_date           DATETIME,
+	posted_date_source    TEXT,
+	applicants            INTEGER,
+	work_type             TEXT,
+	skills                TEXT,
+	skill_ids             TEXT,
+	skills_source         TEXT,
+	openai_adresse        TEXT,
+	job_post_closed_date  DATETIME,
+	salary_min            REAL,
+	salary_max            REAL,
+	salary_currency       TEXT,
+	salary_period         TEXT,
+	salary_annual_min_dkk INTEGER,
+	salary_annual_max_dkk INTEGER,
+	seniority_level       TEXT,
+	employment_type       TEXT,
+	job_function          TEXT,
+	industries            TEXT,
+	location_city         TEXT,
+	location_region       TEXT,
+	location_country      TEXT,
+	country_code          TEXT,
+	place_key             TEXT,
+	workplace_type        TEXT,
+	fingerprint           TEXT,
+	repost_of_job_id      INTEGER,
+	created_at            DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at            DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_job_postings_company_id ON job_postings (company_id);
+CREATE INDEX IF NOT EXISTS idx_job_postings_posted_date ON job_postings (posted_date);
+
+CREATE TABLE IF NOT EXISTS job_ratings (
+	rating_id        INTEGER PRIMARY KEY AUTOINCREMENT,
+	job_id           INTEGER NOT NULL REFERENCES job_postings (job_id) ON DELETE CASCADE,
+	overall_score    INTEGER NOT NULL,
+	location_score   INTEGER,
+	tech_score       INTEGER,
+	team_size_score  INTEGER,
+	leadership_score INTEGER,
+	criteria         TEXT,
+	rating_type      TEXT NOT NULL,
+	rated_at         DATETIME DEFAULT CURRENT_TIMESTAMP,
+	created_at       DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at       DATETIME DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (job_id, rating_type)
+);
+
+CREATE TABLE IF NOT EXISTS job_snapshots (
+	snapshot_id      INTEGER PRIMARY KEY AUTOINCREMENT,
+	job_id           INTEGER NOT NULL REFERENCES job_postings (job_id) ON DELETE CASCADE,
+	linkedin_job_id  INTEGER NOT NULL,
+	captured_at      DATETIME NOT NULL,
+	applicants       INTEGER,
+	title            TEXT NOT NULL,
+	description_hash TEXT NOT NULL,
+	work_type        TEXT,
+	is_open          INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_job_snapshots_linkedin_job_id ON job_snapshots (linkedin_job_id);
+
+CREATE TABLE IF NOT EXISTS company_aliases (
+	alias_id        INTEGER PRIMARY KEY AUTOINCREMENT,
+	alias           TEXT NOT NULL,
+	normalised_name TEXT NOT NULL UNIQUE,
+	company_id      INTEGER NOT NULL REFERENCES companies (company_id) ON DELETE CASCADE,
+	created_at      DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at      DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Unlike MySQL, where job_queue holds saved jobs, in SQLite it replaces the Redis processing
+-- queue: job_id is the LinkedIn job ID of a discovered job waiting to be scraped.
+CREATE TABLE IF NOT EXISTS job_queue (
+	queue_id    INTEGER PRIMARY KEY AUTOINCREMENT,
+	job_id      INTEGER NOT NULL UNIQUE,
+	queued_at   DATETIME DEFAULT CURRENT_TIMESTAMP,
+	status_code INTEGER NOT NULL DEFAULT 1,
+	created_at  DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at  DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_job_queue_status_code ON job_queue (status_code);
+
+CREATE TABLE IF NOT EXISTS scrape_runs (
+	run_id      INTEGER PRIMARY KEY AUTOINCREMENT,
+	command     TEXT NOT NULL,
+	arguments   TEXT,
+	started_at  DATETIME NOT NULL,
+	finished_at DATETIME NOT NULL,
+	status      TEXT NOT NULL,
+	error       TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_scrape_runs_started_at ON scrape_runs (started_at);
+`
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"fmt"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// SnapshotRepository handles job snapshot operations
+type SnapshotRepository struct {
+	db *DB
+}
+
+// NewSnapshotRepository creates a new snapshot repository
+func NewSnapshotRepository(db *DB) *SnapshotRepository {
+	return &SnapshotRepository{db: db}
+}
+
+// Create records a snapshot
+func (r *SnapshotRepository) Create(snapshot *models.JobSnapshot) (*models.JobSnapshot, error) {
+	query := `
+		INSERT INTO job_snapshots (job_id, linkedin_job_id, captured_at, applicants, title, description_hash, work_type, is_open)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	result, err := r.db.Exec(query,
+		snapshot.JobID,
+		snapshot.LinkedInJobID,
+		snapshot.CapturedAt,
+		snapshot.Applicants,
+		snapshot.Title,
+		snapshot.DescriptionHash,
+		snapshot.WorkType,
+		snapshot.IsOpen,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	created := *snapshot
+	created.SnapshotID = int(id)
+	return &created, nil
+}
+
+// ListByLinkedInJobID returns all snapshots of a job, oldest first
+func (r *SnapshotRepository) ListByLinkedInJobID(linkedinJobID int) ([]models.JobSnapshot, error) {
+	query := `
+		SELECT snapshot_id, job_id, linkedin_job_id, captured_at, applicants, title, description_hash, work_type, is_open
+		FROM job_snapshots
+		WHERE linkedin_job_id = ?
+		ORDER BY captured_at, snapshot_id
+	`
+	rows, err := r.db.Query(query, linkedinJobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var snapshots []models.JobSnapshot
+	for rows.Next() {
+		var s models.JobSnapshot
+		err := rows.Scan(&s.SnapshotID, &s.JobID, &s.LinkedInJobID, &s.CapturedAt, &s.Applicants,
+			&s.Title, &s.DescriptionHash, &s.WorkType, &s.IsOpen)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan snapshot: %w", err)
+		}
+		snapshots = append(snapshots, s)
+	}
+	return snapshots, rows.Err()
+}
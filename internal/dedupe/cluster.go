@@ -0,0 +1,148 @@
+package dedupe
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultThreshold is the minimum similarity for two normalised names to be merge candidates
+const DefaultThreshold = 0.92
+
+// blockPrefixLength is how many leading characters two names must share to be compared at all.
+// Comparing every pair is quadratic; near-duplicate company names almost always share a prefix.
+const blockPrefixLength = 3
+
+// Cluster is a group of company names that look like the same company
+type Cluster struct {
+	ID             int      `json:"id"`
+	Canonical      string   `json:"canonical"`       // Name to keep - reviewers may change it
+	Duplicates     []string `json:"duplicates"`      // Names to merge into the canonical company
+	NormalisedName string   `json:"normalised_name"` // Comparison key of the canonical name
+	Similarity     float64  `json:"similarity"`      // Lowest similarity between the canonical name and a duplicate
+	Approved       bool     `json:"approved"`        // Only approved clusters are applied
+}
+
+// FindClusters groups company names whose normalised forms are identical or at least
+// threshold similar. Names that have no near-duplicate are left out.
+func FindClusters(names []string, threshold float64) []Cluster {
+	// Identical names (after trimming) are one company already
+	seen := make(map[string]bool)
+	var unique []string
+	for _, name := range names {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		unique = append(unique, name)
+	}
+	sort.Strings(unique)
+
+	normalised := make([]string, len(unique))
+	blocks := make(map[string][]int)
+	for i, name := range unique {
+		normalised[i] = NormaliseCompanyName(name)
+		key := blockKey(normalised[i])
+		blocks[key] = append(blocks[key], i)
+	}
+
+	parent := make([]int, len(unique))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for _, members := range blocks {
+		for x := 0; x < len(members); x++ {
+			for y := x + 1; y < len(members); y++ {
+				i, j := members[x], members[y]
+				if Similarity(normalised[i], normalised[j]) >= threshold {
+					parent[find(i)] = find(j)
+				}
+			}
+		}
+	}
+
+	groups := make(map[int][]int)
+	for i := range unique {
+		root := find(i)
+		groups[root] = append(groups[root], i)
+	}
+
+	var clusters []Cluster
+	for _, members := range groups {
+		if len(members) < 2 {
+			continue
+		}
+		clusters = append(clusters, buildCluster(unique, normalised, members))
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Canonical < clusters[j].Canonical
+	})
+	for i := range clusters {
+		clusters[i].ID = i + 1
+	}
+	return clusters
+}
+
+// buildCluster picks the canonical name for a group and records how similar the rest are to it
+func buildCluster(names, normalised []string, members []int) Cluster {
+	canonical := members[0]
+	for _, i := range members[1:] {
+		if canonicalRank(names[i]) < canonicalRank(names[canonical]) {
+			canonical = i
+		}
+	}
+
+	cluster := Cluster{
+		Canonical:      names[canonical],
+		NormalisedName: normalised[canonical],
+		Similarity:     1,
+	}
+	for _, i := range members {
+		if i == canonical {
+			continue
+		}
+		cluster.Duplicates = append(cluster.Duplicates, names[i])
+		if similarity := Similarity(normalised[canonical], normalised[i]); similarity < cluster.Similarity {
+			cluster.Similarity = similarity
+		}
+	}
+	sort.Strings(cluster.Duplicates)
+	return cluster
+}
+
+// canonicalRank orders candidate names, lowest first: prefer names that are already trimmed and
+// properly cased, then the shortest, so "Novo Nordisk" wins over "NOVO NORDISK A/S ".
+func canonicalRank(name string) int {
+	rank := len([]rune(name))
+	if name != strings.TrimSpace(name) || strings.Contains(name, "  ") {
+		rank += 1000
+	}
+	if name == strings.ToLower(name) || name == strings.ToUpper(name) {
+		rank += 500
+	}
+	return rank
+}
+
+// blockKey returns the first letters of a normalised name, ignoring spaces
+func blockKey(normalised string) string {
+	var b strings.Builder
+	for _, r := range normalised {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		b.WriteRune(r)
+		if b.Len() >= blockPrefixLength {
+			break
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,86 @@
+package dedupe
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestNormaliseCompanyName(t *testing.T) {
+	tests := map[string]string{
+		"Novo Nordisk A/S":     "novo nordisk",
+		"novo nordisk":         "novo nordisk",
+		"Novo Nordisk, Inc. ":  "novo nordisk",
+		"Netcompany ApS":       "netcompany",
+		"Procter & Gamble Co.": "procter and gamble",
+		"AS":                   "as",
+		"Mærsk  A/S":           "mærsk",
+	}
+
+	for input, expected := range tests {
+		if result := NormaliseCompanyName(input); result != expected {
+			t.Errorf("NormaliseCompanyName(%q) = %q, expected %q", input, result, expected)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	if similarity := Similarity("netcompany", "netcompany"); similarity != 1 {
+		t.Errorf("identical names should have similarity 1, got %f", similarity)
+	}
+	if similarity := Similarity("netcompany", "netcompani"); similarity < DefaultThreshold {
+		t.Errorf("near-identical names should be above threshold, got %f", similarity)
+	}
+	if similarity := Similarity("netcompany", "netflix"); similarity >= DefaultThreshold {
+		t.Errorf("different names should be below threshold, got %f", similarity)
+	}
+}
+
+func TestFindClusters(t *testing.T) {
+	names := []string{"Novo Nordisk A/S", "Novo Nordisk", "NOVO NORDISK ", "Netcompany", "Netflix", "Novozymes"}
+
+	clusters := FindClusters(names, DefaultThreshold)
+	if len(clusters) != 1 {
+		t.Fatalf("expected 1 cluster, got %d: %+v", len(clusters), clusters)
+	}
+
+	cluster := clusters[0]
+	if cluster.Canonical != "Novo Nordisk" {
+		t.Errorf("expected canonical %q, got %q", "Novo Nordisk", cluster.Canonical)
+	}
+	if len(cluster.Duplicates) != 2 {
+		t.Errorf("expected 2 duplicates, got %v", cluster.Duplicates)
+	}
+	if cluster.Approved {
+		t.Error("new clusters should not be approved")
+	}
+}
+
+func TestPlanCSVRoundTrip(t *testing.T) {
+	plan := NewMergePlan([]string{"Novo Nordisk A/S", "Novo Nordisk", "NOVO NORDISK "}, DefaultThreshold)
+
+	var buf bytes.Buffer
+	if err := WritePlanCSV(&buf, plan); err != nil {
+		t.Fatalf("WritePlanCSV failed: %v", err)
+	}
+
+	// Approve only the first duplicate row
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got %d lines", len(lines))
+	}
+	lines[1] = strings.TrimSuffix(lines[1], "false") + "true"
+
+	read, err := ReadPlanCSV(strings.NewReader(strings.Join(lines, "\n")))
+	if err != nil {
+		t.Fatalf("ReadPlanCSV failed: %v", err)
+	}
+
+	approved := read.Approved()
+	if len(approved) != 1 || len(approved[0].Duplicates) != 1 {
+		t.Fatalf("expected 1 approved cluster with 1 duplicate, got %+v", approved)
+	}
+	if approved[0].Canonical != "Novo Nordisk" {
+		t.Errorf("expected canonical %q, got %q", "Novo Nordisk", approved[0].Canonical)
+	}
+}
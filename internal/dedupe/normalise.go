@@ -0,0 +1,46 @@
+package dedupe
+
+import (
+	"strings"
+	"unicode"
+)
+
+// legalSuffixes are company form suffixes that don't distinguish one company from another.
+// Names are compared after punctuation is removed, so "A/S" is matched as "as".
+var legalSuffixes = map[string]bool{
+	// Danish and Nordic
+	"aps": true, "as": true, "ivs": true, "is": true, "ks": true, "ps": true,
+	"amba": true, "fmba": true, "smba": true, "asa": true, "ab": true, "oy": true, "oyj": true, "ehf": true,
+	// English
+	"inc": true, "incorporated": true, "ltd": true, "limited": true, "llc": true, "llp": true,
+	"plc": true, "corp": true, "corporation": true, "co": true,
+	// Continental
+	"gmbh": true, "ag": true, "se": true, "bv": true, "nv": true, "sa": true, "sas": true,
+	"sarl": true, "srl": true, "spa": true,
+}
+
+// NormaliseCompanyName reduces a company display name to a comparison key: lower case,
+// no punctuation, single spaces and no trailing legal suffixes.
+// "Novo Nordisk A/S", "novo nordisk" and "Novo Nordisk, Inc. " all become "novo nordisk".
+func NormaliseCompanyName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.ReplaceAll(name, "&", " and ")
+
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		case r == '/' || r == '.' || r == '\'' || r == '’':
+			// Joined rather than split, so "A/S" -> "as" and "Inc." -> "inc"
+		default:
+			b.WriteRune(' ')
+		}
+	}
+
+	tokens := strings.Fields(b.String())
+	for len(tokens) > 1 && legalSuffixes[tokens[len(tokens)-1]] {
+		tokens = tokens[:len(tokens)-1]
+	}
+	return strings.Join(tokens, " ")
+}
@@ -0,0 +1,179 @@
+package dedupe
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MergePlan is a reviewable list of company clusters to merge
+type MergePlan struct {
+	GeneratedAt time.Time `json:"generated_at"`
+	Threshold   float64   `json:"threshold"`
+	Clusters    []Cluster `json:"clusters"`
+}
+
+// NewMergePlan clusters company names into a plan with nothing approved yet
+func NewMergePlan(names []string, threshold float64) *MergePlan {
+	return &MergePlan{
+		GeneratedAt: time.Now(),
+		Threshold:   threshold,
+		Clusters:    FindClusters(names, threshold),
+	}
+}
+
+// Approved returns the clusters marked for merging
+func (p *MergePlan) Approved() []Cluster {
+	var approved []Cluster
+	for _, cluster := range p.Clusters {
+		if cluster.Approved && len(cluster.Duplicates) > 0 {
+			approved = append(approved, cluster)
+		}
+	}
+	return approved
+}
+
+// planCSVHeader has one row per duplicate so reviewers can approve or reject each name
+var planCSVHeader = []string{"cluster_id", "canonical", "duplicate", "similarity", "approved"}
+
+// WritePlanFile writes the plan as CSV when the path ends in .csv, otherwise as JSON
+func WritePlanFile(path string, plan *MergePlan) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create plan file: %w", err)
+	}
+	defer file.Close()
+
+	if isCSV(path) {
+		return WritePlanCSV(file, plan)
+	}
+	return WritePlanJSON(file, plan)
+}
+
+// ReadPlanFile reads a plan written by WritePlanFile, possibly edited by a reviewer
+func ReadPlanFile(path string) (*MergePlan, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plan file: %w", err)
+	}
+	defer file.Close()
+
+	if isCSV(path) {
+		return ReadPlanCSV(file)
+	}
+	return ReadPlanJSON(file)
+}
+
+// WritePlanJSON writes the plan as indented JSON
+func WritePlanJSON(w io.Writer, plan *MergePlan) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(plan); err != nil {
+		return fmt.Errorf("failed to write merge plan: %w", err)
+	}
+	return nil
+}
+
+// ReadPlanJSON reads a JSON merge plan
+func ReadPlanJSON(r io.Reader) (*MergePlan, error) {
+	var plan MergePlan
+	if err := json.NewDecoder(r).Decode(&plan); err != nil {
+		return nil, fmt.Errorf("failed to parse merge plan: %w", err)
+	}
+	return &plan, nil
+}
+
+// WritePlanCSV writes the plan as CSV with one row per duplicate name
+func WritePlanCSV(w io.Writer, plan *MergePlan) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(planCSVHeader); err != nil {
+		return fmt.Errorf("failed to write merge plan: %w", err)
+	}
+
+	for _, cluster := range plan.Clusters {
+		for _, duplicate := range cluster.Duplicates {
+			record := []string{
+				strconv.Itoa(cluster.ID),
+				cluster.Canonical,
+				duplicate,
+				strconv.FormatFloat(Similarity(cluster.NormalisedName, NormaliseCompanyName(duplicate)), 'f', 3, 64),
+				strconv.FormatBool(cluster.Approved),
+			}
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("failed to write merge plan: %w", err)
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadPlanCSV reads a CSV merge plan. A cluster is approved when at least one of its rows is,
+// and only approved rows are kept as duplicates.
+func ReadPlanCSV(r io.Reader) (*MergePlan, error) {
+	reader := csv.NewReader(r)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse merge plan: %w", err)
+	}
+	if len(records) == 0 {
+		return &MergePlan{}, nil
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range planCSVHeader {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("merge plan is missing column %q", name)
+		}
+	}
+
+	clusters := make(map[int]*Cluster)
+	for line, record := range records[1:] {
+		id, err := strconv.Atoi(strings.TrimSpace(record[columns["cluster_id"]]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cluster_id on line %d: %w", line+2, err)
+		}
+		approved, _ := strconv.ParseBool(strings.TrimSpace(record[columns["approved"]]))
+
+		cluster, ok := clusters[id]
+		if !ok {
+			canonical := record[columns["canonical"]]
+			cluster = &Cluster{ID: id, Canonical: canonical, NormalisedName: NormaliseCompanyName(canonical), Similarity: 1}
+			clusters[id] = cluster
+		}
+		if !approved {
+			continue
+		}
+
+		duplicate := record[columns["duplicate"]]
+		cluster.Duplicates = append(cluster.Duplicates, duplicate)
+		cluster.Approved = true
+		if similarity, err := strconv.ParseFloat(record[columns["similarity"]], 64); err == nil && similarity < cluster.Similarity {
+			cluster.Similarity = similarity
+		}
+	}
+
+	plan := &MergePlan{}
+	for _, cluster := range clusters {
+		plan.Clusters = append(plan.Clusters, *cluster)
+	}
+	sort.Slice(plan.Clusters, func(i, j int) bool {
+		return plan.Clusters[i].ID < plan.Clusters[j].ID
+	})
+	return plan, nil
+}
+
+func isCSV(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".csv")
+}
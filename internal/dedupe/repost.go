@@ -0,0 +1,64 @@
+package dedupe
+
+import (
+	"linkedin-job-scraper/internal/models"
+)
+
+const (
+	// RepostTitleThreshold is the minimum similarity between normalised titles of a job and its repost
+	RepostTitleThreshold = 0.9
+	// RepostMaxDistance is the maximum number of differing SimHash bits between a job and its repost.
+	// Unrelated descriptions differ in about 32 of 64 bits; an added line moves a short description
+	// by up to ~8 bits. The company and title must match too, so the margin can be generous.
+	RepostMaxDistance = 10
+)
+
+// JobFingerprint returns the fingerprint of a job's description, or "" when it has none
+func JobFingerprint(job *models.JobPosting) string {
+	hash := SimHash(job.Description)
+	if hash == 0 {
+		return ""
+	}
+	return FormatFingerprint(hash)
+}
+
+// FindRepost returns the earlier posting that job is a repost of, or nil. Candidates must be from
+// the same company, posted no later than job, with a near-identical title and description.
+// Postings on the same day are cross-posts (e.g. one per city) and are linked the same way.
+func FindRepost(job *models.JobPosting, fingerprint string, candidates []models.JobFingerprint) *models.JobFingerprint {
+	hash, ok := ParseFingerprint(fingerprint)
+	if !ok {
+		return nil
+	}
+	title := NormaliseJobTitle(job.Title)
+
+	var best *models.JobFingerprint
+	bestDistance := RepostMaxDistance + 1
+	for i := range candidates {
+		candidate := &candidates[i]
+		if candidate.LinkedInJobID == job.LinkedInJobID || candidate.CompanyID != job.CompanyID {
+			continue
+		}
+		if candidate.PostedDate.After(job.PostedDate) {
+			continue
+		}
+
+		candidateHash, ok := ParseFingerprint(candidate.Fingerprint)
+		if !ok {
+			continue
+		}
+		distance := HammingDistance(hash, candidateHash)
+		if distance > RepostMaxDistance {
+			continue
+		}
+		if Similarity(title, NormaliseJobTitle(candidate.Title)) < RepostTitleThreshold {
+			continue
+		}
+
+		if distance < bestDistance || (distance == bestDistance && candidate.PostedDate.Before(best.PostedDate)) {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+	return best
+}
@@ -0,0 +1,69 @@
+package dedupe
+
+import (
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+const goDeveloperDescription = `We are looking for an experienced Go developer to join our platform team in Copenhagen.
+You will design and build backend services, work closely with product and operations, and help
+us scale our infrastructure. We offer a pension scheme, lunch arrangement, flexible hours and a
+friendly team of engineers who care about quality, testing and continuous delivery.`
+
+func TestSimHashNearDuplicates(t *testing.T) {
+	original := SimHash(goDeveloperDescription)
+	edited := SimHash(goDeveloperDescription + " Apply before 1 June.")
+	different := SimHash("Vi søger en social- og sundhedsassistent til vores plejecenter i Aarhus med fokus på omsorg og trivsel for beboerne.")
+
+	if distance := HammingDistance(original, edited); distance > RepostMaxDistance {
+		t.Errorf("small edit moved SimHash by %d bits", distance)
+	}
+	if distance := HammingDistance(original, different); distance <= RepostMaxDistance {
+		t.Errorf("unrelated descriptions are only %d bits apart", distance)
+	}
+}
+
+func TestNormaliseJobTitle(t *testing.T) {
+	tests := map[string]string{
+		"Senior Go Developer (m/f/d) - Copenhagen": "senior go developer copenhagen",
+		"Senior Go-Developer [Remote]":             "senior go developer",
+	}
+
+	for input, expected := range tests {
+		if result := NormaliseJobTitle(input); result != expected {
+			t.Errorf("NormaliseJobTitle(%q) = %q, expected %q", input, result, expected)
+		}
+	}
+}
+
+func TestFindRepost(t *testing.T) {
+	posted := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	fingerprint := FormatFingerprint(SimHash(goDeveloperDescription))
+	originalID := 1
+
+	candidates := []models.JobFingerprint{
+		{JobID: 1, LinkedInJobID: 100, CompanyID: 7, Title: "Go Developer", Fingerprint: fingerprint, PostedDate: posted.AddDate(0, -2, 0)},
+		{JobID: 2, LinkedInJobID: 200, CompanyID: 7, Title: "Go Developer (m/f/d)", Fingerprint: fingerprint, PostedDate: posted.AddDate(0, -1, 0), RepostOfJobID: &originalID},
+		{JobID: 3, LinkedInJobID: 300, CompanyID: 8, Title: "Go Developer", Fingerprint: fingerprint, PostedDate: posted.AddDate(0, -1, 0)},
+		{JobID: 4, LinkedInJobID: 400, CompanyID: 7, Title: "Go Developer", Fingerprint: fingerprint, PostedDate: posted.AddDate(0, 1, 0)},
+	}
+
+	job := &models.JobPosting{LinkedInJobID: 500, CompanyID: 7, Title: "Go Developer", Description: goDeveloperDescription, PostedDate: posted}
+	match := FindRepost(job, JobFingerprint(job), candidates)
+	if match == nil {
+		t.Fatal("expected job to be recognised as a repost")
+	}
+	if match.CompanyID != 7 || match.PostedDate.After(posted) {
+		t.Errorf("matched a posting from another company or from the future: %+v", match)
+	}
+	if match.OriginalJobID() != 1 {
+		t.Errorf("expected repost to link to the first version (job 1), got %d", match.OriginalJobID())
+	}
+
+	other := &models.JobPosting{LinkedInJobID: 600, CompanyID: 7, Title: "Product Manager", Description: goDeveloperDescription, PostedDate: posted}
+	if match := FindRepost(other, JobFingerprint(other), candidates); match != nil {
+		t.Errorf("different title should not be a repost, matched %+v", match)
+	}
+}
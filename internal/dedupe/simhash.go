@@ -0,0 +1,88 @@
+package dedupe
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/bits"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// shingleSize is the number of consecutive words hashed together. Word 3-grams keep some
+// word order, so two descriptions sharing a vocabulary but not sentences still differ.
+const shingleSize = 3
+
+// SimHash returns a 64-bit SimHash of the word shingles in text. Similar texts get hashes
+// that differ in few bits, so edits like a changed deadline or salary barely move the hash.
+func SimHash(text string) uint64 {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	addShingle := func(shingle string) {
+		h := fnv.New64a()
+		h.Write([]byte(shingle))
+		sum := h.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if sum&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	if len(words) < shingleSize {
+		addShingle(strings.Join(words, " "))
+	}
+	for i := 0; i+shingleSize <= len(words); i++ {
+		addShingle(strings.Join(words[i:i+shingleSize], " "))
+	}
+
+	var hash uint64
+	for bit := 0; bit < 64; bit++ {
+		if weights[bit] > 0 {
+			hash |= 1 << bit
+		}
+	}
+	return hash
+}
+
+// HammingDistance returns the number of bits that differ between two SimHashes
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// FormatFingerprint encodes a SimHash as the 16 character hex string stored with a job
+func FormatFingerprint(hash uint64) string {
+	return fmt.Sprintf("%016x", hash)
+}
+
+// ParseFingerprint decodes a fingerprint written by FormatFingerprint
+func ParseFingerprint(fingerprint string) (uint64, bool) {
+	hash, err := strconv.ParseUint(fingerprint, 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return hash, true
+}
+
+// titleNoisePattern matches gender markers and bracketed suffixes that vary between reposts,
+// e.g. "(m/f/d)", "(m/k)", "[Remote]"
+var titleNoisePattern = regexp.MustCompile(`\([^)]*\)|\[[^\]]*\]`)
+
+// NormaliseJobTitle reduces a job title to a comparison key:
+// "Senior Go Developer (m/f/d) - Copenhagen" -> "senior go developer copenhagen"
+func NormaliseJobTitle(title string) string {
+	title = titleNoisePattern.ReplaceAllString(strings.ToLower(title), " ")
+	words := strings.FieldsFunc(title, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,64 @@
+package dedupe
+
+// Similarity returns the Jaro-Winkler similarity of two normalised names, from 0 (nothing in
+// common) to 1 (identical). Jaro-Winkler favours names sharing a prefix, which suits company
+// names where the distinctive part comes first ("Netcompany" vs "Netcompany Group").
+func Similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+
+	matchDistance := max(len(ra), len(rb))/2 - 1
+	if matchDistance < 0 {
+		matchDistance = 0
+	}
+
+	matchedA := make([]bool, len(ra))
+	matchedB := make([]bool, len(rb))
+	matches := 0
+	for i := range ra {
+		start := max(0, i-matchDistance)
+		end := min(len(rb), i+matchDistance+1)
+		for j := start; j < end; j++ {
+			if matchedB[j] || ra[i] != rb[j] {
+				continue
+			}
+			matchedA[i], matchedB[j] = true, true
+			matches++
+			break
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Count matched characters that appear in a different order
+	transpositions := 0
+	j := 0
+	for i := range ra {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if ra[i] != rb[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(ra), len(rb)) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
@@ -0,0 +1,74 @@
+// Package embeddings computes vectors for job descriptions and candidate profiles and
+// finds the nearest jobs to a job, a profile or a free-text query.
+package embeddings
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"linkedin-job-scraper/internal/match"
+	"linkedin-job-scraper/internal/models"
+)
+
+// Embedder turns texts into vectors. Vectors from different models can't be compared, so
+// Model identifies the vector space and is stored in the index.
+type Embedder interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	Model() string
+}
+
+// JobText is the text embedded for a job: title, skills and description
+func JobText(job *models.JobPosting) string {
+	var b strings.Builder
+	b.WriteString(job.Title)
+	if job.CompanyName != "" {
+		b.WriteString(" at ")
+		b.WriteString(job.CompanyName)
+	}
+	b.WriteString("\n")
+	if job.Skills != nil && len(*job.Skills) > 0 {
+		b.WriteString("Skills: ")
+		b.WriteString(strings.Join(*job.Skills, ", "))
+		b.WriteString("\n")
+	}
+	b.WriteString(job.Description)
+	return b.String()
+}
+
+// CandidateText is the text embedded for a candidate profile, written like a job posting
+// for the role the candidate wants so it lands near matching jobs
+func CandidateText(candidate *match.Candidate) string {
+	var b strings.Builder
+	b.WriteString(strings.Join(candidate.PreferredRoles, ", "))
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "Skills: %s\n", strings.Join(append(append([]string{}, candidate.PrimarySkills...), candidate.SecondarySkills...), ", "))
+	fmt.Fprintf(&b, "%d years of experience. Location: %s. Languages: %s.\n",
+		candidate.YearsExperience, candidate.Location, strings.Join(candidate.Languages, ", "))
+	return b.String()
+}
+
+// normalise scales v to unit length in place, so cosine similarity is a dot product
+func normalise(v []float32) {
+	var sum float64
+	for _, x := range v {
+		sum += float64(x) * float64(x)
+	}
+	if sum == 0 {
+		return
+	}
+	norm := float32(math.Sqrt(sum))
+	for i := range v {
+		v[i] /= norm
+	}
+}
+
+// dot is the cosine similarity of two unit vectors
+func dot(a, b []float32) float64 {
+	var sum float64
+	for i := range a {
+		sum += float64(a[i]) * float64(b[i])
+	}
+	return sum
+}
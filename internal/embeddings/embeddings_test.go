@@ -0,0 +1,129 @@
+package embeddings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+func testJobs() []models.JobPosting {
+	return []models.JobPosting{
+		{JobID: 1, LinkedInJobID: 101, Title: "Senior PHP Developer", Description: "Build Laravel APIs on AWS with Docker and MySQL."},
+		{JobID: 2, LinkedInJobID: 102, Title: "Backend Developer", Description: "PHP and Laravel services running in Docker on AWS."},
+		{JobID: 3, LinkedInJobID: 103, Title: "Registered Nurse", Description: "Care for patients at the hospital ward, night shifts."},
+		{JobID: 4, LinkedInJobID: 104, Title: ".NET Developer", Description: "C# and .NET microservices on Azure."},
+	}
+}
+
+func buildIndex(t *testing.T, embedder Embedder) *Index {
+	t.Helper()
+	idx := NewIndex(embedder.Model())
+	jobs := testJobs()
+	texts := make([]string, len(jobs))
+	for i := range jobs {
+		texts[i] = JobText(&jobs[i])
+	}
+	vectors, err := embedder.Embed(context.Background(), texts)
+	if err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	for i := range jobs {
+		idx.Put(&jobs[i], ContentHash(texts[i]), vectors[i])
+	}
+	return idx
+}
+
+func TestHashingEmbedderSimilarity(t *testing.T) {
+	embedder := NewHashingEmbedder(0)
+	idx := buildIndex(t, embedder)
+
+	// Deterministic across calls
+	again, _ := embedder.Embed(context.Background(), []string{JobText(&testJobs()[0])})
+	if !reflect.DeepEqual(again[0], idx.Get(101).Vector) {
+		t.Error("HashingEmbedder is not deterministic")
+	}
+
+	similar := idx.Nearest(idx.Get(101).Vector, 2, 101)
+	if len(similar) != 2 || similar[0].Entry.LinkedInJobID != 102 {
+		t.Errorf("Nearest(101) = %+v, want 102 first", similar)
+	}
+
+	query, _ := embedder.Embed(context.Background(), []string{"c# .net azure"})
+	if got := idx.Nearest(query[0], 1, 0); got[0].Entry.LinkedInJobID != 104 {
+		t.Errorf("search for c# .net = %d, want 104", got[0].Entry.LinkedInJobID)
+	}
+}
+
+func TestIndexUpdatesAndFileStore(t *testing.T) {
+	idx := buildIndex(t, NewHashingEmbedder(64))
+
+	jobs := testJobs()
+	if needed, _ := idx.NeedsEmbedding(&jobs[0]); needed {
+		t.Error("unchanged job needs embedding")
+	}
+	jobs[0].Description += " Kubernetes too."
+	if needed, _ := idx.NeedsEmbedding(&jobs[0]); !needed {
+		t.Error("changed job doesn't need embedding")
+	}
+	if removed := idx.Retain(map[int]bool{101: true, 102: true, 104: true}); removed != 1 || idx.Get(103) != nil {
+		t.Errorf("Retain() removed %d, index has %d", removed, idx.Len())
+	}
+
+	store := FileStore{Path: filepath.Join(t.TempDir(), "embeddings.idx")}
+	if loaded, err := store.Load(); loaded != nil || err != nil {
+		t.Fatalf("Load() of a missing file = %v, %v", loaded, err)
+	}
+	if err := store.Save(idx); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !reflect.DeepEqual(loaded, idx) {
+		t.Errorf("Load() = %+v, want %+v", loaded, idx)
+	}
+}
+
+func TestOpenAIEmbedderAgainstStubServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		var req struct {
+			Input []string `json:"input"`
+			Model string   `json:"model"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Model != "test-embedding" {
+			t.Errorf("model = %q", req.Model)
+		}
+
+		// Answer out of order, with the input's length in the vector
+		data := []map[string]interface{}{}
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			data = append(data, map[string]interface{}{
+				"object": "embedding", "index": i, "embedding": []float32{float32(len(req.Input[i])), 1},
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"object": "list", "data": data, "model": req.Model})
+	}))
+	defer server.Close()
+
+	embedder := NewOpenAIEmbedder("test-key", server.URL+"/v1", "test-embedding")
+	vectors, err := embedder.Embed(context.Background(), []string{"a", "bb"})
+	if err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	// [1 1] and [2 1] normalised: the longer input leans further towards the first axis
+	if len(vectors) != 2 || vectors[0][0] >= vectors[1][0] || vectors[0][0] != vectors[0][1] {
+		t.Errorf("Embed() = %v", vectors)
+	}
+}
@@ -0,0 +1,76 @@
+package embeddings
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"unicode"
+)
+
+// DefaultHashingDims is the vector size of the hashing embedder
+const DefaultHashingDims = 512
+
+// HashingEmbedder is a local, deterministic embedder: words and word pairs are hashed into
+// a fixed number of buckets. It only knows shared vocabulary, not meaning, but needs no API
+// and gives the same vectors on every run, which makes it useful offline and in tests.
+type HashingEmbedder struct {
+	dims int
+}
+
+// NewHashingEmbedder creates a hashing embedder with dims buckets, DefaultHashingDims if 0
+func NewHashingEmbedder(dims int) *HashingEmbedder {
+	if dims <= 0 {
+		dims = DefaultHashingDims
+	}
+	return &HashingEmbedder{dims: dims}
+}
+
+// Model identifies the vector space, including the size
+func (e *HashingEmbedder) Model() string {
+	return fmt.Sprintf("hashing-%d", e.dims)
+}
+
+// Embed hashes each text into a unit vector
+func (e *HashingEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = e.embed(text)
+	}
+	return vectors, nil
+}
+
+func (e *HashingEmbedder) embed(text string) []float32 {
+	vector := make([]float32, e.dims)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		// Keep symbols used in technology names: c#, c++, .net, node.js
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("#+.", r)
+	})
+
+	add := func(feature string, weight float32) {
+		h := fnv.New64a()
+		h.Write([]byte(feature))
+		sum := h.Sum64()
+		// The top bit picks the sign, so unrelated features cancel out instead of piling up
+		if sum>>63 == 1 {
+			weight = -weight
+		}
+		vector[sum%uint64(e.dims)] += weight
+	}
+
+	previous := ""
+	for _, word := range words {
+		word = strings.Trim(word, ".")
+		if word == "" {
+			continue
+		}
+		add(word, 1)
+		if previous != "" {
+			add(previous+" "+word, 0.5)
+		}
+		previous = word
+	}
+
+	normalise(vector)
+	return vector
+}
@@ -0,0 +1,224 @@
+package embeddings
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"linkedin-job-scraper/internal/cache"
+	"linkedin-job-scraper/internal/models"
+)
+
+// indexMagic starts every index file, followed by a gob encoded Index
+const indexMagic = "EMBIDX1\n"
+
+// RedisKey is the key of the index when it's kept in Redis
+const RedisKey = "embedding_index"
+
+// Entry is one job's vector and what's needed to show it in results
+type Entry struct {
+	JobID         int
+	LinkedInJobID int
+	Title         string
+	CompanyName   string
+	Location      string
+	ContentHash   uint64 // Hash of JobText, to skip re-embedding unchanged jobs
+	Vector        []float32
+}
+
+// Index holds job vectors of one embedding model, keyed by LinkedIn job ID
+type Index struct {
+	Model   string
+	Entries map[int]*Entry
+}
+
+// Match is a search result
+type Match struct {
+	Entry      *Entry
+	Similarity float64 // Cosine similarity, 1 for identical texts
+}
+
+// NewIndex creates an empty index for vectors of model
+func NewIndex(model string) *Index {
+	return &Index{Model: model, Entries: make(map[int]*Entry)}
+}
+
+// Len returns the number of jobs in the index
+func (idx *Index) Len() int {
+	return len(idx.Entries)
+}
+
+// Get returns the entry of a job, or nil
+func (idx *Index) Get(linkedinJobID int) *Entry {
+	return idx.Entries[linkedinJobID]
+}
+
+// NeedsEmbedding reports whether job is missing from the index or has changed since it was
+// embedded, and returns the hash to store with the new vector
+func (idx *Index) NeedsEmbedding(job *models.JobPosting) (bool, uint64) {
+	hash := ContentHash(JobText(job))
+	entry := idx.Entries[job.LinkedInJobID]
+	return entry == nil || entry.ContentHash != hash, hash
+}
+
+// Put adds or replaces a job's vector
+func (idx *Index) Put(job *models.JobPosting, hash uint64, vector []float32) {
+	idx.Entries[job.LinkedInJobID] = &Entry{
+		JobID:         job.JobID,
+		LinkedInJobID: job.LinkedInJobID,
+		Title:         job.Title,
+		CompanyName:   job.CompanyName,
+		Location:      job.Location,
+		ContentHash:   hash,
+		Vector:        vector,
+	}
+}
+
+// Retain removes every job not in keep, e.g. jobs that have closed. Returns the number removed.
+func (idx *Index) Retain(keep map[int]bool) int {
+	removed := 0
+	for id := range idx.Entries {
+		if !keep[id] {
+			delete(idx.Entries, id)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Nearest returns the k entries most similar to vector, best first, leaving out the job
+// with LinkedIn job ID exclude (0 for none)
+func (idx *Index) Nearest(vector []float32, k int, exclude int) []Match {
+	matches := make([]Match, 0, len(idx.Entries))
+	for id, entry := range idx.Entries {
+		if id == exclude || len(entry.Vector) != len(vector) {
+			continue
+		}
+		matches = append(matches, Match{Entry: entry, Similarity: dot(vector, entry.Vector)})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Similarity != matches[j].Similarity {
+			return matches[i].Similarity > matches[j].Similarity
+		}
+		return matches[i].Entry.LinkedInJobID < matches[j].Entry.LinkedInJobID
+	})
+	if k > 0 && len(matches) > k {
+		matches = matches[:k]
+	}
+	return matches
+}
+
+// ContentHash hashes the text a vector was computed from
+func ContentHash(text string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(text))
+	return h.Sum64()
+}
+
+// Write writes the index in the format read by ReadIndex
+func (idx *Index) Write(w io.Writer) error {
+	if _, err := io.WriteString(w, indexMagic); err != nil {
+		return fmt.Errorf("failed to write index header: %w", err)
+	}
+	if err := gob.NewEncoder(w).Encode(idx); err != nil {
+		return fmt.Errorf("failed to write index: %w", err)
+	}
+	return nil
+}
+
+// ReadIndex reads an index written by Write
+func ReadIndex(r io.Reader) (*Index, error) {
+	magic := make([]byte, len(indexMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != indexMagic {
+		return nil, fmt.Errorf("not an embedding index")
+	}
+	var idx Index
+	if err := gob.NewDecoder(r).Decode(&idx); err != nil {
+		return nil, fmt.Errorf("failed to read index: %w", err)
+	}
+	if idx.Entries == nil {
+		idx.Entries = make(map[int]*Entry)
+	}
+	return &idx, nil
+}
+
+// Store persists an index
+type Store interface {
+	// Load returns the stored index, or nil if nothing has been stored yet
+	Load() (*Index, error)
+	Save(idx *Index) error
+}
+
+// FileStore keeps the index in a local file
+type FileStore struct {
+	Path string
+}
+
+// Load reads the index file
+func (s FileStore) Load() (*Index, error) {
+	file, err := os.Open(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedding index: %w", err)
+	}
+	defer file.Close()
+	return ReadIndex(bufio.NewReader(file))
+}
+
+// Save writes the index to a temporary file and renames it, so an interrupted save never
+// leaves a truncated index behind
+func (s FileStore) Save(idx *Index) error {
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create embedding index: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+	if err := idx.Write(w); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write embedding index: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write embedding index: %w", err)
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// RedisStore keeps the index in Redis under RedisKey, shared by every machine using the
+// same Redis
+type RedisStore struct {
+	Cache *cache.RedisCache
+}
+
+// Load reads the index from Redis
+func (s RedisStore) Load() (*Index, error) {
+	data, err := s.Cache.GetBytes(RedisKey)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return ReadIndex(bytes.NewReader(data))
+}
+
+// Save writes the index to Redis
+func (s RedisStore) Save(idx *Index) error {
+	var buf bytes.Buffer
+	if err := idx.Write(&buf); err != nil {
+		return err
+	}
+	return s.Cache.SetBytes(RedisKey, buf.Bytes())
+}
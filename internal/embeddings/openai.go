@@ -0,0 +1,76 @@
+package embeddings
+
+import (
+	"context"
+	"fmt"
+
+	"linkedin-job-scraper/internal/utils"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// DefaultOpenAIModel is used when no embedding model is configured
+const DefaultOpenAIModel = string(openai.SmallEmbedding3)
+
+// openAIBatchSize is the number of texts sent per request
+const openAIBatchSize = 100
+
+// maxInputChars keeps texts well under the model's token limit
+const maxInputChars = 24000
+
+// OpenAIEmbedder calls an OpenAI-compatible embeddings API
+type OpenAIEmbedder struct {
+	client *openai.Client
+	model  string
+}
+
+// NewOpenAIEmbedder creates an embedder. baseURL may be empty for api.openai.com.
+func NewOpenAIEmbedder(apiKey, baseURL, model string) *OpenAIEmbedder {
+	clientConfig := openai.DefaultConfig(apiKey)
+	if baseURL != "" {
+		clientConfig.BaseURL = baseURL
+	}
+	if model == "" {
+		model = DefaultOpenAIModel
+	}
+	return &OpenAIEmbedder{client: openai.NewClientWithConfig(clientConfig), model: model}
+}
+
+// Model returns the embedding model name
+func (e *OpenAIEmbedder) Model() string {
+	return e.model
+}
+
+// Embed embeds texts in batches
+func (e *OpenAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += openAIBatchSize {
+		batch := texts[start:min(start+openAIBatchSize, len(texts))]
+		input := make([]string, len(batch))
+		for i, text := range batch {
+			input[i] = utils.Truncate(text, maxInputChars, "")
+		}
+
+		resp, err := e.client.CreateEmbeddings(ctx, openai.EmbeddingRequest{
+			Input: input,
+			Model: openai.EmbeddingModel(e.model),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create embeddings: %w", err)
+		}
+		if len(resp.Data) != len(batch) {
+			return nil, fmt.Errorf("embeddings API returned %d vectors for %d texts", len(resp.Data), len(batch))
+		}
+
+		batchVectors := make([][]float32, len(batch))
+		for _, item := range resp.Data {
+			if item.Index < 0 || item.Index >= len(batch) {
+				return nil, fmt.Errorf("embeddings API returned index %d for a batch of %d", item.Index, len(batch))
+			}
+			normalise(item.Embedding)
+			batchVectors[item.Index] = item.Embedding
+		}
+		vectors = append(vectors, batchVectors...)
+	}
+	return vectors, nil
+}
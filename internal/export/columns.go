@@ -0,0 +1,115 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// CompanyRow is an exported company with the number of its jobs that matched the export filter
+type CompanyRow struct {
+	models.Company
+	MatchingJobs int `json:"matching_jobs"`
+}
+
+// JobColumns are the columns of job exports
+var JobColumns = []Column[models.JobPosting]{
+	{"linkedin_job_id", TypeInt, func(j *models.JobPosting) interface{} { return int64(j.LinkedInJobID) }},
+	{"title", TypeString, func(j *models.JobPosting) interface{} { return j.Title }},
+	{"company_id", TypeInt, func(j *models.JobPosting) interface{} { return j.CompanyID }},
+	{"company", TypeString, func(j *models.JobPosting) interface{} { return optional(j.CompanyName) }},
+	{"location", TypeString, func(j *models.JobPosting) interface{} { return optional(j.Location) }},
+	{"city", TypeString, func(j *models.JobPosting) interface{} { return str(j.LocationCity) }},
+	{"country_code", TypeString, func(j *models.JobPosting) interface{} { return str(j.CountryCode) }},
+	{"work_type", TypeString, func(j *models.JobPosting) interface{} { return str(j.WorkType) }},
+	{"employment_type", TypeString, func(j *models.JobPosting) interface{} { return str(j.EmploymentType) }},
+	{"seniority_level", TypeString, func(j *models.JobPosting) interface{} { return str(j.SeniorityLevel) }},
+	{"posted_date", TypeTime, func(j *models.JobPosting) interface{} { return date(j.PostedDate) }},
+	{"closed_date", TypeTime, func(j *models.JobPosting) interface{} { return datePtr(j.JobPostClosedDate) }},
+	{"applicants", TypeInt, func(j *models.JobPosting) interface{} { return integer(j.Applicants) }},
+	{"skills", TypeString, func(j *models.JobPosting) interface{} { return skills(j) }},
+	{"salary_min", TypeFloat, func(j *models.JobPosting) interface{} { return float(j.SalaryMin) }},
+	{"salary_max", TypeFloat, func(j *models.JobPosting) interface{} { return float(j.SalaryMax) }},
+	{"salary_currency", TypeString, func(j *models.JobPosting) interface{} { return str(j.SalaryCurrency) }},
+	{"salary_period", TypeString, func(j *models.JobPosting) interface{} { return str(j.SalaryPeriod) }},
+	{"salary_annual_min_dkk", TypeInt, func(j *models.JobPosting) interface{} { return integer(j.SalaryAnnualMinDKK) }},
+	{"salary_annual_max_dkk", TypeInt, func(j *models.JobPosting) interface{} { return integer(j.SalaryAnnualMaxDKK) }},
+	{"repost_of_job_id", TypeInt, func(j *models.JobPosting) interface{} { return integer(j.RepostOfJobID) }},
+	{"url", TypeString, func(j *models.JobPosting) interface{} {
+		return fmt.Sprintf("https://www.linkedin.com/jobs/view/%d/", j.LinkedInJobID)
+	}},
+	{"apply_url", TypeString, func(j *models.JobPosting) interface{} { return optional(j.ApplyURL) }},
+	{"description", TypeString, func(j *models.JobPosting) interface{} { return optional(j.Description) }},
+}
+
+// CompanyColumns are the columns of company exports
+var CompanyColumns = []Column[CompanyRow]{
+	{"company_id", TypeInt, func(c *CompanyRow) interface{} { return int64(c.CompanyID) }},
+	{"name", TypeString, func(c *CompanyRow) interface{} { return c.Name }},
+	{"linkedin_url", TypeString, func(c *CompanyRow) interface{} { return optional(c.LinkedInURL) }},
+	{"industry", TypeString, func(c *CompanyRow) interface{} { return str(c.Industry) }},
+	{"employee_range", TypeString, func(c *CompanyRow) interface{} { return str(c.EmployeeRange) }},
+	{"employee_count_min", TypeInt, func(c *CompanyRow) interface{} { return integer(c.EmployeeCountMin) }},
+	{"employee_count_max", TypeInt, func(c *CompanyRow) interface{} { return integer(c.EmployeeCountMax) }},
+	{"headquarters", TypeString, func(c *CompanyRow) interface{} { return str(c.Headquarters) }},
+	{"website", TypeString, func(c *CompanyRow) interface{} { return str(c.Website) }},
+	{"follower_count", TypeInt, func(c *CompanyRow) interface{} { return integer(c.FollowerCount) }},
+	{"enriched_at", TypeTime, func(c *CompanyRow) interface{} { return datePtr(c.EnrichedAt) }},
+	{"matching_jobs", TypeInt, func(c *CompanyRow) interface{} { return int64(c.MatchingJobs) }},
+}
+
+// skills returns the canonical skill IDs, or the raw labels if the job has none, comma separated
+func skills(j *models.JobPosting) interface{} {
+	list := j.SkillIDs
+	if list == nil || len(*list) == 0 {
+		list = j.Skills
+	}
+	if list == nil || len(*list) == 0 {
+		return nil
+	}
+	return strings.Join(*list, ", ")
+}
+
+func str(s *string) interface{} {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+func optional(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func integer(n *int) interface{} {
+	if n == nil {
+		return nil
+	}
+	return int64(*n)
+}
+
+func float(f *float64) interface{} {
+	if f == nil {
+		return nil
+	}
+	return *f
+}
+
+func date(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+func datePtr(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return date(*t)
+}
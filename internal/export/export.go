@@ -0,0 +1,149 @@
+// Package export writes jobs and companies to CSV, JSON Lines or Parquet files one record at
+// a time, so exports of any size run in constant memory.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Output formats
+const (
+	FormatCSV     = "csv"
+	FormatJSONL   = "jsonl"
+	FormatParquet = "parquet"
+)
+
+// utf8BOM makes Excel open a CSV file as UTF-8, so æ, ø and å show up correctly
+const utf8BOM = "\ufeff"
+
+// csvTimeLayout is understood by Excel and spreadsheets as a date and time
+const csvTimeLayout = "2006-01-02 15:04:05"
+
+// ColumnType is the type of a column's values
+type ColumnType int
+
+// Column types. Value returns string, int64, float64 or time.Time accordingly.
+const (
+	TypeString ColumnType = iota
+	TypeInt
+	TypeFloat
+	TypeTime
+)
+
+// Column is a field of the flat CSV and Parquet outputs
+type Column[T any] struct {
+	Name string
+	Type ColumnType
+	// Value returns the column value of an item, or nil if it is missing
+	Value func(item *T) interface{}
+}
+
+// Options configure a writer
+type Options struct {
+	BOM bool // Start CSV output with a UTF-8 byte order mark, for Excel
+}
+
+// Writer writes items one at a time. Close must be called to finish the output;
+// it doesn't close the underlying writer.
+type Writer[T any] interface {
+	Write(item *T) error
+	Close() error
+}
+
+// FormatFromPath returns the format matching a file extension, CSV if there is none
+func FormatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		return FormatJSONL
+	case ".parquet":
+		return FormatParquet
+	default:
+		return FormatCSV
+	}
+}
+
+// NewWriter creates a writer for format. CSV and Parquet write the columns; JSON Lines
+// writes each item as it encodes to JSON.
+func NewWriter[T any](w io.Writer, format string, columns []Column[T], opts Options) (Writer[T], error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, columns, opts)
+	case FormatJSONL:
+		return &jsonlWriter[T]{encoder: json.NewEncoder(w)}, nil
+	case FormatParquet:
+		return newParquetWriter(w, columns)
+	default:
+		return nil, fmt.Errorf("unknown format %q, expected csv, jsonl or parquet", format)
+	}
+}
+
+type csvWriter[T any] struct {
+	writer  *csv.Writer
+	columns []Column[T]
+	row     []string
+}
+
+func newCSVWriter[T any](w io.Writer, columns []Column[T], opts Options) (*csvWriter[T], error) {
+	if opts.BOM {
+		if _, err := io.WriteString(w, utf8BOM); err != nil {
+			return nil, err
+		}
+	}
+
+	c := &csvWriter[T]{writer: csv.NewWriter(w), columns: columns, row: make([]string, len(columns))}
+	for i, column := range columns {
+		c.row[i] = column.Name
+	}
+	if err := c.writer.Write(c.row); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *csvWriter[T]) Write(item *T) error {
+	for i, column := range c.columns {
+		c.row[i] = formatCSVValue(column.Value(item))
+	}
+	return c.writer.Write(c.row)
+}
+
+func (c *csvWriter[T]) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+func formatCSVValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return v.UTC().Format(csvTimeLayout)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type jsonlWriter[T any] struct {
+	encoder *json.Encoder
+}
+
+func (j *jsonlWriter[T]) Write(item *T) error {
+	return j.encoder.Encode(item)
+}
+
+func (j *jsonlWriter[T]) Close() error {
+	return nil
+}
@@ -0,0 +1,183 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+func testJob() *models.JobPosting {
+	remote := models.WorkTypeRemote
+	applicants := 42
+	skills := models.SkillsList{"go", "kubernetes"}
+	return &models.JobPosting{
+		LinkedInJobID: 4001,
+		Title:         "Udvikler, backend",
+		CompanyID:     7,
+		CompanyName:   "Østergaard & Søn",
+		PostedDate:    time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC),
+		Applicants:    &applicants,
+		WorkType:      &remote,
+		SkillIDs:      &skills,
+		Description:   "Line one\nline \"two\"",
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, FormatCSV, JobColumns, Options{BOM: true})
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+	if err := writer.Write(testJob()); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if !strings.HasPrefix(buf.String(), "\ufeff") {
+		t.Fatal("CSV output doesn't start with a UTF-8 BOM")
+	}
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(buf.String(), "\ufeff"))).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+	if len(records) != 2 || len(records[1]) != len(JobColumns) {
+		t.Fatalf("got %d records, want a header and one row of %d columns", len(records), len(JobColumns))
+	}
+
+	row := make(map[string]string)
+	for i, name := range records[0] {
+		row[name] = records[1][i]
+	}
+	want := map[string]string{
+		"company":     "Østergaard & Søn",
+		"posted_date": "2025-03-01 09:30:00",
+		"closed_date": "",
+		"applicants":  "42",
+		"skills":      "go, kubernetes",
+		"description": "Line one\nline \"two\"",
+	}
+	for name, value := range want {
+		if row[name] != value {
+			t.Errorf("%s = %q, want %q", name, row[name], value)
+		}
+	}
+}
+
+func TestJSONLWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer, _ := NewWriter(&buf, FormatJSONL, CompanyColumns, Options{BOM: true})
+	for _, name := range []string{"Novo Nordisk", "Mærsk"} {
+		if err := writer.Write(&CompanyRow{Company: models.Company{Name: name}, MatchingJobs: 3}); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	writer.Close()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2", len(lines))
+	}
+	var row CompanyRow
+	if err := json.Unmarshal([]byte(lines[1]), &row); err != nil {
+		t.Fatalf("invalid JSON line %q: %v", lines[1], err)
+	}
+	if row.Name != "Mærsk" || row.MatchingJobs != 3 {
+		t.Errorf("row = %+v", row)
+	}
+}
+
+func TestParquetWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := newParquetWriter(&buf, JobColumns)
+	if err != nil {
+		t.Fatalf("newParquetWriter() error = %v", err)
+	}
+
+	// Two row groups, with nulls in runs of different lengths
+	var jobs []*models.JobPosting
+	for i := 0; i < ParquetRowGroupSize+10; i++ {
+		job := testJob()
+		job.LinkedInJobID += i
+		job.Title = fmt.Sprintf("Udvikler %d", i)
+		job.PostedDate = job.PostedDate.Add(time.Duration(i) * time.Minute)
+		if i%2 == 1 {
+			job.Applicants = nil
+		}
+		if i%3 == 0 {
+			salary := float64(i) * 1.5
+			job.SalaryMin = &salary
+		}
+		jobs = append(jobs, job)
+		if err := w.Write(job); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	names, rows := readParquet(t, buf.Bytes())
+	if len(w.rowGroups) != 2 {
+		t.Errorf("wrote %d row groups, want 2", len(w.rowGroups))
+	}
+	for i, column := range JobColumns {
+		if names[i] != column.Name {
+			t.Errorf("column %d = %s, want %s", i, names[i], column.Name)
+		}
+	}
+	if len(rows) != len(jobs) {
+		t.Fatalf("read %d rows, want %d", len(rows), len(jobs))
+	}
+	for r, job := range jobs {
+		for c, column := range JobColumns {
+			got, want := normaliseValue(rows[r][c]), normaliseValue(column.Value(job))
+			if got != want {
+				t.Fatalf("row %d %s = %s, want %s", r, column.Name, got, want)
+			}
+		}
+	}
+}
+
+func TestParquetWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := newParquetWriter(&buf, CompanyColumns)
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if names, rows := readParquet(t, buf.Bytes()); len(names) != len(CompanyColumns) || len(rows) != 0 {
+		t.Errorf("read %d columns and %d rows, want %d and 0", len(names), len(rows), len(CompanyColumns))
+	}
+}
+
+func TestEncodeDefinitionLevels(t *testing.T) {
+	// Runs of two defined, one null and one defined value, each as (run length << 1, level)
+	got := encodeDefinitionLevels([]interface{}{"a", "b", nil, "c"})
+	want := []byte{2 << 1, 1, 1 << 1, 0, 1 << 1, 1}
+	if !bytes.Equal(got, want) {
+		t.Errorf("encodeDefinitionLevels() = %v, want %v", got, want)
+	}
+}
+
+func TestFormatFromPath(t *testing.T) {
+	for path, want := range map[string]string{
+		"jobs.csv":     FormatCSV,
+		"jobs.JSONL":   FormatJSONL,
+		"jobs.parquet": FormatParquet,
+		"-":            FormatCSV,
+		"export/jobs":  FormatCSV,
+		"jobs.ndjson":  FormatJSONL,
+	} {
+		if got := FormatFromPath(path); got != want {
+			t.Errorf("FormatFromPath(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,378 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// ParquetRowGroupSize is the number of rows buffered before a row group is written
+const ParquetRowGroupSize = 5000
+
+const parquetMagic = "PAR1"
+
+// Parquet physical types, converted types, repetition types, encodings and page types
+const (
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+
+	parquetConvertedUTF8            = 0
+	parquetConvertedTimestampMillis = 9
+
+	parquetOptional = 1
+
+	parquetEncodingPlain = 0
+	parquetEncodingRLE   = 3
+
+	parquetCodecUncompressed = 0
+
+	parquetDataPage = 0
+)
+
+// parquetWriter writes a flat Parquet file: every column is optional, values are PLAIN
+// encoded and uncompressed, and rows are written in row groups of ParquetRowGroupSize so
+// only one group is held in memory. For a flat schema the format is simple enough that this
+// beats pulling in a Parquet library with its Thrift, Snappy, Zstd and LZ4 dependencies.
+// See https://github.com/apache/parquet-format for the layout and Thrift definitions.
+type parquetWriter[T any] struct {
+	out       *countingWriter
+	columns   []Column[T]
+	values    [][]interface{} // Column values of the current row group, nil for null
+	rowGroups []parquetRowGroup
+	rows      int64
+}
+
+// parquetRowGroup is what the footer needs to know about a written row group
+type parquetRowGroup struct {
+	columns []parquetColumnChunk
+	size    int64
+	rows    int64
+}
+
+type parquetColumnChunk struct {
+	offset int64
+	size   int64
+	values int64
+}
+
+func newParquetWriter[T any](w io.Writer, columns []Column[T]) (*parquetWriter[T], error) {
+	out := &countingWriter{w: bufio.NewWriter(w)}
+	if _, err := out.Write([]byte(parquetMagic)); err != nil {
+		return nil, err
+	}
+	return &parquetWriter[T]{
+		out:     out,
+		columns: columns,
+		values:  make([][]interface{}, len(columns)),
+	}, nil
+}
+
+func (p *parquetWriter[T]) Write(item *T) error {
+	for i, column := range p.columns {
+		p.values[i] = append(p.values[i], column.Value(item))
+	}
+	if len(p.values[0]) >= ParquetRowGroupSize {
+		return p.flush()
+	}
+	return nil
+}
+
+// flush writes the buffered rows as a row group with one data page per column
+func (p *parquetWriter[T]) flush() error {
+	rows := len(p.values[0])
+	if rows == 0 {
+		return nil
+	}
+
+	group := parquetRowGroup{rows: int64(rows)}
+	for i, column := range p.columns {
+		page, err := encodeParquetPage(column.Type, p.values[i])
+		if err != nil {
+			return fmt.Errorf("failed to encode column %s: %w", column.Name, err)
+		}
+
+		var header thriftWriter
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(len(page)))
+		header.i32(3, int32(len(page)))
+		header.beginStruct(5)
+		header.i32(1, int32(rows))
+		header.i32(2, parquetEncodingPlain)
+		header.i32(3, parquetEncodingRLE)
+		header.i32(4, parquetEncodingRLE)
+		header.endStruct()
+		header.stop()
+
+		offset := p.out.n
+		if _, err := p.out.Write(header.buf.Bytes()); err != nil {
+			return err
+		}
+		if _, err := p.out.Write(page); err != nil {
+			return err
+		}
+		size := p.out.n - offset
+		group.columns = append(group.columns, parquetColumnChunk{offset: offset, size: size, values: int64(rows)})
+		group.size += size
+		p.values[i] = p.values[i][:0]
+	}
+
+	p.rowGroups = append(p.rowGroups, group)
+	p.rows += int64(rows)
+	return nil
+}
+
+// Close writes the last row group and the footer
+func (p *parquetWriter[T]) Close() error {
+	if err := p.flush(); err != nil {
+		return err
+	}
+
+	footer := p.footer()
+	if _, err := p.out.Write(footer); err != nil {
+		return err
+	}
+	if err := binary.Write(p.out, binary.LittleEndian, uint32(len(footer))); err != nil {
+		return err
+	}
+	if _, err := p.out.Write([]byte(parquetMagic)); err != nil {
+		return err
+	}
+	return p.out.w.Flush()
+}
+
+// footer encodes the FileMetaData struct
+func (p *parquetWriter[T]) footer() []byte {
+	var meta thriftWriter
+	meta.i32(1, 1) // version
+
+	meta.beginList(2, thriftStruct, len(p.columns)+1)
+	meta.beginElement()
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(p.columns)))
+	meta.endStruct()
+	for _, column := range p.columns {
+		physical, converted := parquetTypes(column.Type)
+		meta.beginElement()
+		meta.i32(1, physical)
+		meta.i32(3, parquetOptional)
+		meta.binary(4, column.Name)
+		if converted >= 0 {
+			meta.i32(6, converted)
+		}
+		meta.endStruct()
+	}
+
+	meta.i64(3, p.rows)
+
+	meta.beginList(4, thriftStruct, len(p.rowGroups))
+	for _, group := range p.rowGroups {
+		meta.beginElement()
+		meta.beginList(1, thriftStruct, len(group.columns))
+		for i, chunk := range group.columns {
+			physical, _ := parquetTypes(p.columns[i].Type)
+			meta.beginElement()
+			meta.i64(2, chunk.offset) // file_offset
+			meta.beginStruct(3)       // meta_data
+			meta.i32(1, physical)
+			meta.beginList(2, thriftI32, 2)
+			meta.listI32(parquetEncodingPlain)
+			meta.listI32(parquetEncodingRLE)
+			meta.beginList(3, thriftBinary, 1)
+			meta.listBinary(p.columns[i].Name)
+			meta.i32(4, parquetCodecUncompressed)
+			meta.i64(5, chunk.values)
+			meta.i64(6, chunk.size)
+			meta.i64(7, chunk.size)
+			meta.i64(9, chunk.offset) // data_page_offset
+			meta.endStruct()
+			meta.endStruct()
+		}
+		meta.i64(2, group.size)
+		meta.i64(3, group.rows)
+		meta.endStruct()
+	}
+
+	meta.binary(6, "linkedin-job-scraper")
+	meta.stop()
+	return meta.buf.Bytes()
+}
+
+// parquetTypes returns the physical and converted type of a column, -1 if there is no converted type
+func parquetTypes(t ColumnType) (physical, converted int32) {
+	switch t {
+	case TypeInt:
+		return parquetInt64, -1
+	case TypeFloat:
+		return parquetDouble, -1
+	case TypeTime:
+		return parquetInt64, parquetConvertedTimestampMillis
+	default:
+		return parquetByteArray, parquetConvertedUTF8
+	}
+}
+
+// encodeParquetPage encodes the definition levels and the non-null values of a data page
+func encodeParquetPage(t ColumnType, values []interface{}) ([]byte, error) {
+	var page bytes.Buffer
+
+	levels := encodeDefinitionLevels(values)
+	binary.Write(&page, binary.LittleEndian, uint32(len(levels)))
+	page.Write(levels)
+
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		switch t {
+		case TypeInt:
+			n, ok := value.(int64)
+			if !ok {
+				return nil, fmt.Errorf("expected int64, got %T", value)
+			}
+			binary.Write(&page, binary.LittleEndian, n)
+		case TypeFloat:
+			f, ok := value.(float64)
+			if !ok {
+				return nil, fmt.Errorf("expected float64, got %T", value)
+			}
+			binary.Write(&page, binary.LittleEndian, math.Float64bits(f))
+		case TypeTime:
+			ts, ok := value.(time.Time)
+			if !ok {
+				return nil, fmt.Errorf("expected time.Time, got %T", value)
+			}
+			binary.Write(&page, binary.LittleEndian, ts.UnixMilli())
+		default:
+			s, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected string, got %T", value)
+			}
+			binary.Write(&page, binary.LittleEndian, uint32(len(s)))
+			page.WriteString(s)
+		}
+	}
+	return page.Bytes(), nil
+}
+
+// encodeDefinitionLevels encodes 1 for a value and 0 for a null as RLE runs of bit width 1
+func encodeDefinitionLevels(values []interface{}) []byte {
+	var levels []byte
+	for start := 0; start < len(values); {
+		defined := values[start] != nil
+		end := start + 1
+		for end < len(values) && (values[end] != nil) == defined {
+			end++
+		}
+		levels = binary.AppendUvarint(levels, uint64(end-start)<<1)
+		if defined {
+			levels = append(levels, 1)
+		} else {
+			levels = append(levels, 0)
+		}
+		start = end
+	}
+	return levels
+}
+
+// countingWriter tracks the file offset for the footer
+type countingWriter struct {
+	w *bufio.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}
+
+// Thrift compact protocol type IDs
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes structs with the Thrift compact protocol, which Parquet uses for
+// page headers and the footer
+type thriftWriter struct {
+	buf       bytes.Buffer
+	lastField []int16 // Last field ID written, per open struct
+	field     int16
+}
+
+func (t *thriftWriter) fieldHeader(id int16, typ byte) {
+	if delta := id - t.field; delta > 0 && delta <= 15 {
+		t.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		t.buf.WriteByte(typ)
+		t.varint(int64(id))
+	}
+	t.field = id
+}
+
+func (t *thriftWriter) varint(n int64) {
+	t.buf.Write(binary.AppendVarint(nil, n)) // Zigzag, as the compact protocol expects
+}
+
+func (t *thriftWriter) i32(id int16, n int32) {
+	t.fieldHeader(id, thriftI32)
+	t.varint(int64(n))
+}
+
+func (t *thriftWriter) i64(id int16, n int64) {
+	t.fieldHeader(id, thriftI64)
+	t.varint(n)
+}
+
+func (t *thriftWriter) binary(id int16, s string) {
+	t.fieldHeader(id, thriftBinary)
+	t.listBinary(s)
+}
+
+func (t *thriftWriter) beginStruct(id int16) {
+	t.fieldHeader(id, thriftStruct)
+	t.beginElement()
+}
+
+// beginElement starts a struct inside a list
+func (t *thriftWriter) beginElement() {
+	t.lastField = append(t.lastField, t.field)
+	t.field = 0
+}
+
+func (t *thriftWriter) endStruct() {
+	t.stop()
+	t.field = t.lastField[len(t.lastField)-1]
+	t.lastField = t.lastField[:len(t.lastField)-1]
+}
+
+func (t *thriftWriter) stop() {
+	t.buf.WriteByte(0)
+}
+
+func (t *thriftWriter) beginList(id int16, elemType byte, size int) {
+	t.fieldHeader(id, thriftList)
+	if size < 15 {
+		t.buf.WriteByte(byte(size)<<4 | elemType)
+	} else {
+		t.buf.WriteByte(0xf0 | elemType)
+		t.buf.Write(binary.AppendUvarint(nil, uint64(size)))
+	}
+}
+
+func (t *thriftWriter) listI32(n int32) {
+	t.varint(int64(n))
+}
+
+func (t *thriftWriter) listBinary(s string) {
+	t.buf.Write(binary.AppendUvarint(nil, uint64(len(s))))
+	t.buf.WriteString(s)
+}
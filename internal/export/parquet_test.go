@@ -0,0 +1,262 @@
+package export
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+// The test decodes files with its own Thrift compact protocol reader, so a mistake in the
+// writer's encoder isn't mirrored by the check.
+
+// thriftReader decodes Thrift compact protocol structs into maps of field ID to value:
+// integers are int64, binaries string, lists []interface{} and structs thriftStruct
+type thriftReader struct {
+	t    *testing.T
+	data []byte
+	pos  int
+}
+
+type thriftFields map[int16]interface{}
+
+func (r *thriftReader) byte() byte {
+	if r.pos >= len(r.data) {
+		r.t.Fatalf("thrift: read past the end at %d", r.pos)
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	n, size := binary.Uvarint(r.data[r.pos:])
+	if size <= 0 {
+		r.t.Fatalf("thrift: bad varint at %d", r.pos)
+	}
+	r.pos += size
+	return n
+}
+
+func (r *thriftReader) zigzag() int64 {
+	n := r.uvarint()
+	return int64(n>>1) ^ -int64(n&1)
+}
+
+func (r *thriftReader) readStruct() thriftFields {
+	fields := thriftFields{}
+	var last int16
+	for {
+		header := r.byte()
+		if header == 0 {
+			return fields
+		}
+		id := last + int16(header>>4)
+		if header>>4 == 0 {
+			id = int16(r.zigzag())
+		}
+		last = id
+		fields[id] = r.readValue(header & 0x0f)
+	}
+}
+
+func (r *thriftReader) readValue(typ byte) interface{} {
+	switch typ {
+	case 1, 2: // Booleans are stored in the type
+		return typ == 1
+	case 3:
+		return int64(int8(r.byte()))
+	case 4, 5, 6:
+		return r.zigzag()
+	case 7:
+		bits := binary.LittleEndian.Uint64(r.data[r.pos:])
+		r.pos += 8
+		return math.Float64frombits(bits)
+	case 8:
+		n := int(r.uvarint())
+		if r.pos+n > len(r.data) {
+			r.t.Fatalf("thrift: binary of %d bytes past the end at %d", n, r.pos)
+		}
+		s := string(r.data[r.pos : r.pos+n])
+		r.pos += n
+		return s
+	case 9, 10:
+		header := r.byte()
+		size := int(header >> 4)
+		if size == 15 {
+			size = int(r.uvarint())
+		}
+		list := make([]interface{}, size)
+		for i := range list {
+			list[i] = r.readValue(header & 0x0f)
+		}
+		return list
+	case 12:
+		return r.readStruct()
+	default:
+		r.t.Fatalf("thrift: unsupported type %d at %d", typ, r.pos)
+		return nil
+	}
+}
+
+// field returns a struct field, failing the test if it is missing or has another type
+func field[V any](t *testing.T, fields thriftFields, id int16) V {
+	t.Helper()
+	value, ok := fields[id].(V)
+	if !ok {
+		t.Fatalf("field %d = %#v, want a %T", id, fields[id], *new(V))
+	}
+	return value
+}
+
+// readParquet decodes a file written by parquetWriter and returns its column names and rows,
+// with nil for nulls and time.Time for timestamps
+func readParquet(t *testing.T, data []byte) ([]string, [][]interface{}) {
+	t.Helper()
+	if len(data) < 12 || string(data[:4]) != parquetMagic || string(data[len(data)-4:]) != parquetMagic {
+		t.Fatal("missing PAR1 magic at the start or end of the file")
+	}
+	footerLength := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	if footerLength <= 0 || footerLength > len(data)-12 {
+		t.Fatalf("footer length %d doesn't fit in a %d byte file", footerLength, len(data))
+	}
+	footer := &thriftReader{t: t, data: data[len(data)-8-footerLength : len(data)-8]}
+	meta := footer.readStruct()
+	if footer.pos != footerLength {
+		t.Fatalf("FileMetaData ends at %d, footer is %d bytes", footer.pos, footerLength)
+	}
+
+	schema := field[[]interface{}](t, meta, 2)
+	root := schema[0].(thriftFields)
+	if int(field[int64](t, root, 5)) != len(schema)-1 {
+		t.Fatalf("root has %d children, schema has %d columns", root[5], len(schema)-1)
+	}
+	var names []string
+	var types []int64
+	var timestamps []bool
+	for _, element := range schema[1:] {
+		element := element.(thriftFields)
+		if field[int64](t, element, 3) != parquetOptional {
+			t.Errorf("column %v is not optional", element[4])
+		}
+		names = append(names, field[string](t, element, 4))
+		types = append(types, field[int64](t, element, 1))
+		timestamps = append(timestamps, element[6] == int64(parquetConvertedTimestampMillis))
+	}
+
+	var rows [][]interface{}
+	for _, group := range field[[]interface{}](t, meta, 4) {
+		group := group.(thriftFields)
+		groupRows := int(field[int64](t, group, 3))
+		chunks := field[[]interface{}](t, group, 1)
+		if len(chunks) != len(names) {
+			t.Fatalf("row group has %d column chunks, want %d", len(chunks), len(names))
+		}
+
+		first := len(rows)
+		for i := 0; i < groupRows; i++ {
+			rows = append(rows, make([]interface{}, len(names)))
+		}
+		var groupSize int64
+		for c, chunk := range chunks {
+			chunkMeta := field[thriftFields](t, chunk.(thriftFields), 3)
+			if path := field[[]interface{}](t, chunkMeta, 3); len(path) != 1 || path[0] != names[c] {
+				t.Errorf("column chunk %d has path %v, want [%s]", c, path, names[c])
+			}
+			if field[int64](t, chunkMeta, 1) != types[c] || field[int64](t, chunkMeta, 4) != parquetCodecUncompressed ||
+				int(field[int64](t, chunkMeta, 5)) != groupRows {
+				t.Errorf("column chunk %s metadata = %v", names[c], chunkMeta)
+			}
+
+			offset := field[int64](t, chunkMeta, 9)
+			size := field[int64](t, chunkMeta, 6)
+			groupSize += size
+			pageReader := &thriftReader{t: t, data: data[:offset+size], pos: int(offset)}
+			header := pageReader.readStruct()
+			pageSize := field[int64](t, header, 3)
+			if field[int64](t, header, 1) != parquetDataPage || field[int64](t, header, 2) != pageSize {
+				t.Fatalf("column %s page header = %v", names[c], header)
+			}
+			dataHeader := field[thriftFields](t, header, 5)
+			if int(field[int64](t, dataHeader, 1)) != groupRows || field[int64](t, dataHeader, 2) != parquetEncodingPlain ||
+				field[int64](t, dataHeader, 3) != parquetEncodingRLE {
+				t.Fatalf("column %s data page header = %v", names[c], dataHeader)
+			}
+			if int64(pageReader.pos)+pageSize != offset+size {
+				t.Fatalf("column %s page ends at %d, chunk at %d", names[c], int64(pageReader.pos)+pageSize, offset+size)
+			}
+
+			values := decodeParquetPage(t, data[pageReader.pos:offset+size], types[c], timestamps[c], groupRows)
+			for i, value := range values {
+				rows[first+i][c] = value
+			}
+		}
+		if groupSize != field[int64](t, group, 2) {
+			t.Errorf("row group total_byte_size = %v, column chunks add up to %d", group[2], groupSize)
+		}
+	}
+	if int(field[int64](t, meta, 3)) != len(rows) {
+		t.Errorf("num_rows = %v, row groups hold %d", meta[3], len(rows))
+	}
+	return names, rows
+}
+
+// decodeParquetPage reads the RLE definition levels and PLAIN values of a data page
+func decodeParquetPage(t *testing.T, page []byte, physical int64, timestamp bool, rows int) []interface{} {
+	t.Helper()
+	levelsLength := int(binary.LittleEndian.Uint32(page))
+	levels := &thriftReader{t: t, data: page[4 : 4+levelsLength]}
+	var defined []bool
+	for levels.pos < len(levels.data) {
+		header := levels.uvarint()
+		if header&1 == 1 {
+			t.Fatal("unexpected bit-packed definition levels")
+		}
+		level := levels.byte()
+		for i := uint64(0); i < header>>1; i++ {
+			defined = append(defined, level == 1)
+		}
+	}
+	if len(defined) != rows {
+		t.Fatalf("%d definition levels, want %d", len(defined), rows)
+	}
+
+	data := page[4+levelsLength:]
+	values := make([]interface{}, rows)
+	for i := range values {
+		if !defined[i] {
+			continue
+		}
+		switch physical {
+		case parquetInt64:
+			n := int64(binary.LittleEndian.Uint64(data))
+			values[i] = n
+			if timestamp {
+				values[i] = time.UnixMilli(n).UTC()
+			}
+			data = data[8:]
+		case parquetDouble:
+			values[i] = math.Float64frombits(binary.LittleEndian.Uint64(data))
+			data = data[8:]
+		case parquetByteArray:
+			n := int(binary.LittleEndian.Uint32(data))
+			values[i] = string(data[4 : 4+n])
+			data = data[4+n:]
+		default:
+			t.Fatalf("unexpected physical type %d", physical)
+		}
+	}
+	if len(data) != 0 {
+		t.Fatalf("%d bytes left after the values of the page", len(data))
+	}
+	return values
+}
+
+// normaliseValue makes a column value comparable to a decoded one
+func normaliseValue(value interface{}) string {
+	if ts, ok := value.(time.Time); ok {
+		return ts.UTC().Truncate(time.Millisecond).Format(time.RFC3339Nano)
+	}
+	return fmt.Sprintf("%#v", value)
+}
@@ -0,0 +1,98 @@
+// Package match scores job postings against a candidate profile (job_match_config.json)
+// with deterministic rules, without any AI.
+package match
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// DefaultConfigPath is the candidate profile shipped with the repository
+const DefaultConfigPath = "job_match_config.json"
+
+// Config is the candidate profile and rule weights in job_match_config.json
+type Config struct {
+	Candidate Candidate `json:"candidate"`
+	Weights   Weights   `json:"weights"`
+}
+
+// Candidate describes who the jobs are matched for
+type Candidate struct {
+	Name            string          `json:"name"`
+	Location        string          `json:"location"`
+	YearsExperience int             `json:"years_experience"`
+	PrimarySkills   []string        `json:"primary_skills"`
+	SecondarySkills []string        `json:"secondary_skills"`
+	PreferredRoles  []string        `json:"preferred_roles"`
+	AvoidRoles      []string        `json:"avoid_roles"`
+	CompanySize     CompanySize     `json:"company_size"`
+	WorkPreferences WorkPreferences `json:"work_preferences"`
+	SalaryRange     SalaryRange     `json:"salary_range"`
+	Languages       []string        `json:"languages"`
+}
+
+// CompanySize is the candidate's preferred employer size
+type CompanySize struct {
+	Minimum   int    `json:"minimum"`
+	Preferred string `json:"preferred"` // e.g. "50-500 employees"
+}
+
+// PreferredRange parses Preferred into its bounds; max is 0 for open ranges like "500+"
+func (cs CompanySize) PreferredRange() (min, max int, ok bool) {
+	fields := strings.FieldsFunc(cs.Preferred, func(r rune) bool { return r < '0' || r > '9' })
+	if len(fields) == 0 {
+		return 0, 0, false
+	}
+	min, _ = strconv.Atoi(fields[0])
+	if len(fields) > 1 {
+		max, _ = strconv.Atoi(fields[1])
+	}
+	return min, max, true
+}
+
+// WorkPreferences are the workplace types the candidate accepts and their commute limits
+type WorkPreferences struct {
+	Remote         bool   `json:"remote"`
+	Hybrid         bool   `json:"hybrid"`
+	OnSite         bool   `json:"on_site"`
+	MaxCommute     string `json:"max_commute"`      // e.g. "45 minutes from Roskilde"
+	HomePostalCode string `json:"home_postal_code"` // Overrides the origin in MaxCommute
+	CommuteMode    string `json:"commute_mode"`     // car, public_transport, bike or walk
+}
+
+// SalaryRange is the candidate's expected yearly salary in DKK
+type SalaryRange struct {
+	Minimum int `json:"minimum"`
+	Maximum int `json:"maximum"`
+}
+
+// Weights are the relative weights of each rule in the overall score
+type Weights struct {
+	Location     int `json:"location"`
+	TechMatch    int `json:"tech_match"`
+	CompanyFit   int `json:"company_fit"`
+	SeniorityFit int `json:"seniority_fit"`
+	WorkTypeFit  int `json:"work_type_fit"`
+}
+
+// LoadConfig reads a job match config file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read match config: %w", err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse match config: %w", err)
+	}
+
+	w := cfg.Weights
+	if w.Location+w.TechMatch+w.CompanyFit+w.SeniorityFit+w.WorkTypeFit <= 0 {
+		return nil, fmt.Errorf("match config %s has no weights", path)
+	}
+	return &cfg, nil
+}
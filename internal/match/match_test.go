@@ -0,0 +1,237 @@
+package match
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/addresses"
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/skills"
+)
+
+func TestContainsTerm(t *testing.T) {
+	tests := []struct {
+		text     string
+		term     string
+		expected bool
+	}{
+		{"We use Go, Docker and AWS", "Go", true},
+		{"Experience with Google Cloud", "Go", false},
+		{"MongoDB and Postgres", "Go", false},
+		{"C# and .NET developer", "C#", true},
+		{"C# and .NET developer", ".NET", true},
+		{"Node.js backend", "Node.js", true},
+		{"Senior PHP-udvikler", "PHP", true},
+		{"", "PHP", false},
+	}
+
+	for _, tt := range tests {
+		if result := containsTerm(tt.text, tt.term); result != tt.expected {
+			t.Errorf("containsTerm(%q, %q) = %v, expected %v", tt.text, tt.term, result, tt.expected)
+		}
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	cfg, err := LoadConfig("../../" + DefaultConfigPath)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if cfg.Weights.TechMatch != 35 || len(cfg.Candidate.PrimarySkills) == 0 {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+	if min, max, ok := cfg.Candidate.CompanySize.PreferredRange(); !ok || min != 50 || max != 500 {
+		t.Errorf("PreferredRange() = %d, %d, %v, expected 50, 500", min, max, ok)
+	}
+}
+
+func testConfig() *Config {
+	return &Config{
+		Candidate: Candidate{
+			YearsExperience: 8,
+			PrimarySkills:   []string{"PHP", "Laravel", "Docker"},
+			SecondarySkills: []string{"Go", "MySQL"},
+			PreferredRoles:  []string{"Backend Developer"},
+			AvoidRoles:      []string{"Manager"},
+			CompanySize:     CompanySize{Minimum: 50, Preferred: "50-500 employees"},
+			WorkPreferences: WorkPreferences{Remote: true, Hybrid: true, OnSite: false, MaxCommute: "45 minutes from Roskilde", HomePostalCode: "4000"},
+			SalaryRange:     SalaryRange{Minimum: 600000},
+		},
+		Weights: Weights{Location: 25, TechMatch: 35, CompanyFit: 20, SeniorityFit: 15, WorkTypeFit: 5},
+	}
+}
+
+func TestRate(t *testing.T) {
+	matcher := NewMatcher(testConfig(), addresses.DefaultCentroids())
+	if len(matcher.Warnings()) > 0 {
+		t.Fatalf("unexpected warnings: %v", matcher.Warnings())
+	}
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	hybrid, onSite := models.WorkTypeHybrid, models.WorkTypeOnSite
+	minEmployees, maxEmployees, employeeRange := 51, 200, "51-200"
+	company := &models.Company{EmployeeCountMin: &minEmployees, EmployeeCountMax: &maxEmployees, EmployeeRange: &employeeRange}
+
+	good := &models.JobPosting{
+		JobID:       1,
+		Title:       "Backend Developer",
+		Location:    "Roskilde, Region Zealand, Denmark",
+		Description: "You will build our PHP and Laravel platform, running in Docker on MySQL.",
+		WorkType:    &hybrid,
+	}
+	rating := matcher.Rate(good, company, now)
+
+	if rating.RatingType != RatingType || rating.JobID != 1 || !rating.RatedAt.Equal(now) {
+		t.Errorf("unexpected rating metadata: %+v", rating)
+	}
+	if rating.TechScore == nil || *rating.TechScore != 85 {
+		t.Errorf("TechScore = %v, expected 85", rating.TechScore)
+	}
+	if rating.TeamSizeScore == nil || *rating.TeamSizeScore != 100 {
+		t.Errorf("TeamSizeScore = %v, expected 100", rating.TeamSizeScore)
+	}
+	if rating.LeadershipScore == nil || *rating.LeadershipScore != 100 {
+		t.Errorf("LeadershipScore = %v, expected 100", rating.LeadershipScore)
+	}
+	if rating.LocationScore == nil || *rating.LocationScore < 90 {
+		t.Errorf("LocationScore = %v, expected at least 90 for a hybrid job in the home town", rating.LocationScore)
+	}
+	if rating.OverallScore < 90 {
+		t.Errorf("OverallScore = %d, expected at least 90", rating.OverallScore)
+	}
+	if result, ok := (*rating.Criteria)[RuleTech].(RuleResult); !ok || result.Reason == "" || result.Weight != 35 {
+		t.Errorf("expected an explained tech_match criterion, got %+v", (*rating.Criteria)[RuleTech])
+	}
+
+	// On-site is not accepted, the title is a role to avoid and the salary is too low
+	salary := 450000
+	bad := &models.JobPosting{
+		Title:              "Engineering Manager",
+		Location:           "Aarhus C",
+		Description:        "Lead a team of Java developers.",
+		WorkType:           &onSite,
+		SalaryAnnualMaxDKK: &salary,
+	}
+	rating = matcher.Rate(bad, nil, now)
+
+	if *rating.LocationScore != 0 || *rating.LeadershipScore != 0 || *rating.TechScore != 0 {
+		t.Errorf("expected zero sub-scores, got location %d, leadership %d, tech %d",
+			*rating.LocationScore, *rating.LeadershipScore, *rating.TechScore)
+	}
+	if rating.TeamSizeScore != nil {
+		t.Errorf("TeamSizeScore = %d, expected nil for an unknown company", *rating.TeamSizeScore)
+	}
+	if rating.OverallScore > 15 {
+		t.Errorf("OverallScore = %d, expected at most 15", rating.OverallScore)
+	}
+}
+
+func TestLanguageRule(t *testing.T) {
+	cfg := testConfig()
+	cfg.Candidate.Languages = []string{"English"}
+	matcher := NewMatcher(cfg, nil)
+
+	tests := []struct {
+		description string
+		score       *int
+		reason      string
+	}{
+		{"You will build our PHP platform. You speak and write Danish fluently.", intPtr(0), "requires Danish, not in the candidate's languages"},
+		{"We are a team of developers and you will work with our PHP platform in English.", intPtr(100), "requires English"},
+		{"Du skal arbejde med vores PHP platform, og det er en fordel at have erfaring med Docker.", intPtr(0), "requires Danish, not in the candidate's languages"},
+		{"You work with the team on our PHP platform. German is a plus.", intPtr(100), "requires English"},
+		{"PHP", nil, "no language requirements found"},
+	}
+	for _, tt := range tests {
+		result := matcher.languageRule(&models.JobPosting{Description: tt.description})
+		if (result.Score == nil) != (tt.score == nil) || (result.Score != nil && *result.Score != *tt.score) || result.Reason != tt.reason {
+			t.Errorf("languageRule(%q) = %v, %q, expected %v, %q", tt.description, result.Score, result.Reason, tt.score, tt.reason)
+		}
+	}
+
+	// A language the candidate doesn't speak caps an otherwise good match
+	hybrid := models.WorkTypeHybrid
+	rating := matcher.Rate(&models.JobPosting{
+		Title:       "Backend Developer",
+		Location:    "Roskilde, Region Zealand, Denmark",
+		Description: "You will build our PHP and Laravel platform on Docker and MySQL. Fluent Danish is required.",
+		WorkType:    &hybrid,
+	}, nil, time.Now())
+	if rating.OverallScore > 40 {
+		t.Errorf("OverallScore = %d, expected at most 40", rating.OverallScore)
+	}
+}
+
+func TestProfileMatcherRank(t *testing.T) {
+	taxonomy, err := skills.LoadTaxonomy("../../" + skills.DefaultTaxonomyPath)
+	if err != nil {
+		t.Fatalf("LoadTaxonomy failed: %v", err)
+	}
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	date := func(year int) *time.Time {
+		d := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	seniority, city := models.SeniorityMidSenior, "Copenhagen"
+	job := &models.JobPosting{
+		LinkedInJobID:  1,
+		Title:          "Senior Backend Developer",
+		Location:       "Copenhagen, Capital Region of Denmark",
+		LocationCity:   &city,
+		SkillIDs:       &models.SkillsList{"go", "docker", "postgresql"},
+		SeniorityLevel: &seniority,
+	}
+
+	profiles := []models.UserProfile{
+		{
+			LinkedInURL:  "https://www.linkedin.com/in/junior/",
+			LocationCity: "Aarhus, Denmark",
+			Positions: []models.ProfilePosition{
+				{Title: "Frontend Developer", StartDate: date(2023), Skills: []string{"React"}},
+			},
+			SkillFrequencies: map[string]int{"React": 2, "Docker": 1},
+		},
+		{
+			LinkedInURL:  "https://www.linkedin.com/in/senior/",
+			LocationCity: "Copenhagen, Capital Region of Denmark",
+			Positions: []models.ProfilePosition{
+				{Title: "Backend Developer", CompanyName: "Acme", StartDate: date(2019), Skills: []string{"Golang", "Docker"}},
+				{Title: "Developer", StartDate: date(2015), EndDate: date(2019), Skills: []string{"Docker", "PostgreSQL"}},
+				{Title: "Consultant", StartDate: date(2017), EndDate: date(2018)},
+			},
+			SkillFrequencies: map[string]int{"Golang": 3, "Docker": 2, "PostgreSQL": 1},
+		},
+	}
+
+	matches := NewProfileMatcher(taxonomy, now).Rank(job, profiles)
+	if len(matches) != 2 || matches[0].Username != "senior" {
+		t.Fatalf("expected senior first, got %+v", matches)
+	}
+
+	best := matches[0]
+	if best.YearsExperience != 9 {
+		t.Errorf("YearsExperience = %d, expected 9 with overlapping positions counted once", best.YearsExperience)
+	}
+	if tech := best.Criteria[RuleSkills]; tech.Score == nil || *tech.Score != 83 || !strings.Contains(tech.Reason, "3/3") {
+		t.Errorf("unexpected skills criterion: %+v", tech)
+	}
+	if title := best.Criteria[RuleTitle]; title.Score == nil || *title.Score != 80 || !strings.Contains(title.Reason, "Acme") {
+		t.Errorf("unexpected title criterion: %+v", title)
+	}
+	if location := best.Criteria[RuleLocation]; location.Score == nil || *location.Score != 100 {
+		t.Errorf("unexpected location criterion: %+v", location)
+	}
+	if experience := best.Criteria[RuleExperience]; experience.Score == nil || *experience.Score != 100 {
+		t.Errorf("unexpected experience criterion: %+v", experience)
+	}
+
+	junior := matches[1]
+	if experience := junior.Criteria[RuleExperience]; experience.Score == nil || *experience.Score != 25 {
+		t.Errorf("unexpected experience criterion for 1 year: %+v", experience)
+	}
+	if junior.Score >= 40 {
+		t.Errorf("Score = %d, expected below 40", junior.Score)
+	}
+}
@@ -0,0 +1,361 @@
+package match
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"linkedin-job-scraper/internal/addresses"
+	"linkedin-job-scraper/internal/commute"
+	"linkedin-job-scraper/internal/models"
+)
+
+// RatingType is the rating_type of ratings made by the rules in this package
+const RatingType = "rules"
+
+// neutralScore stands in for a rule that can't be evaluated, e.g. an unknown company size,
+// so missing data neither helps nor hurts a job
+const neutralScore = 50
+
+// Rule names, used as keys in JobRating.Criteria
+const (
+	RuleLocation  = "location"
+	RuleTech      = "tech_match"
+	RuleCompany   = "company_fit"
+	RuleSeniority = "seniority_fit"
+	RuleWorkType  = "work_type_fit"
+	RuleSalary    = "salary"
+	RuleLanguage  = "language"
+)
+
+// RuleResult is one rule's score and the reason for it, stored in JobRating.Criteria
+type RuleResult struct {
+	Score  *int   `json:"score"`
+	Weight int    `json:"weight"`
+	Reason string `json:"reason"`
+}
+
+// Matcher rates jobs against a candidate profile
+type Matcher struct {
+	config   *Config
+	commute  *commute.Estimator
+	warnings []string
+}
+
+// NewMatcher creates a matcher for a config. Commute-based location scores need centroids
+// and a parseable max_commute; without them the location rule only checks the workplace type.
+func NewMatcher(cfg *Config, centroids *addresses.Centroids) *Matcher {
+	m := &Matcher{config: cfg}
+
+	prefs := cfg.Candidate.WorkPreferences
+	if prefs.MaxCommute == "" || centroids == nil {
+		return m
+	}
+
+	maxCommute, origin, err := commute.ParseMaxCommute(prefs.MaxCommute)
+	if err != nil {
+		m.warnings = append(m.warnings, err.Error())
+		return m
+	}
+	if prefs.HomePostalCode != "" {
+		origin = prefs.HomePostalCode
+	}
+	mode := commute.Mode(prefs.CommuteMode)
+	if mode == "" {
+		mode = commute.ModeCar
+	}
+
+	estimator, err := commute.NewEstimator(centroids, origin, maxCommute, mode)
+	if err != nil {
+		m.warnings = append(m.warnings, err.Error())
+		return m
+	}
+	m.commute = estimator
+	return m
+}
+
+// Warnings returns problems with the config found while creating the matcher
+func (m *Matcher) Warnings() []string {
+	return m.warnings
+}
+
+// Rate scores a job. company may be nil when the job's company is unknown.
+func (m *Matcher) Rate(job *models.JobPosting, company *models.Company, now time.Time) *models.JobRating {
+	weights := m.config.Weights
+	results := map[string]RuleResult{
+		RuleLocation:  m.locationRule(job),
+		RuleTech:      m.techRule(job),
+		RuleCompany:   m.companyRule(company),
+		RuleSeniority: m.seniorityRule(job),
+		RuleWorkType:  m.workTypeRule(job),
+		RuleSalary:    m.salaryRule(job),
+		RuleLanguage:  m.languageRule(job),
+	}
+	for rule, weight := range map[string]int{
+		RuleLocation:  weights.Location,
+		RuleTech:      weights.TechMatch,
+		RuleCompany:   weights.CompanyFit,
+		RuleSeniority: weights.SeniorityFit,
+		RuleWorkType:  weights.WorkTypeFit,
+	} {
+		result := results[rule]
+		result.Weight = weight
+		results[rule] = result
+	}
+
+	criteria := models.RatingCriteria{}
+	for rule, result := range results {
+		criteria[rule] = result
+	}
+	overall := weightedScore(results)
+
+	// A salary ceiling below the candidate's minimum or a language the candidate doesn't speak
+	// caps the match, whatever else fits
+	for _, rule := range []string{RuleSalary, RuleLanguage} {
+		if result := results[rule]; result.Score != nil && *result.Score == 0 && overall > 40 {
+			overall = 40
+		}
+	}
+
+	return &models.JobRating{
+		JobID:           job.JobID,
+		OverallScore:    overall,
+		LocationScore:   results[RuleLocation].Score,
+		TechScore:       results[RuleTech].Score,
+		TeamSizeScore:   results[RuleCompany].Score,
+		LeadershipScore: results[RuleSeniority].Score,
+		Criteria:        &criteria,
+		RatingType:      RatingType,
+		RatedAt:         now,
+	}
+}
+
+// locationRule scores the commute, or rejects workplace types the candidate doesn't accept
+func (m *Matcher) locationRule(job *models.JobPosting) RuleResult {
+	workType := jobWorkType(job)
+	if workType != "" && !m.acceptsWorkType(workType) {
+		return RuleResult{Score: intPtr(0), Reason: fmt.Sprintf("%s jobs are not accepted", workType)}
+	}
+	if workType == models.WorkTypeRemote {
+		return RuleResult{Score: intPtr(100), Reason: "remote job, no commute"}
+	}
+	if m.commute == nil {
+		return RuleResult{Reason: "no commute preference configured"}
+	}
+
+	estimate, ok := m.commute.EstimateJob(job)
+	score := m.commute.LocationScore(job)
+	if !ok || score == nil {
+		return RuleResult{Reason: fmt.Sprintf("location %q is not in the postal code table", job.Location)}
+	}
+
+	reason := fmt.Sprintf("~%.0f min to %s %s (%.0f km)", estimate.TravelTime.Minutes(),
+		estimate.Destination.PostalCode, estimate.Destination.City, estimate.DistanceKm)
+	if workType == models.WorkTypeHybrid {
+		reason += ", hybrid"
+	}
+	return RuleResult{Score: score, Reason: reason}
+}
+
+// techRule counts the candidate's skills found in the job's skills, title and description.
+// Each primary skill is worth 25 points and each secondary skill 10, so four primary skills
+// make a full match.
+func (m *Matcher) techRule(job *models.JobPosting) RuleResult {
+	texts := []string{job.Title, job.Description}
+	if job.Skills != nil {
+		texts = append(texts, strings.Join(*job.Skills, ", "))
+	}
+
+	primary := matchingTerms(m.config.Candidate.PrimarySkills, texts...)
+	secondary := matchingTerms(m.config.Candidate.SecondarySkills, texts...)
+	score := min(100, 25*len(primary)+10*len(secondary))
+
+	if len(primary)+len(secondary) == 0 {
+		return RuleResult{Score: intPtr(0), Reason: "none of the candidate's skills are mentioned"}
+	}
+	reason := fmt.Sprintf("primary: %s", joinOrNone(primary))
+	reason += fmt.Sprintf("; secondary: %s", joinOrNone(secondary))
+	return RuleResult{Score: &score, Reason: reason}
+}
+
+// companyRule compares the company's employee range with the preferred size
+func (m *Matcher) companyRule(company *models.Company) RuleResult {
+	if company == nil || company.EmployeeCountMin == nil {
+		return RuleResult{Reason: "company size unknown"}
+	}
+
+	size := m.config.Candidate.CompanySize
+	employees := *company.EmployeeCountMin
+	label := fmt.Sprintf("%d+", employees)
+	if company.EmployeeRange != nil {
+		label = *company.EmployeeRange
+	}
+
+	if size.Minimum > 0 && company.EmployeeCountMax != nil && *company.EmployeeCountMax < size.Minimum {
+		return RuleResult{Score: intPtr(20), Reason: fmt.Sprintf("%s employees, below the minimum of %d", label, size.Minimum)}
+	}
+
+	preferredMin, preferredMax, ok := size.PreferredRange()
+	switch {
+	case !ok:
+		return RuleResult{Score: intPtr(100), Reason: fmt.Sprintf("%s employees", label)}
+	case employees >= preferredMin && (preferredMax == 0 || employees <= preferredMax):
+		return RuleResult{Score: intPtr(100), Reason: fmt.Sprintf("%s employees, within the preferred %s", label, size.Preferred)}
+	case preferredMax > 0 && employees > preferredMax:
+		return RuleResult{Score: intPtr(70), Reason: fmt.Sprintf("%s employees, larger than the preferred %s", label, size.Preferred)}
+	default:
+		return RuleResult{Score: intPtr(60), Reason: fmt.Sprintf("%s employees, smaller than the preferred %s", label, size.Preferred)}
+	}
+}
+
+// seniorityRule rejects roles to avoid, rewards preferred roles and otherwise compares the
+// job's seniority level with the candidate's experience
+func (m *Matcher) seniorityRule(job *models.JobPosting) RuleResult {
+	candidate := m.config.Candidate
+
+	if avoided := matchingTerms(candidate.AvoidRoles, job.Title); len(avoided) > 0 {
+		return RuleResult{Score: intPtr(0), Reason: fmt.Sprintf("title matches avoided role %q", avoided[0])}
+	}
+	if preferred := matchingTerms(candidate.PreferredRoles, job.Title); len(preferred) > 0 {
+		return RuleResult{Score: intPtr(100), Reason: fmt.Sprintf("title matches preferred role %q", preferred[0])}
+	}
+	if job.SeniorityLevel == nil {
+		return RuleResult{Reason: "seniority level unknown"}
+	}
+
+	experienced := candidate.YearsExperience >= 5
+	level := *job.SeniorityLevel
+	var score int
+	switch level {
+	case models.SeniorityMidSenior:
+		score = 100
+		if !experienced {
+			score = 70
+		}
+	case models.SeniorityAssociate:
+		score = 60
+		if !experienced {
+			score = 100
+		}
+	case models.SeniorityEntryLevel, models.SeniorityInternship:
+		score = 20
+		if !experienced {
+			score = 80
+		}
+	case models.SeniorityDirector, models.SeniorityExecutive:
+		score = 20
+	default:
+		score = neutralScore
+	}
+	return RuleResult{Score: &score, Reason: fmt.Sprintf("%s for %d years of experience", level, candidate.YearsExperience)}
+}
+
+// workTypeRule checks the job's workplace type against the accepted ones
+func (m *Matcher) workTypeRule(job *models.JobPosting) RuleResult {
+	workType := jobWorkType(job)
+	switch {
+	case workType == "":
+		return RuleResult{Reason: "work type unknown"}
+	case m.acceptsWorkType(workType):
+		return RuleResult{Score: intPtr(100), Reason: fmt.Sprintf("%s is accepted", workType)}
+	default:
+		return RuleResult{Score: intPtr(0), Reason: fmt.Sprintf("%s is not accepted", workType)}
+	}
+}
+
+// salaryRule compares the job's yearly salary in DKK with the expected range. It has no
+// weight of its own, but a salary that can't reach the minimum caps the overall score.
+func (m *Matcher) salaryRule(job *models.JobPosting) RuleResult {
+	expected := m.config.Candidate.SalaryRange
+	if job.SalaryAnnualMaxDKK == nil && job.SalaryAnnualMinDKK == nil {
+		return RuleResult{Reason: "no salary information"}
+	}
+
+	top := job.SalaryAnnualMaxDKK
+	if top == nil {
+		top = job.SalaryAnnualMinDKK
+	}
+	if expected.Minimum > 0 && *top < expected.Minimum {
+		return RuleResult{Score: intPtr(0), Reason: fmt.Sprintf("at most %d DKK/year, below the minimum of %d", *top, expected.Minimum)}
+	}
+	return RuleResult{Score: intPtr(100), Reason: fmt.Sprintf("up to %d DKK/year", *top)}
+}
+
+// languageRule checks the languages the job asks for, and the one its description is written
+// in, against the candidate's languages. Like salaryRule it has no weight of its own, but a
+// language the candidate doesn't speak caps the overall score.
+func (m *Matcher) languageRule(job *models.JobPosting) RuleResult {
+	if len(m.config.Candidate.Languages) == 0 {
+		return RuleResult{Reason: "no languages configured"}
+	}
+
+	required := requiredLanguages(job.Description)
+	if written := writtenLanguage(job.Description); written != "" && !slices.Contains(required, written) {
+		required = append(required, written)
+	}
+	if len(required) == 0 {
+		return RuleResult{Reason: "no language requirements found"}
+	}
+
+	var missing []string
+	for _, language := range required {
+		if !m.speaks(language) {
+			missing = append(missing, language)
+		}
+	}
+	if len(missing) > 0 {
+		return RuleResult{Score: intPtr(0), Reason: fmt.Sprintf("requires %s, not in the candidate's languages", strings.Join(missing, ", "))}
+	}
+	return RuleResult{Score: intPtr(100), Reason: fmt.Sprintf("requires %s", strings.Join(required, ", "))}
+}
+
+// speaks reports whether the candidate's languages include language, by any of its names
+func (m *Matcher) speaks(language string) bool {
+	for _, known := range languages {
+		if known.name != language {
+			continue
+		}
+		for _, spoken := range m.config.Candidate.Languages {
+			if len(matchingTerms(known.aliases, spoken)) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m *Matcher) acceptsWorkType(workType string) bool {
+	prefs := m.config.Candidate.WorkPreferences
+	switch workType {
+	case models.WorkTypeRemote:
+		return prefs.Remote
+	case models.WorkTypeHybrid:
+		return prefs.Hybrid
+	case models.WorkTypeOnSite:
+		return prefs.OnSite
+	}
+	return true
+}
+
+// jobWorkType prefers the work type shown on the job page over the one in the location line
+func jobWorkType(job *models.JobPosting) string {
+	if job.WorkType != nil && *job.WorkType != "" {
+		return *job.WorkType
+	}
+	if job.WorkplaceType != nil {
+		return *job.WorkplaceType
+	}
+	return ""
+}
+
+func joinOrNone(terms []string) string {
+	if len(terms) == 0 {
+		return "none"
+	}
+	return strings.Join(terms, ", ")
+}
+
+func intPtr(i int) *int {
+	return &i
+}
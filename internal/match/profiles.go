@@ -0,0 +1,392 @@
+package match
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/skills"
+)
+
+// Rule names used when ranking user profiles for a job; location reuses RuleLocation
+const (
+	RuleSkills     = "skills"
+	RuleTitle      = "title"
+	RuleExperience = "experience"
+)
+
+// ProfileWeights are the weights of each rule in a profile's overall score
+var ProfileWeights = map[string]int{
+	RuleSkills:     45,
+	RuleTitle:      25,
+	RuleLocation:   15,
+	RuleExperience: 15,
+}
+
+// recentPositions is how many of a profile's latest positions the title rule looks at
+const recentPositions = 3
+
+// ProfileMatch is a user profile's fit for a job and the reasons for it
+type ProfileMatch struct {
+	Profile         *models.UserProfile   `json:"-"`
+	Username        string                `json:"username"`
+	LinkedInURL     string                `json:"linkedin_url"`
+	Score           int                   `json:"score"`
+	YearsExperience int                   `json:"years_experience"`
+	Criteria        map[string]RuleResult `json:"criteria"`
+}
+
+// ProfileMatcher ranks user profiles for a job, the reverse of Matcher
+type ProfileMatcher struct {
+	taxonomy  *skills.Taxonomy
+	extractor *skills.Extractor
+	now       time.Time
+}
+
+// NewProfileMatcher creates a profile matcher. The taxonomy lets "Golang" on a profile
+// match "Go" on a job, and finds skills in the description of jobs that have none; without
+// it skills are compared by label.
+func NewProfileMatcher(taxonomy *skills.Taxonomy, now time.Time) *ProfileMatcher {
+	return &ProfileMatcher{taxonomy: taxonomy, extractor: skills.NewExtractor(taxonomy), now: now}
+}
+
+// Rank scores every profile for the job, best first
+func (m *ProfileMatcher) Rank(job *models.JobPosting, profiles []models.UserProfile) []ProfileMatch {
+	matches := make([]ProfileMatch, 0, len(profiles))
+	for i := range profiles {
+		matches = append(matches, m.Score(job, &profiles[i]))
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	return matches
+}
+
+// Score rates one profile for the job on skills, title, location and experience
+func (m *ProfileMatcher) Score(job *models.JobPosting, profile *models.UserProfile) ProfileMatch {
+	years := profileYearsOfExperience(profile.Positions, m.now)
+	results := map[string]RuleResult{
+		RuleSkills:     m.skillsRule(job, profile),
+		RuleTitle:      m.titleRule(job, profile),
+		RuleLocation:   profileLocationRule(job, profile),
+		RuleExperience: experienceRule(job, years),
+	}
+	for rule, result := range results {
+		result.Weight = ProfileWeights[rule]
+		results[rule] = result
+	}
+
+	return ProfileMatch{
+		Profile:         profile,
+		Username:        profile.Username(),
+		LinkedInURL:     profile.LinkedInURL,
+		Score:           weightedScore(results),
+		YearsExperience: years,
+		Criteria:        results,
+	}
+}
+
+// skillsRule checks which of the job's skills the profile has. A skill used once earns
+// two thirds of its share and one used three or more times the full share.
+func (m *ProfileMatcher) skillsRule(job *models.JobPosting, profile *models.UserProfile) RuleResult {
+	type jobSkill struct{ key, label string }
+	var required []jobSkill
+	seen := make(map[string]bool)
+	addRequired := func(key, label string) {
+		if key != "" && !seen[key] {
+			seen[key] = true
+			required = append(required, jobSkill{key, label})
+		}
+	}
+	if job.SkillIDs != nil && len(*job.SkillIDs) > 0 {
+		for _, id := range *job.SkillIDs {
+			label := id
+			if skill := m.taxonomy.Skill(id); skill != nil {
+				label = skill.Name
+			}
+			addRequired(id, label)
+		}
+	} else if job.Skills != nil && len(*job.Skills) > 0 {
+		for _, label := range *job.Skills {
+			addRequired(m.skillKey(label), label)
+		}
+	} else {
+		for _, skill := range m.extractor.Extract(job.Title + "\n" + job.Description) {
+			addRequired(skill.ID, skill.Name)
+		}
+	}
+	if len(required) == 0 {
+		return RuleResult{Reason: "the job has no skills"}
+	}
+
+	frequencies := make(map[string]int)
+	for label, frequency := range profile.SkillFrequencies {
+		frequencies[m.skillKey(label)] += frequency
+	}
+	if len(frequencies) == 0 {
+		for _, pos := range profile.Positions {
+			for _, label := range pos.Skills {
+				frequencies[m.skillKey(label)]++
+			}
+		}
+	}
+
+	var credit float64
+	var held, missing []string
+	for _, skill := range required {
+		frequency := frequencies[skill.key]
+		if frequency <= 0 {
+			missing = append(missing, skill.label)
+			continue
+		}
+		credit += 0.5 + 0.5*float64(min(frequency, 3))/3
+		held = append(held, fmt.Sprintf("%s (%d)", skill.label, frequency))
+	}
+
+	score := int(math.Round(100 * credit / float64(len(required))))
+	reason := fmt.Sprintf("%d/%d job skills: %s; missing: %s", len(held), len(required), joinOrNone(held), joinOrNone(missing))
+	return RuleResult{Score: &score, Reason: reason}
+}
+
+// skillKey identifies a skill label: its canonical ID, or the folded label if it has none
+func (m *ProfileMatcher) skillKey(label string) string {
+	if id, ok := m.taxonomy.Normalise(label); ok {
+		return id
+	}
+	return strings.ToLower(strings.TrimSpace(label))
+}
+
+// titleRule compares the job title with the profile's latest titles and headline by the
+// words they share. Older positions count for less.
+func (m *ProfileMatcher) titleRule(job *models.JobPosting, profile *models.UserProfile) RuleResult {
+	jobWords := titleWords(job.Title)
+	if len(jobWords) == 0 {
+		return RuleResult{Reason: "the job has no title"}
+	}
+
+	type candidate struct {
+		title, source string
+		factor        float64
+	}
+	var candidates []candidate
+	for i, pos := range latestPositions(profile.Positions, recentPositions) {
+		source := "previous position"
+		if pos.EndDate == nil {
+			source = "current position"
+		}
+		if pos.CompanyName != "" {
+			source += " at " + pos.CompanyName
+		}
+		candidates = append(candidates, candidate{pos.Title, source, 1 - 0.1*float64(i)})
+	}
+	if profile.Headline != "" {
+		candidates = append(candidates, candidate{profile.Headline, "headline", 0.8})
+	}
+	if len(candidates) == 0 {
+		return RuleResult{Reason: "the profile has no positions"}
+	}
+
+	best, bestScore := candidate{}, 0.0
+	for _, c := range candidates {
+		if score := c.factor * diceCoefficient(jobWords, titleWords(c.title)); score > bestScore {
+			best, bestScore = c, score
+		}
+	}
+	if bestScore == 0 {
+		return RuleResult{Score: intPtr(0), Reason: fmt.Sprintf("no title shares a word with %q", job.Title)}
+	}
+
+	score := int(math.Round(100 * bestScore))
+	return RuleResult{Score: &score, Reason: fmt.Sprintf("%q (%s)", best.title, best.source)}
+}
+
+// profileLocationRule compares where the profile lives with where the job is
+func profileLocationRule(job *models.JobPosting, profile *models.UserProfile) RuleResult {
+	if jobWorkType(job) == models.WorkTypeRemote {
+		return RuleResult{Score: intPtr(100), Reason: "remote job"}
+	}
+	if profile.LocationCity == "" {
+		return RuleResult{Reason: "profile location unknown"}
+	}
+
+	city := strings.TrimSpace(strings.Split(job.Location, ",")[0])
+	if job.LocationCity != nil && *job.LocationCity != "" {
+		city = *job.LocationCity
+	}
+	switch {
+	case city != "" && containsTerm(profile.LocationCity, city):
+		return RuleResult{Score: intPtr(100), Reason: fmt.Sprintf("lives in %s", city)}
+	case job.LocationRegion != nil && *job.LocationRegion != "" && containsTerm(profile.LocationCity, *job.LocationRegion):
+		return RuleResult{Score: intPtr(80), Reason: fmt.Sprintf("lives in the job's region, %s", *job.LocationRegion)}
+	case job.LocationCountry != nil && *job.LocationCountry != "" && containsTerm(profile.LocationCity, *job.LocationCountry):
+		return RuleResult{Score: intPtr(50), Reason: fmt.Sprintf("lives in %s, the job is in %s", profile.LocationCity, job.Location)}
+	case city == "":
+		return RuleResult{Reason: "job location unknown"}
+	}
+	return RuleResult{Score: intPtr(10), Reason: fmt.Sprintf("lives in %s, the job is in %s", profile.LocationCity, job.Location)}
+}
+
+// experienceRanges are the years of experience expected for each seniority level; a max
+// of 0 means no upper bound
+var experienceRanges = map[string][2]int{
+	models.SeniorityInternship: {0, 1},
+	models.SeniorityEntryLevel: {0, 2},
+	models.SeniorityAssociate:  {2, 5},
+	models.SeniorityMidSenior:  {4, 0},
+	models.SeniorityDirector:   {8, 0},
+	models.SeniorityExecutive:  {12, 0},
+}
+
+// experienceRule compares the profile's years of experience with the job's seniority level,
+// taken from the job criteria or guessed from the title. Each missing year costs 25 points
+// and each year too many 10, down to 40.
+func experienceRule(job *models.JobPosting, years int) RuleResult {
+	level, source := "", "seniority level"
+	if job.SeniorityLevel != nil {
+		level = *job.SeniorityLevel
+	}
+	if _, ok := experienceRanges[level]; !ok {
+		level, source = titleSeniority(job.Title), "title"
+	}
+	expected, ok := experienceRanges[level]
+	if !ok {
+		return RuleResult{Reason: fmt.Sprintf("%d years of experience, seniority level unknown", years)}
+	}
+
+	score := 100
+	switch {
+	case years < expected[0]:
+		score = max(0, 100-25*(expected[0]-years))
+	case expected[1] > 0 && years > expected[1]:
+		score = max(40, 100-10*(years-expected[1]))
+	}
+
+	want := fmt.Sprintf("%d+", expected[0])
+	if expected[1] > 0 {
+		want = fmt.Sprintf("%d-%d", expected[0], expected[1])
+	}
+	reason := fmt.Sprintf("%d years of experience for %s (%s years, from the %s)", years, level, want, source)
+	return RuleResult{Score: &score, Reason: reason}
+}
+
+// titleSeniority guesses a seniority level from words in a job title
+func titleSeniority(title string) string {
+	words := titleWords(title)
+	switch {
+	case words["intern"] || words["internship"] || words["praktikant"] || words["student"] || words["studentermedhjælper"]:
+		return models.SeniorityInternship
+	case words["junior"] || words["graduate"] || words["trainee"]:
+		return models.SeniorityEntryLevel
+	case words["director"] || words["direktør"] || words["head"]:
+		return models.SeniorityDirector
+	case words["cto"] || words["ceo"] || words["vp"]:
+		return models.SeniorityExecutive
+	case words["senior"] || words["lead"] || words["principal"] || words["staff"] || words["architect"]:
+		return models.SeniorityMidSenior
+	}
+	return ""
+}
+
+// titleStopWords are left out when comparing titles
+var titleStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "the": true, "of": true, "for": true, "in": true, "at": true, "to": true, "with": true,
+	"og": true, "i": true, "til": true, "med": true, "hos": true, "af": true, "m": true, "k": true,
+}
+
+// titleWords returns the lowercased words of a title, without stop words
+func titleWords(title string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(title), func(r rune) bool { return !isWordRune(r) }) {
+		if !titleStopWords[word] {
+			words[word] = true
+		}
+	}
+	return words
+}
+
+// diceCoefficient is the share of words two sets have in common, from 0 to 1
+func diceCoefficient(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for word := range a {
+		if b[word] {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(a)+len(b))
+}
+
+// latestPositions returns up to n positions, current ones first and then by end date
+func latestPositions(positions []models.ProfilePosition, n int) []models.ProfilePosition {
+	sorted := make([]models.ProfilePosition, len(positions))
+	copy(sorted, positions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].EndDate, sorted[j].EndDate
+		switch {
+		case a == nil || b == nil:
+			return a == nil && b != nil
+		default:
+			return a.After(*b)
+		}
+	})
+	return sorted[:min(n, len(sorted))]
+}
+
+// profileYearsOfExperience counts the time covered by any position, so overlapping
+// positions aren't counted twice
+func profileYearsOfExperience(positions []models.ProfilePosition, now time.Time) int {
+	type span struct{ start, end time.Time }
+	var spans []span
+	for _, pos := range positions {
+		if pos.StartDate == nil {
+			continue
+		}
+		end := now
+		if pos.EndDate != nil && pos.EndDate.Before(now) {
+			end = *pos.EndDate
+		}
+		if end.After(*pos.StartDate) {
+			spans = append(spans, span{*pos.StartDate, end})
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start.Before(spans[j].start) })
+
+	var total time.Duration
+	var current span
+	for _, s := range spans {
+		if current.end.IsZero() || s.start.After(current.end) {
+			total += current.end.Sub(current.start)
+			current = s
+		} else if s.end.After(current.end) {
+			current.end = s.end
+		}
+	}
+	total += current.end.Sub(current.start)
+
+	return int(total.Hours() / 24 / 365.25)
+}
+
+// weightedScore is the weighted average of the rule scores, counting rules that couldn't
+// be evaluated as neutral
+func weightedScore(results map[string]RuleResult) int {
+	totalWeight, weighted := 0, 0.0
+	for _, result := range results {
+		if result.Weight == 0 {
+			continue
+		}
+		score := neutralScore
+		if result.Score != nil {
+			score = *result.Score
+		}
+		weighted += float64(score * result.Weight)
+		totalWeight += result.Weight
+	}
+
+	if totalWeight == 0 {
+		return 0
+	}
+	return int(math.Round(weighted / float64(totalWeight)))
+}
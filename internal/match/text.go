@@ -0,0 +1,121 @@
+package match
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// containsTerm reports whether term occurs in text as a whole term, case-insensitively.
+// Terms may contain symbols ("C#", ".NET", "Node.js"), so the boundary check only looks at
+// the characters around the match: "Go" matches "Go, Docker" but not "Google" or "MongoDB".
+func containsTerm(text, term string) bool {
+	text, term = strings.ToLower(text), strings.ToLower(strings.TrimSpace(term))
+	if term == "" {
+		return false
+	}
+
+	for offset := 0; ; {
+		i := strings.Index(text[offset:], term)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(term)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if (start == 0 || !isWordRune(before)) && (end == len(text) || !isWordRune(after)) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// matchingTerms returns the terms that occur in any of texts
+func matchingTerms(terms []string, texts ...string) []string {
+	var matched []string
+	for _, term := range terms {
+		for _, text := range texts {
+			if containsTerm(text, term) {
+				matched = append(matched, term)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// languages are the languages a job can ask for, with the names Danish job posts use for them
+var languages = []struct {
+	name    string
+	aliases []string
+}{
+	{"Danish", []string{"Danish", "dansk"}},
+	{"English", []string{"English", "engelsk"}},
+	{"German", []string{"German", "tysk", "Deutsch"}},
+	{"Swedish", []string{"Swedish", "svensk"}},
+	{"Norwegian", []string{"Norwegian", "norsk"}},
+	{"French", []string{"French", "fransk"}},
+	{"Dutch", []string{"Dutch", "hollandsk"}},
+	{"Spanish", []string{"Spanish", "spansk"}},
+	{"Finnish", []string{"Finnish", "finsk"}},
+	{"Polish", []string{"Polish", "polsk"}},
+}
+
+// requirementTerms make a sentence that names a language a requirement, unless an optionalTerm
+// says it's only a plus
+var (
+	requirementTerms = []string{"fluent", "fluency", "fluently", "native", "proficient", "proficiency", "required",
+		"requirement", "must", "mandatory", "flydende", "krav", "skal", "mundtligt", "skriftligt", "modersmål"}
+	optionalTerms = []string{"plus", "advantage", "nice to have", "preferably", "fordel", "gerne"}
+)
+
+// requiredLanguages returns the languages that sentences of text ask for, e.g. "You speak and
+// write Danish fluently" or "Flydende dansk er et krav"
+func requiredLanguages(text string) []string {
+	sentences := strings.FieldsFunc(text, func(r rune) bool { return strings.ContainsRune(".!?;\n•", r) })
+	var required []string
+	for _, language := range languages {
+		for _, sentence := range sentences {
+			if len(matchingTerms(language.aliases, sentence)) > 0 &&
+				len(matchingTerms(requirementTerms, sentence)) > 0 &&
+				len(matchingTerms(optionalTerms, sentence)) == 0 {
+				required = append(required, language.name)
+				break
+			}
+		}
+	}
+	return required
+}
+
+// Common short words of Danish and English, to tell which of them a job post is written in
+var (
+	danishWords  = map[string]bool{"og": true, "det": true, "er": true, "til": true, "med": true, "vi": true, "du": true, "på": true, "af": true, "som": true, "har": true, "vores": true, "ikke": true, "kan": true}
+	englishWords = map[string]bool{"and": true, "the": true, "to": true, "of": true, "we": true, "you": true, "with": true, "is": true, "are": true, "our": true, "will": true, "in": true}
+)
+
+// writtenLanguage returns "Danish" or "English" for text written in either, or "" when it's
+// too short to tell
+func writtenLanguage(text string) string {
+	danish, english := 0, 0
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !isWordRune(r) }) {
+		if danishWords[word] {
+			danish++
+		}
+		if englishWords[word] {
+			english++
+		}
+	}
+	switch {
+	case danish+english < 5:
+		return ""
+	case danish > english:
+		return "Danish"
+	default:
+		return "English"
+	}
+}
@@ -1,8 +1,88 @@
 package models
 
+import (
+	"time"
+)
+
 // Company represents the companies table
 type Company struct {
 	CompanyID int    `json:"company_id" db:"company_id"`
 	Name      string `json:"name" db:"name"`
 	ImageURL  string `json:"image_url" db:"image_url"`
+
+	// Enrichment from the company's LinkedIn page
+	LinkedInCompanyID string     `json:"linkedin_company_id,omitempty" db:"linkedin_company_id"` // Numeric ID from urn:li:fsd_company:<id>
+	LinkedInSlug      string     `json:"linkedin_slug,omitempty" db:"linkedin_slug"`             // <slug> from the company page URL
+	LinkedInURL       string     `json:"linkedin_url,omitempty" db:"linkedin_url"`               // https://www.linkedin.com/company/<slug>/
+	EmployeeRange     *string    `json:"employee_range,omitempty" db:"employee_range"`           // As shown by LinkedIn, e.g. "51-200"
+	EmployeeCountMin  *int       `json:"employee_count_min,omitempty" db:"employee_count_min"`
+	EmployeeCountMax  *int       `json:"employee_count_max,omitempty" db:"employee_count_max"` // Nil for open ranges like "10,001+"
+	Industry          *string    `json:"industry,omitempty" db:"industry"`
+	Headquarters      *string    `json:"headquarters,omitempty" db:"headquarters"`
+	Website           *string    `json:"website,omitempty" db:"website"`
+	FollowerCount     *int       `json:"follower_count,omitempty" db:"follower_count"`
+	EnrichedAt        *time.Time `json:"enriched_at,omitempty" db:"enriched_at"`
+}
+
+// LinkedInURN returns the company's LinkedIn URN, or "" if the ID is unknown
+func (c *Company) LinkedInURN() string {
+	if c.LinkedInCompanyID == "" {
+		return ""
+	}
+	return "urn:li:fsd_company:" + c.LinkedInCompanyID
+}
+
+// IdentityKey returns the most specific identity known for the company: the LinkedIn
+// company ID, then the LinkedIn slug, and only as a fallback the display name
+func (c *Company) IdentityKey() string {
+	switch {
+	case c.LinkedInCompanyID != "":
+		return "linkedin:" + c.LinkedInCompanyID
+	case c.LinkedInSlug != "":
+		return "slug:" + c.LinkedInSlug
+	default:
+		return "name:" + c.Name
+	}
+}
+
+// IdentityKeys returns every identity known for the company, most specific first.
+// Caches store a company under all of them so it can be found by whichever is available.
+func (c *Company) IdentityKeys() []string {
+	var keys []string
+	if c.LinkedInCompanyID != "" {
+		keys = append(keys, "linkedin:"+c.LinkedInCompanyID)
+	}
+	if c.LinkedInSlug != "" {
+		keys = append(keys, "slug:"+c.LinkedInSlug)
+	}
+	if c.Name != "" {
+		keys = append(keys, "name:"+c.Name)
+	}
+	return keys
+}
+
+// HasLinkedInIdentity reports whether the company is identified by LinkedIn ID or slug rather than name
+func (c *Company) HasLinkedInIdentity() bool {
+	return c.LinkedInCompanyID != "" || c.LinkedInSlug != ""
+}
+
+// ConflictsWith reports whether two companies are known to be different LinkedIn companies,
+// e.g. two unrelated companies that happen to share a display name
+func (c *Company) ConflictsWith(other *Company) bool {
+	if c.LinkedInCompanyID != "" && other.LinkedInCompanyID != "" {
+		return c.LinkedInCompanyID != other.LinkedInCompanyID
+	}
+	if c.LinkedInSlug != "" && other.LinkedInSlug != "" {
+		return c.LinkedInSlug != other.LinkedInSlug
+	}
+	return false
+}
+
+// CompanyAlias maps an alternative company name to the canonical company it was merged into
+type CompanyAlias struct {
+	AliasID        int    `json:"alias_id,omitempty" db:"alias_id"`
+	Alias          string `json:"alias" db:"alias"`                     // Display name as scraped, e.g. "Novo Nordisk A/S"
+	NormalisedName string `json:"normalised_name" db:"normalised_name"` // Comparison key, e.g. "novo nordisk"
+	CompanyID      int    `json:"company_id" db:"company_id"`
+	CompanyName    string `json:"company_name,omitempty"` // Canonical name (joined, not saved)
 }
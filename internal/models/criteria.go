@@ -0,0 +1,23 @@
+package models
+
+// SeniorityLevel constants mirror LinkedIn's "Seniority level" job criteria
+const (
+	SeniorityInternship    = "internship"
+	SeniorityEntryLevel    = "entry_level"
+	SeniorityAssociate     = "associate"
+	SeniorityMidSenior     = "mid_senior"
+	SeniorityDirector      = "director"
+	SeniorityExecutive     = "executive"
+	SeniorityNotApplicable = "not_applicable"
+)
+
+// EmploymentType constants mirror LinkedIn's "Employment type" job criteria
+const (
+	EmploymentFullTime   = "full_time"
+	EmploymentPartTime   = "part_time"
+	EmploymentContract   = "contract"
+	EmploymentTemporary  = "temporary"
+	EmploymentInternship = "internship"
+	EmploymentVolunteer  = "volunteer"
+	EmploymentOther      = "other"
+)
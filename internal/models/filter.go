@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// JobFilter selects jobs when listing them from a store. Zero values don't filter.
+type JobFilter struct {
+	LinkedInJobID      int
+	Open               bool      // Only jobs without a closed date
+	Closed             bool      // Only jobs with a closed date
+	PostedAfter        time.Time // Only jobs posted on or after this time
+	PostedBefore       time.Time // Only jobs posted before this time
+	OpenOrPostedBefore time.Time // Only jobs without a closed date or posted before this time
+	Skills             []string  // Only jobs with all of these skills (canonical ID or raw name, case-insensitive)
+	CompanyID          int64
+	Company            string // Company name contains this, case-insensitive
+	WorkType           string // See WorkType* constants, case-insensitive
+	MinRating          int    // Only jobs with a rating whose overall score is at least this
+	RatingType         string // Rating type MinRating applies to, any type if empty
+	MissingFingerprint bool
+	MissingAddress     bool // No resolved address (OpenaiAdresse) yet
+	MissingSkillIDs    bool
+	UnratedBy          string // Only jobs without a rating of this type
+	NewestFirst        bool   // Otherwise oldest first. The API chooses the order itself.
+	LeastRefreshed     bool   // Jobs without snapshots first, then by their latest snapshot. Overrides NewestFirst.
+	Limit              int
+	Offset             int
+}
+
+// CompanyFilter selects companies when listing them from a store
+type CompanyFilter struct {
+	NeedsEnrichment bool   // Only companies with a LinkedIn URL that haven't been enriched
+	Name            string // Name contains this, case-insensitive
+	Limit           int
+	Offset          int
+}
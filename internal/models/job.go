@@ -14,13 +14,86 @@ type JobPosting struct {
 	Description       string         `json:"description" db:"description"`
 	ApplyURL          string         `json:"apply_url" db:"apply_url"`
 	PostedDate        time.Time      `json:"posted_date" db:"posted_date"`
+	PostedDateSource  *string        `json:"posted_date_source,omitempty" db:"posted_date_source"` // See PostedDateSource* constants
 	Applicants        *int           `json:"applicants,omitempty" db:"applicants"` // Pointer to handle NULL values
 	WorkType          *string        `json:"work_type,omitempty" db:"work_type"`   // Remote, Hybrid, On-site
 	Skills            *SkillsList    `json:"skills,omitempty" db:"skills"`         // JSON list of skills
+	SkillIDs          *SkillsList    `json:"skill_ids,omitempty" db:"skill_ids"`   // Canonical IDs of Skills from skill_taxonomy.json
+	SkillsSource      *string        `json:"skills_source,omitempty" db:"skills_source"` // See SkillsSource* constants
 	OpenaiAdresse     *string        `json:"openai_adresse,omitempty" db:"openai_adresse"` // AI-extracted standardized address
 	JobPostClosedDate *time.Time     `json:"job_post_closed_date,omitempty" db:"job_post_closed_date"` // Date when job was closed
-	
+
+	// Compensation (normalised from salary insights or description text)
+	SalaryMin          *float64 `json:"salary_min,omitempty" db:"salary_min"`
+	SalaryMax          *float64 `json:"salary_max,omitempty" db:"salary_max"`
+	SalaryCurrency     *string  `json:"salary_currency,omitempty" db:"salary_currency"`           // ISO 4217 code, e.g. DKK
+	SalaryPeriod       *string  `json:"salary_period,omitempty" db:"salary_period"`               // hour, day, week, month, year
+	SalaryAnnualMinDKK *int     `json:"salary_annual_min_dkk,omitempty" db:"salary_annual_min_dkk"` // Yearly minimum converted to DKK
+	SalaryAnnualMaxDKK *int     `json:"salary_annual_max_dkk,omitempty" db:"salary_annual_max_dkk"` // Yearly maximum converted to DKK
+
+	// Job criteria (normalised from LinkedIn's criteria list)
+	SeniorityLevel *string `json:"seniority_level,omitempty" db:"seniority_level"` // See Seniority* constants
+	EmploymentType *string `json:"employment_type,omitempty" db:"employment_type"` // See Employment* constants
+	JobFunction    *string `json:"job_function,omitempty" db:"job_function"`       // Comma separated, e.g. "Engineering, Information Technology"
+	Industries     *string `json:"industries,omitempty" db:"industries"`           // Comma separated, e.g. "Software Development"
+
+	// Structured location (parsed from Location, e.g. "Copenhagen, Capital Region of Denmark (Hybrid)")
+	LocationCity    *string `json:"location_city,omitempty" db:"location_city"`       // Canonical English name, e.g. Copenhagen
+	LocationRegion  *string `json:"location_region,omitempty" db:"location_region"`   // e.g. Capital Region of Denmark
+	LocationCountry *string `json:"location_country,omitempty" db:"location_country"` // English country name
+	CountryCode     *string `json:"country_code,omitempty" db:"country_code"`         // ISO 3166-1 alpha-2, e.g. DK
+	PlaceKey        *string `json:"place_key,omitempty" db:"place_key"`               // Stable key, e.g. dk/capital-region-of-denmark/copenhagen
+	WorkplaceType   *string `json:"workplace_type,omitempty" db:"workplace_type"`     // From the location line, see WorkType* constants
+
+	// Repost detection (see internal/dedupe)
+	Fingerprint   *string `json:"fingerprint,omitempty" db:"fingerprint"`           // SimHash of the description shingles, 16 hex chars
+	RepostOfJobID *int    `json:"repost_of_job_id,omitempty" db:"repost_of_job_id"` // Earliest version of the same job, if this is a repost
+
 	// Joined fields (only used for display, not saved to DB)
-	CompanyName     string `json:"company_name,omitempty" db:"company_name"`
-	CompanyImageURL string `json:"company_image_url,omitempty" db:"company_image_url"`
+	CompanyName       string `json:"company_name,omitempty" db:"company_name"`
+	CompanyImageURL   string `json:"company_image_url,omitempty" db:"company_image_url"`
+	CompanyURL        string `json:"company_url,omitempty"`         // LinkedIn company page, used for enrichment and identity
+	CompanyLinkedInID string `json:"company_linkedin_id,omitempty"` // Numeric LinkedIn company ID, when present on the job page
+}
+
+// PostedDateSource constants record how a job's PostedDate was determined, most precise first
+const (
+	PostedDateSourceJSONLD      = "json_ld"      // datePosted in the page's JSON-LD
+	PostedDateSourceCodeBlock   = "code_block"   // listedAt in LinkedIn's embedded data
+	PostedDateSourceTimeElement = "time_element" // <time datetime> attribute
+	PostedDateSourceRelative    = "relative"     // Parsed from text like "2 uger siden"
+	PostedDateSourceScrapedAt   = "scraped_at"   // Unknown - the time the job was scraped
+)
+
+// SkillsSource constants record where a job's Skills came from
+const (
+	SkillsSourceInsights    = "insights"    // LinkedIn's skills insights modal
+	SkillsSourceDescription = "description" // Taxonomy skills mentioned in the title or description
+	SkillsSourceNone        = "none"        // Checked, but no skills in the insights or description
+)
+
+// WorkType constants are the normalised workplace types, shared by WorkType and WorkplaceType
+const (
+	WorkTypeRemote = "Remote"
+	WorkTypeHybrid = "Hybrid"
+	WorkTypeOnSite = "On-site"
+)
+
+// JobFingerprint is the part of a job posting needed to recognise reposts of it
+type JobFingerprint struct {
+	JobID         int       `json:"job_id"`
+	LinkedInJobID int       `json:"linkedin_job_id"`
+	CompanyID     int64     `json:"company_id"`
+	Title         string    `json:"title"`
+	Fingerprint   string    `json:"fingerprint"`
+	PostedDate    time.Time `json:"posted_date"`
+	RepostOfJobID *int      `json:"repost_of_job_id,omitempty"`
+}
+
+// OriginalJobID returns the ID of the first version of this job
+func (f *JobFingerprint) OriginalJobID() int {
+	if f.RepostOfJobID != nil {
+		return *f.RepostOfJobID
+	}
+	return f.JobID
 }
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// UserProfile is a LinkedIn user collected by linkedin-user-scraper, in the simplified
+// format it sends to the API
+type UserProfile struct {
+	ID               int                `json:"id"`
+	LinkedInURL      string             `json:"linkedin_url"`
+	Headline         string             `json:"headline,omitempty"`
+	Summary          string             `json:"summary,omitempty"`
+	LocationCity     string             `json:"location_city,omitempty"` // e.g. "Roskilde, Region Zealand, Denmark"
+	Positions        []ProfilePosition  `json:"positions"`
+	Educations       []ProfileEducation `json:"educations"`
+	SkillFrequencies map[string]int     `json:"skill_frequencies"` // Skill label to the number of positions and pages it appears on
+}
+
+// ProfilePosition is a position on a user profile; EndDate is nil for current positions
+type ProfilePosition struct {
+	Title       string     `json:"title"`
+	CompanyName string     `json:"company_name"`
+	Summary     string     `json:"summary,omitempty"`
+	Location    string     `json:"location,omitempty"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	EndDate     *time.Time `json:"end_date,omitempty"`
+	Skills      []string   `json:"skills,omitempty"`
+}
+
+// ProfileEducation is an education on a user profile
+type ProfileEducation struct {
+	SchoolName string   `json:"school_name"`
+	Degree     string   `json:"degree,omitempty"`
+	StartYear  *int     `json:"start_year,omitempty"`
+	EndYear    *int     `json:"end_year,omitempty"`
+	Skills     []string `json:"skills,omitempty"`
+}
+
+// Username returns the profile slug from the LinkedIn URL
+func (p *UserProfile) Username() string {
+	parts := strings.Split(strings.TrimSuffix(p.LinkedInURL, "/"), "/in/")
+	if len(parts) < 2 {
+		return p.LinkedInURL
+	}
+	return parts[1]
+}
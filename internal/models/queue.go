@@ -19,3 +19,12 @@ const (
 	StatusDone       = 3
 	StatusError      = 4
 )
+
+// QueueStats counts the jobs in the queue by status. Queues that drop jobs once they
+// are popped (Redis) only know the pending count.
+type QueueStats struct {
+	Pending    int `json:"pending"`
+	InProgress int `json:"in_progress"`
+	Done       int `json:"done"`
+	Error      int `json:"error"`
+}
@@ -41,10 +41,12 @@ func (rc *RatingCriteria) Scan(value interface{}) error {
 		return nil
 	}
 	
-	bytes, ok := value.([]byte)
-	if !ok {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, rc)
+	case string: // SQLite returns TEXT columns as strings
+		return json.Unmarshal([]byte(v), rc)
+	default:
 		return fmt.Errorf("cannot scan %T into RatingCriteria", value)
 	}
-	
-	return json.Unmarshal(bytes, rc)
 }
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+)
+
+// ScrapeRun represents the scrape_runs table: one run of a scraping command
+type ScrapeRun struct {
+	RunID      int       `json:"run_id,omitempty" db:"run_id"`
+	Command    string    `json:"command" db:"command"`
+	Arguments  string    `json:"arguments,omitempty" db:"arguments"`
+	StartedAt  time.Time `json:"started_at" db:"started_at"`
+	FinishedAt time.Time `json:"finished_at" db:"finished_at"`
+	Status     string    `json:"status" db:"status"`
+	Error      string    `json:"error,omitempty" db:"error"`
+}
+
+// ScrapeRun status values
+const (
+	RunStatusSucceeded = "succeeded"
+	RunStatusFailed    = "failed"
+)
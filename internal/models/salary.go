@@ -0,0 +1,56 @@
+package models
+
+// SalaryPeriod constants describe the period a salary amount is quoted for
+const (
+	SalaryPeriodHour  = "hour"
+	SalaryPeriodDay   = "day"
+	SalaryPeriodWeek  = "week"
+	SalaryPeriodMonth = "month"
+	SalaryPeriodYear  = "year"
+)
+
+// Working time used when annualising salaries (Danish full-time standard of 37 hours/week)
+const (
+	HoursPerYear = 1924
+	DaysPerYear  = 225
+	WeeksPerYear = 52
+)
+
+// DKKExchangeRates holds approximate static conversion rates to DKK.
+// They are only used to compare salaries across currencies, not for accounting.
+var DKKExchangeRates = map[string]float64{
+	"DKK": 1.0,
+	"EUR": 7.46,
+	"USD": 6.90,
+	"GBP": 8.70,
+	"SEK": 0.65,
+	"NOK": 0.64,
+	"CHF": 7.80,
+}
+
+// AnnualiseToDKK converts an amount in the given currency and period to a yearly DKK amount.
+// Returns false if the currency or period is unknown.
+func AnnualiseToDKK(amount float64, currency, period string) (int, bool) {
+	rate, ok := DKKExchangeRates[currency]
+	if !ok {
+		return 0, false
+	}
+
+	var multiplier float64
+	switch period {
+	case SalaryPeriodHour:
+		multiplier = HoursPerYear
+	case SalaryPeriodDay:
+		multiplier = DaysPerYear
+	case SalaryPeriodWeek:
+		multiplier = WeeksPerYear
+	case SalaryPeriodMonth:
+		multiplier = 12
+	case SalaryPeriodYear:
+		multiplier = 1
+	default:
+		return 0, false
+	}
+
+	return int(amount*multiplier*rate + 0.5), true
+}
@@ -24,10 +24,12 @@ func (sl *SkillsList) Scan(value interface{}) error {
 		return nil
 	}
 	
-	bytes, ok := value.([]byte)
-	if !ok {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, sl)
+	case string: // SQLite returns TEXT columns as strings
+		return json.Unmarshal([]byte(v), sl)
+	default:
 		return fmt.Errorf("cannot scan %T into SkillsList", value)
 	}
-	
-	return json.Unmarshal(bytes, sl)
 }
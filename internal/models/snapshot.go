@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+// JobSnapshot represents the job_snapshots table: the state of a job posting at one point in time
+type JobSnapshot struct {
+	SnapshotID      int       `json:"snapshot_id,omitempty" db:"snapshot_id"`
+	JobID           int       `json:"job_id" db:"job_id"`
+	LinkedInJobID   int       `json:"linkedin_job_id" db:"linkedin_job_id"`
+	CapturedAt      time.Time `json:"captured_at" db:"captured_at"`
+	Applicants      *int      `json:"applicants,omitempty" db:"applicants"`
+	Title           string    `json:"title" db:"title"`
+	DescriptionHash string    `json:"description_hash" db:"description_hash"` // SHA-256 of the whitespace-normalised description
+	WorkType        *string   `json:"work_type,omitempty" db:"work_type"`
+	IsOpen          bool      `json:"is_open" db:"is_open"`
+}
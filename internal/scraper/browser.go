@@ -0,0 +1,40 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chromedp/chromedp"
+)
+
+// newBrowserContext starts Chrome with the scraper's standard options and returns a chromedp context.
+// The returned cancel function shuts down both the tab and the browser.
+func (s *LinkedInScraper) newBrowserContext() (context.Context, context.CancelFunc) {
+	opts := append(chromedp.DefaultExecAllocatorOptions[:],
+		chromedp.ExecPath(s.config.Scraper.ChromeExecutablePath),
+		chromedp.Flag("headless", s.config.Scraper.HeadlessBrowser),
+		chromedp.Flag("disable-gpu", true),
+		chromedp.Flag("no-sandbox", true),
+		chromedp.Flag("disable-dev-shm-usage", true),
+		chromedp.Flag("disable-web-security", true),
+		chromedp.Flag("disable-features", "VizDisplayCompositor"),
+		chromedp.Flag("disable-extensions", true),
+		chromedp.Flag("disable-plugins", true),
+		chromedp.Flag("disable-images", true),
+		chromedp.UserDataDir(s.config.Scraper.UserDataDir),
+	)
+
+	allocCtx, allocCancel := chromedp.NewExecAllocator(context.Background(), opts...)
+
+	ctx, ctxCancel := chromedp.NewContext(allocCtx, chromedp.WithLogf(func(s string, args ...interface{}) {
+		if !strings.Contains(s, "cookiePart") && !strings.Contains(s, "could not unmarshal event") {
+			fmt.Printf("ChromeDP: "+s+"\n", args...)
+		}
+	}))
+
+	return ctx, func() {
+		ctxCancel()
+		allocCancel()
+	}
+}
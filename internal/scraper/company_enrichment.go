@@ -0,0 +1,265 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+
+	"github.com/chromedp/chromedp"
+	"github.com/sirupsen/logrus"
+)
+
+var companySlugPattern = regexp.MustCompile(`/company/([^/?#]+)`)
+
+// parseCompanySlug extracts the company slug from a LinkedIn company URL,
+// e.g. "https://www.linkedin.com/company/novo-nordisk/life/" -> "novo-nordisk"
+func parseCompanySlug(companyURL string) string {
+	matches := companySlugPattern.FindStringSubmatch(companyURL)
+	if len(matches) < 2 {
+		return ""
+	}
+	slug, err := url.PathUnescape(matches[1])
+	if err != nil {
+		slug = matches[1]
+	}
+	return strings.ToLower(strings.TrimSpace(slug))
+}
+
+// isNumericID reports whether a company slug is actually a numeric LinkedIn company ID
+func isNumericID(slug string) bool {
+	if slug == "" {
+		return false
+	}
+	for _, r := range slug {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// companyPageURL builds the canonical company page URL for a slug
+func companyPageURL(slug string) string {
+	return fmt.Sprintf("https://www.linkedin.com/company/%s/", url.PathEscape(slug))
+}
+
+// EnrichCompanies visits the LinkedIn page of companies that have not been enriched yet
+func (s *LinkedInScraper) EnrichCompanies(limit int) error {
+	companies, err := s.dataService.GetCompaniesForEnrichment(limit)
+	if err != nil {
+		return fmt.Errorf("failed to get companies for enrichment: %w", err)
+	}
+
+	if len(companies) == 0 {
+		fmt.Println("📭 No companies need enrichment")
+		return nil
+	}
+
+	ctx, cancel := s.newBrowserContext()
+	defer cancel()
+
+	if err := s.login(ctx); err != nil {
+		return fmt.Errorf("login failed: %w", err)
+	}
+	fmt.Printf("✅ Ready to enrich %d companies!\n", len(companies))
+
+	enrichedCount := 0
+	failedCount := 0
+
+	for i := range companies {
+		company := &companies[i]
+		fmt.Printf("\n🏢 Enriching company %d/%d: %s\n", i+1, len(companies), company.Name)
+
+		if err := s.enrichCompany(ctx, company); err != nil {
+			fmt.Printf("❌ Failed to enrich company %s: %v\n", company.Name, err)
+			failedCount++
+			continue
+		}
+
+		enrichedCount++
+		time.Sleep(time.Duration(s.config.Scraper.DelayBetweenRequests) * time.Second)
+	}
+
+	fmt.Printf("\n🎉 Company enrichment completed! Enriched: %d, Failed: %d\n", enrichedCount, failedCount)
+	return nil
+}
+
+// enrichCompany fetches LinkedIn page details for a company and saves them via the data service
+func (s *LinkedInScraper) enrichCompany(ctx context.Context, company *models.Company) error {
+	slug := parseCompanySlug(company.LinkedInURL)
+	if slug == "" {
+		return fmt.Errorf("company %q has no LinkedIn company URL", company.Name)
+	}
+
+	details, found := s.dataService.GetCachedCompanyEnrichment(slug)
+	if found {
+		logrus.Debugf("🎯 Cache hit for company enrichment: %s", slug)
+	} else {
+		var err error
+		details, err = s.fetchCompanyDetails(ctx, slug)
+		if err != nil {
+			return err
+		}
+		s.dataService.CacheCompanyEnrichment(slug, details)
+	}
+
+	mergeCompanyDetails(company, details)
+	if err := s.dataService.UpdateCompany(company); err != nil {
+		return fmt.Errorf("failed to save company enrichment: %w", err)
+	}
+
+	logrus.Debugf("💾 Enriched company %s: size=%v industry=%v", company.Name, company.EmployeeRange, company.Industry)
+	return nil
+}
+
+// fetchCompanyDetails navigates to a company's About page and extracts its details
+func (s *LinkedInScraper) fetchCompanyDetails(ctx context.Context, slug string) (*models.Company, error) {
+	navCtx, navCancel := context.WithTimeout(ctx, 15*time.Second)
+	defer navCancel()
+
+	err := chromedp.Run(navCtx,
+		chromedp.Navigate(companyPageURL(slug)+"about/"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to navigate to company page: %w", err)
+	}
+
+	if waitErr := smartWaitForCondition(ctx, `document.querySelector('dl dt') !== null || document.querySelector('.error-page') !== null`, 10*time.Second); waitErr != nil {
+		logrus.Debugf("Company about page did not render a details list: %v", waitErr)
+	}
+
+	var aboutData map[string]interface{}
+	evalCtx, evalCancel := context.WithTimeout(ctx, 15*time.Second)
+	defer evalCancel()
+
+	err = chromedp.Run(evalCtx,
+		chromedp.Evaluate(s.buildCompanyAboutScript(), &aboutData),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("JavaScript company extraction failed: %w", err)
+	}
+
+	return companyFromAboutData(aboutData, slug), nil
+}
+
+// companyFromAboutData converts the company About page extraction result to a Company
+func companyFromAboutData(data map[string]interface{}, slug string) *models.Company {
+	now := time.Now()
+	company := &models.Company{
+		Name:              getString(data, "name"),
+		LinkedInCompanyID: getString(data, "linkedinCompanyId"),
+		LinkedInURL:       companyPageURL(slug),
+		Industry:          getStringPointer(data, "industry"),
+		Headquarters:      getStringPointer(data, "headquarters"),
+		Website:           getStringPointer(data, "website"),
+		EnrichedAt:        &now,
+	}
+
+	if !isNumericID(slug) {
+		company.LinkedInSlug = slug
+	}
+
+	if sizeText := getString(data, "companySize"); sizeText != "" {
+		company.EmployeeRange, company.EmployeeCountMin, company.EmployeeCountMax = parseEmployeeRange(sizeText)
+	}
+	company.FollowerCount = parseFollowerCount(getString(data, "followers"))
+
+	return company
+}
+
+// mergeCompanyDetails copies enrichment fields from details onto company, keeping the company's identity
+func mergeCompanyDetails(company, details *models.Company) {
+	if details.LinkedInCompanyID != "" {
+		company.LinkedInCompanyID = details.LinkedInCompanyID
+	}
+	if details.LinkedInSlug != "" {
+		company.LinkedInSlug = details.LinkedInSlug
+	}
+	if details.LinkedInURL != "" {
+		company.LinkedInURL = details.LinkedInURL
+	}
+	company.EmployeeRange = details.EmployeeRange
+	company.EmployeeCountMin = details.EmployeeCountMin
+	company.EmployeeCountMax = details.EmployeeCountMax
+	company.Industry = details.Industry
+	company.Headquarters = details.Headquarters
+	company.Website = details.Website
+	company.FollowerCount = details.FollowerCount
+	company.EnrichedAt = details.EnrichedAt
+}
+
+var employeeRangePattern = regexp.MustCompile(`(\d[\d.,]*)\s*(?:-|–|til|to)\s*(\d[\d.,]*)|(\d[\d.,]*)\s*\+`)
+
+// parseEmployeeRange parses "51-200 employees", "10,001+ employees" or "201-500 ansatte"
+func parseEmployeeRange(text string) (rangeText *string, minCount *int, maxCount *int) {
+	matches := employeeRangePattern.FindStringSubmatch(text)
+	if matches == nil {
+		return nil, nil, nil
+	}
+
+	var normalised string
+	if matches[3] != "" {
+		if lower, ok := parseCount(matches[3]); ok {
+			minCount = &lower
+			normalised = fmt.Sprintf("%d+", lower)
+		}
+	} else {
+		lower, okLower := parseCount(matches[1])
+		upper, okUpper := parseCount(matches[2])
+		if okLower && okUpper {
+			minCount, maxCount = &lower, &upper
+			normalised = fmt.Sprintf("%d-%d", lower, upper)
+		}
+	}
+
+	if normalised == "" {
+		return nil, nil, nil
+	}
+	return &normalised, minCount, maxCount
+}
+
+var followerCountPattern = regexp.MustCompile(`(?i)(\d[\d.,]*)\s*([km])?\s*(?:followers|følgere)`)
+
+// parseFollowerCount parses "12,345 followers", "1.234 følgere" or "12K followers"
+func parseFollowerCount(text string) *int {
+	matches := followerCountPattern.FindStringSubmatch(text)
+	if matches == nil {
+		return nil
+	}
+
+	if matches[2] != "" {
+		// Abbreviated counts like "12.5K" use the separator as a decimal point
+		value, err := strconv.ParseFloat(strings.ReplaceAll(matches[1], ",", "."), 64)
+		if err != nil {
+			return nil
+		}
+		multiplier := 1000.0
+		if strings.EqualFold(matches[2], "m") {
+			multiplier = 1000000
+		}
+		count := int(value * multiplier)
+		return &count
+	}
+
+	count, ok := parseCount(matches[1])
+	if !ok {
+		return nil
+	}
+	return &count
+}
+
+// parseCount parses an integer with thousand separators ("10,001" or "10.001")
+func parseCount(text string) (int, bool) {
+	digits := strings.NewReplacer(",", "", ".", "", " ", "").Replace(text)
+	value, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
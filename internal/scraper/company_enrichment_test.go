@@ -0,0 +1,103 @@
+package scraper
+
+import (
+	"testing"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+func TestParseCompanySlug(t *testing.T) {
+	tests := map[string]string{
+		"https://www.linkedin.com/company/novo-nordisk/life/":        "novo-nordisk",
+		"https://dk.linkedin.com/company/lego-group?trk=public_jobs": "lego-group",
+		"https://www.linkedin.com/jobs/view/1234567890/":             "",
+	}
+
+	for input, expected := range tests {
+		if result := parseCompanySlug(input); result != expected {
+			t.Errorf("parseCompanySlug(%q) = %q, expected %q", input, result, expected)
+		}
+	}
+}
+
+func TestParseEmployeeRange(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		min      int
+		max      int
+	}{
+		{"51-200 employees", "51-200", 51, 200},
+		{"201-500 ansatte", "201-500", 201, 500},
+		{"1.001-5.000 ansatte", "1001-5000", 1001, 5000},
+		{"10,001+ employees", "10001+", 10001, 0},
+	}
+
+	for _, tt := range tests {
+		rangeText, minCount, maxCount := parseEmployeeRange(tt.input)
+		if rangeText == nil || *rangeText != tt.expected {
+			t.Errorf("parseEmployeeRange(%q) range = %v, expected %q", tt.input, rangeText, tt.expected)
+			continue
+		}
+		if minCount == nil || *minCount != tt.min {
+			t.Errorf("parseEmployeeRange(%q) min = %v, expected %d", tt.input, minCount, tt.min)
+		}
+		if tt.max == 0 && maxCount != nil {
+			t.Errorf("parseEmployeeRange(%q) max = %d, expected nil", tt.input, *maxCount)
+		} else if tt.max != 0 && (maxCount == nil || *maxCount != tt.max) {
+			t.Errorf("parseEmployeeRange(%q) max = %v, expected %d", tt.input, maxCount, tt.max)
+		}
+	}
+}
+
+func TestParseFollowerCount(t *testing.T) {
+	tests := map[string]int{
+		"12,345 followers": 12345,
+		"1.234 følgere":    1234,
+		"12K followers":    12000,
+		"1.5M followers":   1500000,
+	}
+
+	for input, expected := range tests {
+		result := parseFollowerCount(input)
+		if result == nil || *result != expected {
+			t.Errorf("parseFollowerCount(%q) = %v, expected %d", input, result, expected)
+		}
+	}
+}
+
+func TestCompanyReference(t *testing.T) {
+	tests := []struct {
+		job         models.JobPosting
+		identityKey string
+		url         string
+	}{
+		{models.JobPosting{CompanyName: "Novo Nordisk A/S", CompanyURL: "https://www.linkedin.com/company/novo-nordisk/life/"}, "slug:novo-nordisk", "https://www.linkedin.com/company/novo-nordisk/"},
+		{models.JobPosting{CompanyName: "Novo Nordisk", CompanyURL: "https://www.linkedin.com/company/novo-nordisk/", CompanyLinkedInID: "2227"}, "linkedin:2227", "https://www.linkedin.com/company/novo-nordisk/"},
+		{models.JobPosting{CompanyName: "Acme", CompanyURL: "https://www.linkedin.com/company/1234567/"}, "linkedin:1234567", "https://www.linkedin.com/company/1234567/"},
+		{models.JobPosting{CompanyName: "Acme"}, "name:Acme", ""},
+	}
+
+	for _, tt := range tests {
+		ref := companyReference(&tt.job)
+		if ref.IdentityKey() != tt.identityKey {
+			t.Errorf("companyReference(%q) identity = %q, expected %q", tt.job.CompanyURL, ref.IdentityKey(), tt.identityKey)
+		}
+		if ref.LinkedInURL != tt.url {
+			t.Errorf("companyReference(%q) URL = %q, expected %q", tt.job.CompanyURL, ref.LinkedInURL, tt.url)
+		}
+	}
+}
+
+func TestCompanyConflictsWith(t *testing.T) {
+	a := &models.Company{Name: "Acme", LinkedInCompanyID: "1"}
+	b := &models.Company{Name: "Acme", LinkedInCompanyID: "2"}
+	byName := &models.Company{Name: "Acme"}
+
+	if !a.ConflictsWith(b) {
+		t.Error("companies with different LinkedIn IDs should conflict")
+	}
+	if a.ConflictsWith(byName) || byName.ConflictsWith(a) {
+		t.Error("a company known only by name should not conflict")
+	}
+}
@@ -0,0 +1,108 @@
+package scraper
+
+import (
+	"strings"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// seniorityKeywords maps LinkedIn seniority labels (English and Danish) to normalised levels.
+// Order matters: "topleder" must be checked before the more generic "leder".
+var seniorityKeywords = []struct {
+	level    string
+	keywords []string
+}{
+	{models.SeniorityNotApplicable, []string{"not applicable", "ikke relevant", "ikke angivet"}},
+	{models.SeniorityInternship, []string{"internship", "praktik"}},
+	{models.SeniorityEntryLevel, []string{"entry level", "entry-level", "begynder", "nyuddannet"}},
+	{models.SeniorityAssociate, []string{"associate", "associeret"}},
+	{models.SeniorityMidSenior, []string{"mid-senior", "mid senior", "mellem", "seniorniveau", "erfaren"}},
+	{models.SeniorityExecutive, []string{"executive", "topleder", "ledelse"}},
+	{models.SeniorityDirector, []string{"director", "direktør", "leder"}},
+}
+
+// employmentKeywords maps LinkedIn employment type labels (English and Danish) to normalised types
+var employmentKeywords = []struct {
+	employmentType string
+	keywords       []string
+}{
+	{models.EmploymentFullTime, []string{"full-time", "full time", "fuldtid"}},
+	{models.EmploymentPartTime, []string{"part-time", "part time", "deltid"}},
+	{models.EmploymentContract, []string{"contract", "kontrakt", "freelance"}},
+	{models.EmploymentTemporary, []string{"temporary", "midlertidig", "vikar"}},
+	{models.EmploymentInternship, []string{"internship", "praktik"}},
+	{models.EmploymentVolunteer, []string{"volunteer", "frivillig"}},
+	{models.EmploymentOther, []string{"other", "andet"}},
+}
+
+// normaliseSeniorityLevel maps a raw seniority label to a SeniorityLevel constant
+func normaliseSeniorityLevel(raw string) *string {
+	text := strings.ToLower(strings.TrimSpace(raw))
+	if text == "" {
+		return nil
+	}
+
+	for _, candidate := range seniorityKeywords {
+		for _, keyword := range candidate.keywords {
+			if strings.Contains(text, keyword) {
+				level := candidate.level
+				return &level
+			}
+		}
+	}
+	return nil
+}
+
+// normaliseEmploymentType maps a raw employment type label to an EmploymentType constant
+func normaliseEmploymentType(raw string) *string {
+	text := strings.ToLower(strings.TrimSpace(raw))
+	if text == "" {
+		return nil
+	}
+
+	for _, candidate := range employmentKeywords {
+		for _, keyword := range candidate.keywords {
+			if strings.Contains(text, keyword) {
+				employmentType := candidate.employmentType
+				return &employmentType
+			}
+		}
+	}
+	return nil
+}
+
+// normaliseCriteriaList cleans a comma separated criteria value like
+// "Information Technology and Services, Software Development" into a comma separated list.
+// It doesn't split on "and": LinkedIn uses it inside single names like the one above.
+func normaliseCriteriaList(raw string) *string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+
+	var values []string
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.Join(strings.Fields(part), " ")
+		if part != "" {
+			values = append(values, part)
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := strings.Join(values, ", ")
+	return &result
+}
+
+// applyJobCriteria copies extracted job criteria onto a job posting
+func applyJobCriteria(job *models.JobPosting, criteria map[string]interface{}) {
+	if criteria == nil {
+		return
+	}
+
+	job.SeniorityLevel = normaliseSeniorityLevel(getString(criteria, "seniorityLevel"))
+	job.EmploymentType = normaliseEmploymentType(getString(criteria, "employmentType"))
+	job.JobFunction = normaliseCriteriaList(getString(criteria, "jobFunction"))
+	job.Industries = normaliseCriteriaList(getString(criteria, "industries"))
+}
@@ -0,0 +1,53 @@
+package scraper
+
+import (
+	"testing"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+func TestNormaliseJobCriteria(t *testing.T) {
+	tests := []struct {
+		name          string
+		seniority     string
+		employment    string
+		expectedLevel string
+		expectedType  string
+	}{
+		{"English mid-senior full-time", "Mid-Senior level", "Full-time", models.SeniorityMidSenior, models.EmploymentFullTime},
+		{"English entry level contract", "Entry level", "Contract", models.SeniorityEntryLevel, models.EmploymentContract},
+		{"Danish mid-senior full-time", "Mellemniveau/seniorniveau", "Fuldtid", models.SeniorityMidSenior, models.EmploymentFullTime},
+		{"Danish internship part-time", "Praktikant", "Deltid", models.SeniorityInternship, models.EmploymentPartTime},
+		{"Director temporary", "Director", "Temporary", models.SeniorityDirector, models.EmploymentTemporary},
+		{"Not applicable", "Not Applicable", "Other", models.SeniorityNotApplicable, models.EmploymentOther},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level := normaliseSeniorityLevel(tt.seniority)
+			if level == nil || *level != tt.expectedLevel {
+				t.Errorf("normaliseSeniorityLevel(%q) = %v, expected %q", tt.seniority, level, tt.expectedLevel)
+			}
+			employmentType := normaliseEmploymentType(tt.employment)
+			if employmentType == nil || *employmentType != tt.expectedType {
+				t.Errorf("normaliseEmploymentType(%q) = %v, expected %q", tt.employment, employmentType, tt.expectedType)
+			}
+		})
+	}
+
+	if level := normaliseSeniorityLevel(""); level != nil {
+		t.Errorf("normaliseSeniorityLevel(\"\") = %q, expected nil", *level)
+	}
+}
+
+func TestNormaliseCriteriaList(t *testing.T) {
+	result := normaliseCriteriaList("  Information Technology and Services ,  Software   Development ")
+	expected := "Information Technology and Services, Software Development"
+	if result == nil || *result != expected {
+		t.Errorf("normaliseCriteriaList() = %v, expected %q", result, expected)
+	}
+
+	if result := normaliseCriteriaList(" , "); result != nil {
+		t.Errorf("normaliseCriteriaList() = %q, expected nil for an empty list", *result)
+	}
+}
@@ -1,7 +1,9 @@
 package scraper
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"linkedin-job-scraper/internal/models"
 
@@ -9,9 +11,9 @@ import (
 )
 
 // saveJob saves a job posting via the data service (API + cache)
-func (s *LinkedInScraper) saveJob(jobPosting *models.JobPosting) error {
+func (s *LinkedInScraper) saveJob(ctx context.Context, jobPosting *models.JobPosting) error {
 	// Ensure company exists and get its ID
-	companyID, err := s.ensureCompanyExists(jobPosting.CompanyName, jobPosting.CompanyImageURL)
+	companyID, err := s.ensureCompanyExists(ctx, companyReference(jobPosting))
 	if err != nil {
 		return fmt.Errorf("failed to ensure company exists: %w", err)
 	}
@@ -19,42 +21,90 @@ func (s *LinkedInScraper) saveJob(jobPosting *models.JobPosting) error {
 	// Set the company ID on the job
 	jobPosting.CompanyID = companyID
 
+	// Link reposts of earlier postings - best effort, the job is saved either way
+	if original, err := s.dataService.LinkRepost(jobPosting); err != nil {
+		logrus.Warnf("⚠️  Failed to check job %d for reposts: %v", jobPosting.LinkedInJobID, err)
+	} else if original != nil {
+		fmt.Printf("🔁 Job %d is a repost of job %d (%s)\n", jobPosting.LinkedInJobID, original.LinkedInJobID, original.Title)
+	}
+
 	// Create job posting via DataService (includes company creation)
-	_, err = s.dataService.CreateJob(jobPosting)
+	createdJob, err := s.dataService.CreateJob(jobPosting)
 	if err != nil {
 		return fmt.Errorf("failed to create job posting: %w", err)
 	}
 
+	// The first snapshot starts the job's history; refreshes add the rest
+	if createdJob != nil {
+		jobPosting.JobID = createdJob.JobID
+		if err := s.dataService.RecordJobSnapshot(newJobSnapshot(jobPosting, time.Now())); err != nil {
+			logrus.Warnf("⚠️  Failed to record snapshot for job %d: %v", jobPosting.LinkedInJobID, err)
+		}
+	}
+
 	logrus.Debugf("💾 Successfully saved job: %s at %s", jobPosting.Title, jobPosting.CompanyName)
 	return nil
 }
 
-// ensureCompanyExists checks if a company exists and creates it if it doesn't
-func (s *LinkedInScraper) ensureCompanyExists(companyName, companyImageURL string) (int64, error) {
-	if companyName == "" {
+// companyReference builds the company identity for a scraped job: the LinkedIn company ID and
+// slug from the job page's company link, with the display name as a fallback identity
+func companyReference(job *models.JobPosting) *models.Company {
+	ref := &models.Company{
+		Name:              job.CompanyName,
+		ImageURL:          job.CompanyImageURL,
+		LinkedInCompanyID: job.CompanyLinkedInID,
+	}
+
+	slug := parseCompanySlug(job.CompanyURL)
+	if isNumericID(slug) {
+		// Links like /company/1234567/ carry the company ID instead of the vanity slug
+		if ref.LinkedInCompanyID == "" {
+			ref.LinkedInCompanyID = slug
+		}
+	} else {
+		ref.LinkedInSlug = slug
+	}
+	if slug != "" {
+		ref.LinkedInURL = companyPageURL(slug)
+	}
+
+	return ref
+}
+
+// ensureCompanyExists checks if a company exists and creates it if it doesn't.
+// Newly created companies are enriched from their LinkedIn page when enabled.
+func (s *LinkedInScraper) ensureCompanyExists(ctx context.Context, ref *models.Company) (int64, error) {
+	if ref.Name == "" && !ref.HasLinkedInIdentity() {
 		return 0, fmt.Errorf("company name cannot be empty")
 	}
 	
 	// Add debug logging to show where we're getting the company name from
-	logrus.Debugf("🏢 Processing company: name='%s', imageURL='%s'", companyName, companyImageURL)
+	logrus.Debugf("🏢 Processing company: name='%s', identity='%s', imageURL='%s'", ref.Name, ref.IdentityKey(), ref.ImageURL)
 	
 	// Check if company exists
-	exists, companyID, err := s.dataService.CompanyExists(companyName)
+	company, err := s.dataService.CompanyExists(ref)
 	if err != nil {
 		return 0, fmt.Errorf("failed to check if company exists: %w", err)
 	}
 	
-	if exists {
-		logrus.Debugf("✅ Company already exists: %s (ID: %d)", companyName, companyID)
-		return int64(companyID), nil
+	if company != nil {
+		logrus.Debugf("✅ Company already exists: %s (ID: %d)", company.Name, company.CompanyID)
+		return int64(company.CompanyID), nil
 	}
 	
 	// Create company if it doesn't exist
-	logrus.Debugf("🆕 Creating new company: %s with image: %s", companyName, companyImageURL)
-	companyID, err = s.dataService.CreateCompany(companyName, companyImageURL)
+	logrus.Debugf("🆕 Creating new company: %s with image: %s", ref.Name, ref.ImageURL)
+	company, err = s.dataService.CreateCompany(ref)
 	if err != nil {
 		return 0, fmt.Errorf("failed to create company: %w", err)
 	}
-	
-	return int64(companyID), nil
+
+	// Enrichment is best effort - a failure must not prevent saving the job
+	if s.config.Scraper.EnrichCompanies && company.LinkedInURL != "" {
+		if err := s.enrichCompany(ctx, company); err != nil {
+			logrus.Warnf("⚠️  Failed to enrich company %s: %v", company.Name, err)
+		}
+	}
+
+	return int64(company.CompanyID), nil
 }
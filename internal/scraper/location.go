@@ -0,0 +1,304 @@
+package scraper
+
+import (
+	"regexp"
+	"strings"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// structuredLocation is a LinkedIn location line split into its parts
+type structuredLocation struct {
+	City          string
+	Region        string
+	Country       string
+	CountryCode   string
+	PlaceKey      string
+	WorkplaceType string
+}
+
+// country is a country LinkedIn shows jobs in, with the names it uses in each UI language
+type country struct {
+	code  string
+	name  string
+	names []string
+}
+
+var countries = []country{
+	{"DK", "Denmark", []string{"denmark", "danmark", "dänemark"}},
+	{"SE", "Sweden", []string{"sweden", "sverige", "schweden"}},
+	{"NO", "Norway", []string{"norway", "norge", "noreg", "norwegen"}},
+	{"DE", "Germany", []string{"germany", "tyskland", "deutschland"}},
+	{"FI", "Finland", []string{"finland", "suomi", "finnland"}},
+	{"IS", "Iceland", []string{"iceland", "island"}},
+	{"NL", "Netherlands", []string{"netherlands", "nederland", "holland", "nederländerna", "niederlande"}},
+	{"BE", "Belgium", []string{"belgium", "belgien"}},
+	{"GB", "United Kingdom", []string{"united kingdom", "uk", "storbritannien", "storbritannia", "großbritannien", "england"}},
+	{"IE", "Ireland", []string{"ireland", "irland"}},
+	{"FR", "France", []string{"france", "frankrig", "frankrike", "frankreich"}},
+	{"ES", "Spain", []string{"spain", "spanien", "spania"}},
+	{"PT", "Portugal", []string{"portugal"}},
+	{"PL", "Poland", []string{"poland", "polen"}},
+	{"CH", "Switzerland", []string{"switzerland", "schweiz", "sveits"}},
+	{"AT", "Austria", []string{"austria", "østrig", "österrike", "østerrike", "österreich"}},
+	{"EE", "Estonia", []string{"estonia", "estland"}},
+	{"US", "United States", []string{"united states", "usa", "forenede stater"}},
+}
+
+// regionAliases maps local region names to the English names LinkedIn uses, so the place key
+// doesn't depend on the UI language the job was scraped in
+var regionAliases = map[string]string{
+	"region hovedstaden":     "Capital Region of Denmark",
+	"hovedstaden":            "Capital Region of Denmark",
+	"region midtjylland":     "Central Denmark Region",
+	"midtjylland":            "Central Denmark Region",
+	"region syddanmark":      "Region of Southern Denmark",
+	"syddanmark":             "Region of Southern Denmark",
+	"region nordjylland":     "North Denmark Region",
+	"nordjylland":            "North Denmark Region",
+	"region sjælland":        "Region Zealand",
+	"sjælland":               "Region Zealand",
+	"stockholms län":         "Stockholm County",
+	"västra götalands län":   "Västra Götaland County",
+	"skåne län":              "Skåne County",
+	"storkøbenhavn":          "Greater Copenhagen",
+	"københavns storkommune": "Greater Copenhagen",
+}
+
+// cities maps local and English city names to the canonical English name and country code.
+// Only cities we see often need to be here; unknown cities are kept as written.
+var cities = map[string]struct {
+	name        string
+	countryCode string
+}{
+	"copenhagen":     {"Copenhagen", "DK"},
+	"københavn":      {"Copenhagen", "DK"},
+	"kopenhagen":     {"Copenhagen", "DK"},
+	"köpenhamn":      {"Copenhagen", "DK"},
+	"aarhus":         {"Aarhus", "DK"},
+	"århus":          {"Aarhus", "DK"},
+	"odense":         {"Odense", "DK"},
+	"aalborg":        {"Aalborg", "DK"},
+	"ålborg":         {"Aalborg", "DK"},
+	"kongens lyngby": {"Kongens Lyngby", "DK"},
+	"lyngby":         {"Kongens Lyngby", "DK"},
+	"billund":        {"Billund", "DK"},
+	"stockholm":      {"Stockholm", "SE"},
+	"gothenburg":     {"Gothenburg", "SE"},
+	"göteborg":       {"Gothenburg", "SE"},
+	"malmö":          {"Malmö", "SE"},
+	"malmo":          {"Malmö", "SE"},
+	"oslo":           {"Oslo", "NO"},
+	"bergen":         {"Bergen", "NO"},
+	"trondheim":      {"Trondheim", "NO"},
+	"berlin":         {"Berlin", "DE"},
+	"hamburg":        {"Hamburg", "DE"},
+	"munich":         {"Munich", "DE"},
+	"münchen":        {"Munich", "DE"},
+	"helsinki":       {"Helsinki", "FI"},
+	"london":         {"London", "GB"},
+	"amsterdam":      {"Amsterdam", "NL"},
+}
+
+// workplaceKeywords maps the parenthesised workplace type in a location line to a WorkType constant
+var workplaceKeywords = []struct {
+	workType string
+	keywords []string
+}{
+	{models.WorkTypeHybrid, []string{"hybrid"}},
+	{models.WorkTypeRemote, []string{"remote", "fjernarbejde", "fjernarbeid", "distans", "fernarbeit"}},
+	{models.WorkTypeOnSite, []string{"on-site", "on site", "på stedet", "på arbejdspladsen", "på plats", "på kontoret", "vor ort"}},
+}
+
+// regionKeywords mark a location part as a region or metropolitan area rather than a city
+var regionKeywords = []string{"region", "area", "metropolitan", "greater ", "county", "län", "fylke", "storkøbenhavn", "storkommune", "hovedstaden", "jylland", "sjælland"}
+
+var parenthesisedPattern = regexp.MustCompile(`\(([^)]*)\)`)
+
+// parseStructuredLocation splits a location like "Copenhagen, Capital Region of Denmark, Denmark (Hybrid)"
+// into city, region and country, maps the country to its ISO code and builds a stable place key
+func parseStructuredLocation(location string) *structuredLocation {
+	result := &structuredLocation{}
+
+	for _, match := range parenthesisedPattern.FindAllStringSubmatch(location, -1) {
+		if workType := normaliseWorkplaceType(match[1]); workType != "" {
+			result.WorkplaceType = workType
+		}
+	}
+	location = strings.TrimSpace(parenthesisedPattern.ReplaceAllString(location, ""))
+	if location == "" {
+		return result
+	}
+
+	var parts []string
+	for _, part := range strings.Split(location, ",") {
+		if part = strings.Join(strings.Fields(part), " "); part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	// The country is always last when present
+	if len(parts) > 0 {
+		if c := lookupCountry(parts[len(parts)-1]); c != nil {
+			result.Country, result.CountryCode = c.name, c.code
+			parts = parts[:len(parts)-1]
+		}
+	}
+
+	for i, part := range parts {
+		switch {
+		case i == 0 && !isRegionName(part):
+			result.City = canonicalCityName(part)
+		case result.Region == "":
+			result.Region = canonicalRegionName(part)
+		}
+	}
+
+	// "Copenhagen, Capital Region of Denmark" and "Copenhagen Metropolitan Area" have no country
+	// part, but the region or city tells us
+	if result.CountryCode == "" {
+		if c := inferCountry(result.Region, result.City); c != nil {
+			result.Country, result.CountryCode = c.name, c.code
+		}
+	}
+
+	result.PlaceKey = placeKey(result.CountryCode, result.Region, result.City)
+	return result
+}
+
+// applyTo stores the structured location on a job. A workplace type from the location line
+// also fills WorkType when the page didn't show one.
+func (l *structuredLocation) applyTo(job *models.JobPosting) {
+	job.LocationCity = optionalString(l.City)
+	job.LocationRegion = optionalString(l.Region)
+	job.LocationCountry = optionalString(l.Country)
+	job.CountryCode = optionalString(l.CountryCode)
+	job.PlaceKey = optionalString(l.PlaceKey)
+	job.WorkplaceType = optionalString(l.WorkplaceType)
+
+	if job.WorkType == nil || *job.WorkType == "" {
+		job.WorkType = optionalString(l.WorkplaceType)
+	}
+}
+
+func normaliseWorkplaceType(text string) string {
+	text = strings.ToLower(strings.TrimSpace(text))
+	for _, candidate := range workplaceKeywords {
+		for _, keyword := range candidate.keywords {
+			if strings.Contains(text, keyword) {
+				return candidate.workType
+			}
+		}
+	}
+	return ""
+}
+
+func lookupCountry(name string) *country {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for i := range countries {
+		for _, candidate := range countries[i].names {
+			if name == candidate {
+				return &countries[i]
+			}
+		}
+	}
+	return nil
+}
+
+// inferCountry finds a country named in one of texts, or the country of a known city in them
+func inferCountry(texts ...string) *country {
+	for _, text := range texts {
+		text = strings.ToLower(text)
+		for i := range countries {
+			for _, name := range countries[i].names {
+				if containsWord(text, name) {
+					return &countries[i]
+				}
+			}
+		}
+		for name, city := range cities {
+			if containsWord(text, name) {
+				for i := range countries {
+					if countries[i].code == city.countryCode {
+						return &countries[i]
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func isRegionName(name string) bool {
+	name = strings.ToLower(name)
+	if _, ok := regionAliases[name]; ok {
+		return true
+	}
+	for _, keyword := range regionKeywords {
+		if strings.Contains(name, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func canonicalCityName(name string) string {
+	if city, ok := cities[strings.ToLower(name)]; ok {
+		return city.name
+	}
+	return name
+}
+
+func canonicalRegionName(name string) string {
+	if alias, ok := regionAliases[strings.ToLower(name)]; ok {
+		return alias
+	}
+	return name
+}
+
+// containsWord reports whether word appears in text as a whole word ("uk" must not match "ukraine")
+func containsWord(text, word string) bool {
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool { return r == ' ' || r == '-' }) {
+		if field == word {
+			return true
+		}
+	}
+	return strings.Contains(word, " ") && strings.Contains(text, word)
+}
+
+// placeKey builds a lowercase ASCII key like "dk/capital-region-of-denmark/copenhagen",
+// leaving out unknown parts
+func placeKey(countryCode, region, city string) string {
+	var segments []string
+	for _, segment := range []string{countryCode, region, city} {
+		if slug := slugify(segment); slug != "" {
+			segments = append(segments, slug)
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+var slugReplacer = strings.NewReplacer("æ", "ae", "ø", "oe", "å", "aa", "ä", "ae", "ö", "oe", "ü", "ue", "ß", "ss", "é", "e")
+
+func slugify(text string) string {
+	text = slugReplacer.Replace(strings.ToLower(text))
+	var b strings.Builder
+	dash := false
+	for _, r := range text {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+		} else if b.Len() > 0 && !dash {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
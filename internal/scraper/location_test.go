@@ -0,0 +1,89 @@
+package scraper
+
+import (
+	"testing"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+func TestParseStructuredLocation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected structuredLocation
+	}{
+		{
+			input: "Copenhagen, Capital Region of Denmark, Denmark (Hybrid)",
+			expected: structuredLocation{City: "Copenhagen", Region: "Capital Region of Denmark", Country: "Denmark",
+				CountryCode: "DK", PlaceKey: "dk/capital-region-of-denmark/copenhagen", WorkplaceType: models.WorkTypeHybrid},
+		},
+		{
+			input: "København, Region Hovedstaden, Danmark",
+			expected: structuredLocation{City: "Copenhagen", Region: "Capital Region of Denmark", Country: "Denmark",
+				CountryCode: "DK", PlaceKey: "dk/capital-region-of-denmark/copenhagen"},
+		},
+		{
+			input: "Copenhagen, Capital Region of Denmark",
+			expected: structuredLocation{City: "Copenhagen", Region: "Capital Region of Denmark", Country: "Denmark",
+				CountryCode: "DK", PlaceKey: "dk/capital-region-of-denmark/copenhagen"},
+		},
+		{
+			input: "Greater Copenhagen (Remote)",
+			expected: structuredLocation{Region: "Greater Copenhagen", Country: "Denmark", CountryCode: "DK",
+				PlaceKey: "dk/greater-copenhagen", WorkplaceType: models.WorkTypeRemote},
+		},
+		{
+			input:    "Denmark (Fjernarbejde)",
+			expected: structuredLocation{Country: "Denmark", CountryCode: "DK", PlaceKey: "dk", WorkplaceType: models.WorkTypeRemote},
+		},
+		{
+			input: "Göteborg, Västra Götalands län, Sverige (På plats)",
+			expected: structuredLocation{City: "Gothenburg", Region: "Västra Götaland County", Country: "Sweden",
+				CountryCode: "SE", PlaceKey: "se/vaestra-goetaland-county/gothenburg", WorkplaceType: models.WorkTypeOnSite},
+		},
+		{
+			input:    "Oslo",
+			expected: structuredLocation{City: "Oslo", Country: "Norway", CountryCode: "NO", PlaceKey: "no/oslo"},
+		},
+		{
+			input:    "Herlev, Denmark",
+			expected: structuredLocation{City: "Herlev", Country: "Denmark", CountryCode: "DK", PlaceKey: "dk/herlev"},
+		},
+		{
+			input:    "Kyiv, Ukraine",
+			expected: structuredLocation{City: "Kyiv", Region: "Ukraine", PlaceKey: "ukraine/kyiv"},
+		},
+		{
+			input:    "",
+			expected: structuredLocation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := parseStructuredLocation(tt.input)
+			if *result != tt.expected {
+				t.Errorf("parseStructuredLocation(%q) = %+v, expected %+v", tt.input, *result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStructuredLocationApplyTo(t *testing.T) {
+	job := &models.JobPosting{}
+	parseStructuredLocation("Aarhus, Central Denmark Region, Denmark (Hybrid)").applyTo(job)
+
+	if job.CountryCode == nil || *job.CountryCode != "DK" {
+		t.Errorf("CountryCode = %v, expected DK", job.CountryCode)
+	}
+	if job.WorkType == nil || *job.WorkType != models.WorkTypeHybrid {
+		t.Errorf("WorkType = %v, expected the workplace type from the location", job.WorkType)
+	}
+
+	// A work type found on the page is kept
+	remote := models.WorkTypeRemote
+	job = &models.JobPosting{WorkType: &remote}
+	parseStructuredLocation("Aarhus (Hybrid)").applyTo(job)
+	if *job.WorkType != models.WorkTypeRemote || job.WorkplaceType == nil || *job.WorkplaceType != models.WorkTypeHybrid {
+		t.Errorf("WorkType = %q, WorkplaceType = %v", *job.WorkType, job.WorkplaceType)
+	}
+}
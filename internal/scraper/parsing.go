@@ -17,23 +17,50 @@ func (s *LinkedInScraper) convertToJobPosting(jobData map[string]interface{}, jo
 		return nil, fmt.Errorf("invalid LinkedIn job ID '%s': %w", jobIDStr, err)
 	}
 
-	// Parse location data to extract applicants and posted date
+	// Parse location data to extract applicants
 	locationStr := getString(jobData, "location")
-	location, postedDate, applicants := parseLocationInfo(locationStr)
+	location, _, applicants := parseLocationInfo(locationStr)
+
+	// Prefer LinkedIn's machine-readable posting time over the relative date in the location line
+	postedTimestamp, _ := jobData["postedTimestamp"].(map[string]interface{})
+	postedDate, postedDateSource := resolvePostedDate(postedTimestamp, locationStr, time.Now())
 
 	// Create the job posting (CompanyID will be set when saving to DB)
 	job := &models.JobPosting{
-		LinkedInJobID:   jobID,
-		Title:           getString(jobData, "title"),
-		CompanyName:     getString(jobData, "company"), // Temporary field for company name
-		CompanyImageURL: getString(jobData, "companyImageUrl"), // Temporary field for company image URL
-		Location:        location,
-		Description:     getString(jobData, "description"),
-		ApplyURL:        getString(jobData, "applyUrl"),
-		PostedDate:      postedDate,
-		Applicants:      applicants,
-		WorkType:        getStringPointer(jobData, "workType"),
-		Skills:          getSkillsPointer(jobData, "skills"),
+		LinkedInJobID:     jobID,
+		Title:             getString(jobData, "title"),
+		CompanyName:       getString(jobData, "company"),           // Temporary field for company name
+		CompanyImageURL:   getString(jobData, "companyImageUrl"),   // Temporary field for company image URL
+		CompanyURL:        getString(jobData, "companyUrl"),        // Temporary field for company page URL
+		CompanyLinkedInID: getString(jobData, "companyLinkedinId"), // Temporary field for LinkedIn company ID
+		Location:          location,
+		Description:       getString(jobData, "description"),
+		ApplyURL:          getString(jobData, "applyUrl"),
+		PostedDate:        postedDate,
+		PostedDateSource:  &postedDateSource,
+		Applicants:        applicants,
+		WorkType:          getStringPointer(jobData, "workType"),
+		Skills:            getSkillsPointer(jobData, "skills"),
+	}
+
+	// Skills from the insights modal with their canonical IDs, or else from the description
+	s.skills.ApplyTo(job)
+
+	// City, region, country and workplace type from the location line
+	parseStructuredLocation(location).applyTo(job)
+
+	// Prefer LinkedIn's salary insights, fall back to salary mentions in the description
+	salary := parseSalary(getString(jobData, "salary"))
+	if salary == nil {
+		salary = parseSalaryFromDescription(job.Description)
+	}
+	if salary != nil {
+		salary.applyTo(job)
+	}
+
+	// Seniority level, employment type, job function and industries
+	if criteria, ok := jobData["criteria"].(map[string]interface{}); ok {
+		applyJobCriteria(job, criteria)
 	}
 
 	return job, nil
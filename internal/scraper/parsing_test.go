@@ -1,8 +1,12 @@
 package scraper
 
 import (
+	"reflect"
 	"testing"
 	"time"
+
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/skills"
 )
 
 func TestParseApplicantsCount(t *testing.T) {
@@ -36,6 +40,33 @@ func TestParseApplicantsCount(t *testing.T) {
 			input:    "some other text",
 			expected: nil,
 		},
+		// Corpus of applicant texts seen on LinkedIn job pages
+		{name: "English over", input: "Over 100 applicants", expected: intPtr(100)},
+		{name: "English more than", input: "More than 200 applicants", expected: intPtr(200)},
+		{name: "English among the first", input: "Be among the first 25 applicants", expected: intPtr(25)},
+		{name: "English clicked apply", input: "47 people clicked apply", expected: intPtr(47)},
+		{name: "English thousands comma", input: "1,234 applicants", expected: intPtr(1234)},
+		{name: "English no applicants", input: "No applicants yet", expected: intPtr(0)},
+		{name: "Danish over", input: "Mere end 100 ansøgere", expected: intPtr(100)},
+		{name: "Danish over flere end", input: "Flere end 200 ansøgere", expected: intPtr(200)},
+		{name: "Danish among the first", input: "Vær blandt de første 25 ansøgere", expected: intPtr(25)},
+		{name: "Danish thousands dot", input: "1.050 ansøgere", expected: intPtr(1050)},
+		{name: "Danish clicked apply", input: "12 personer har klikket på Ansøg", expected: intPtr(12)},
+		{name: "Danish no applicants", input: "Ingen ansøgere endnu", expected: intPtr(0)},
+		{name: "Swedish exact", input: "37 sökande", expected: intPtr(37)},
+		{name: "Swedish over", input: "Fler än 100 sökande", expected: intPtr(100)},
+		{name: "Swedish among the first", input: "Bli en av de första 25 sökande", expected: intPtr(25)},
+		{name: "Norwegian exact", input: "8 søkere", expected: intPtr(8)},
+		{name: "Norwegian over", input: "Mer enn 100 søkere", expected: intPtr(100)},
+		{name: "Norwegian among the first", input: "Vær blant de første 25 søkerne", expected: intPtr(25)},
+		{name: "German exact", input: "30 Bewerber", expected: intPtr(30)},
+		{name: "German over", input: "Über 200 Bewerber", expected: intPtr(200)},
+		{name: "German more than", input: "Mehr als 100 Bewerbungen", expected: intPtr(100)},
+		{name: "German among the first", input: "Gehören Sie zu den ersten 25 Bewerbern", expected: intPtr(25)},
+		{name: "Non-breaking space thousands", input: "1\u00a0200 sökande", expected: intPtr(1200)},
+		{name: "Surrounding whitespace", input: "  15 applicants \n", expected: intPtr(15)},
+		{name: "Date only", input: "2 weeks ago", expected: nil},
+		{name: "Empty", input: "", expected: nil},
 	}
 
 	for _, tt := range tests {
@@ -112,6 +143,179 @@ func TestParseLocationInfo(t *testing.T) {
 	}
 }
 
+func TestParsePostedText(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input    string
+		expected time.Time
+		reposted bool
+		promoted bool
+	}{
+		// Danish
+		{input: "2 dage siden", expected: now.AddDate(0, 0, -2)},
+		{input: "1 dag siden", expected: now.AddDate(0, 0, -1)},
+		{input: "en dag siden", expected: now.AddDate(0, 0, -1)},
+		{input: "for 3 timer siden", expected: now.Add(-3 * time.Hour)},
+		{input: "for en time siden", expected: now.Add(-time.Hour)},
+		{input: "45 minutter siden", expected: now.Add(-45 * time.Minute)},
+		{input: "1 uge siden", expected: now.AddDate(0, 0, -7)},
+		{input: "3 uger siden", expected: now.AddDate(0, 0, -21)},
+		{input: "en måned siden", expected: now.AddDate(0, -1, 0)},
+		{input: "5 måneder siden", expected: now.AddDate(0, -5, 0)},
+		{input: "et år siden", expected: now.AddDate(-1, 0, 0)},
+		{input: "Genopslået for 2 uger siden", expected: now.AddDate(0, 0, -14), reposted: true},
+		{input: "lige nu", expected: now},
+		// English
+		{input: "2 days ago", expected: now.AddDate(0, 0, -2)},
+		{input: "a day ago", expected: now.AddDate(0, 0, -1)},
+		{input: "an hour ago", expected: now.Add(-time.Hour)},
+		{input: "17 hours ago", expected: now.Add(-17 * time.Hour)},
+		{input: "30 minutes ago", expected: now.Add(-30 * time.Minute)},
+		{input: "5 mins ago", expected: now.Add(-5 * time.Minute)},
+		{input: "1 week ago", expected: now.AddDate(0, 0, -7)},
+		{input: "2 months ago", expected: now.AddDate(0, -2, 0)},
+		{input: "a year ago", expected: now.AddDate(-1, 0, 0)},
+		{input: "Reposted 3 weeks ago", expected: now.AddDate(0, 0, -21), reposted: true},
+		{input: "Just now", expected: now},
+		// Swedish
+		{input: "för 2 dagar sedan", expected: now.AddDate(0, 0, -2)},
+		{input: "för en timme sedan", expected: now.Add(-time.Hour)},
+		{input: "för 10 minuter sedan", expected: now.Add(-10 * time.Minute)},
+		{input: "för 1 vecka sedan", expected: now.AddDate(0, 0, -7)},
+		{input: "för ett år sedan", expected: now.AddDate(-1, 0, 0)},
+		{input: "Publicerades igen för 4 veckor sedan", expected: now.AddDate(0, 0, -28), reposted: true},
+		// Norwegian
+		{input: "for 2 dager siden", expected: now.AddDate(0, 0, -2)},
+		{input: "for 1 uke siden", expected: now.AddDate(0, 0, -7)},
+		{input: "for 2 uker siden", expected: now.AddDate(0, 0, -14)},
+		{input: "for ett år siden", expected: now.AddDate(-1, 0, 0)},
+		{input: "for 20 minutter siden", expected: now.Add(-20 * time.Minute)},
+		{input: "Publisert på nytt for 6 dager siden", expected: now.AddDate(0, 0, -6), reposted: true},
+		// German
+		{input: "vor 2 Tagen", expected: now.AddDate(0, 0, -2)},
+		{input: "vor einem Tag", expected: now.AddDate(0, 0, -1)},
+		{input: "vor einer Stunde", expected: now.Add(-time.Hour)},
+		{input: "vor 15 Minuten", expected: now.Add(-15 * time.Minute)},
+		{input: "vor 3 Wochen", expected: now.AddDate(0, 0, -21)},
+		{input: "vor einem Monat", expected: now.AddDate(0, -1, 0)},
+		{input: "vor 2 Jahren", expected: now.AddDate(-2, 0, 0)},
+		{input: "Erneut veröffentlicht vor 1 Woche", expected: now.AddDate(0, 0, -7), reposted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := parsePostedText(tt.input, now)
+			if result.Posted == nil {
+				t.Fatalf("parsePostedText(%q) returned no date, expected %v", tt.input, tt.expected)
+			}
+			if !result.Posted.Equal(tt.expected) {
+				t.Errorf("parsePostedText(%q) = %v, expected %v", tt.input, *result.Posted, tt.expected)
+			}
+			if result.Reposted != tt.reposted {
+				t.Errorf("parsePostedText(%q) reposted = %v, expected %v", tt.input, result.Reposted, tt.reposted)
+			}
+			if result.Promoted != tt.promoted {
+				t.Errorf("parsePostedText(%q) promoted = %v, expected %v", tt.input, result.Promoted, tt.promoted)
+			}
+		})
+	}
+}
+
+func TestParsePostedTextWithoutDate(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input    string
+		promoted bool
+	}{
+		{input: "Promoted", promoted: true},
+		{input: "Promoveret", promoted: true},
+		{input: "Marknadsförd", promoted: true},
+		{input: "Promotert", promoted: true},
+		{input: "Gesponsert", promoted: true},
+		{input: "Copenhagen, Capital Region, Denmark"},
+		{input: "15 ansøgere"},
+		{input: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := parsePostedText(tt.input, now)
+			if result.Posted != nil {
+				t.Errorf("parsePostedText(%q) = %v, expected no date", tt.input, *result.Posted)
+			}
+			if result.Promoted != tt.promoted {
+				t.Errorf("parsePostedText(%q) promoted = %v, expected %v", tt.input, result.Promoted, tt.promoted)
+			}
+		})
+	}
+}
+
+func TestExtractNumberFromPattern(t *testing.T) {
+	tests := []struct {
+		text     string
+		pattern  string
+		expected int
+	}{
+		{"over 100 applicants", `over\s+(\d+)`, 100},
+		// The pattern decides which number is used, not its position in the text
+		{"2 weeks ago · over 100 applicants", `over\s+(\d+)`, 100},
+		{"1,234 applicants", `([\d,]+)\s+applicants`, 1234},
+		{"no match here", `over\s+(\d+)`, 0},
+	}
+
+	for _, tt := range tests {
+		if result := extractNumberFromPattern(tt.text, tt.pattern); result != tt.expected {
+			t.Errorf("extractNumberFromPattern(%q, %q) = %d, expected %d", tt.text, tt.pattern, result, tt.expected)
+		}
+	}
+}
+
+func TestConvertToJobPostingNormalisesSkills(t *testing.T) {
+	taxonomy, err := skills.LoadTaxonomy("../../" + skills.DefaultTaxonomyPath)
+	if err != nil {
+		t.Fatalf("LoadTaxonomy() error = %v", err)
+	}
+	s := &LinkedInScraper{skills: skills.NewExtractor(taxonomy)}
+
+	jobData := map[string]interface{}{
+		"title":  "Backend Developer",
+		"skills": []interface{}{"Golang", "Go (Programming Language)", "Projektledelse", "Obscure Skill"},
+	}
+	job, err := s.convertToJobPosting(jobData, "123", "")
+	if err != nil {
+		t.Fatalf("convertToJobPosting() error = %v", err)
+	}
+	if got := []string(*job.Skills); len(got) != 4 {
+		t.Errorf("Skills = %v, want the 4 raw labels", got)
+	}
+	if job.SkillIDs == nil || !reflect.DeepEqual([]string(*job.SkillIDs), []string{"go", "project-management"}) {
+		t.Errorf("SkillIDs = %v, want [go project-management]", job.SkillIDs)
+	}
+
+	if *job.SkillsSource != models.SkillsSourceInsights {
+		t.Errorf("SkillsSource = %q, want insights", *job.SkillsSource)
+	}
+
+	// Without insights the skills come from the description
+	jobData = map[string]interface{}{
+		"title":       "Backend Developer",
+		"description": "Our stack: Go, PostgreSQL and React Native. Go ahead and apply!",
+	}
+	job, _ = s.convertToJobPosting(jobData, "123", "")
+	if job.Skills == nil || !reflect.DeepEqual([]string(*job.Skills), []string{"Go", "PostgreSQL", "React Native"}) ||
+		*job.SkillsSource != models.SkillsSourceDescription {
+		t.Errorf("Skills = %v from %v, want [Go PostgreSQL React Native] from description", job.Skills, job.SkillsSource)
+	}
+
+	// Without a taxonomy the raw labels are kept and no IDs are set
+	job, _ = (&LinkedInScraper{}).convertToJobPosting(jobData, "123", "")
+	if job.SkillIDs != nil || job.Skills != nil {
+		t.Errorf("Skills without taxonomy = %v, %v", job.Skills, job.SkillIDs)
+	}
+}
+
 // Helper function to create int pointer
 func intPtr(i int) *int {
 	return &i
@@ -0,0 +1,69 @@
+package scraper
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// postedTimestampLayouts are the formats LinkedIn uses in JSON-LD and <time datetime>
+var postedTimestampLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// parsePostedTimestamp parses a machine-readable posting time: an RFC 3339 timestamp,
+// a plain date, or epoch milliseconds as used by LinkedIn's "listedAt"
+func parsePostedTimestamp(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	if millis, err := strconv.ParseInt(value, 10, 64); err == nil && len(value) >= 12 {
+		return time.UnixMilli(millis), true
+	}
+
+	for _, layout := range postedTimestampLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// resolvePostedDate picks the most precise posting time available: the machine-readable
+// timestamp extracted from the page, then relative text like "2 uger siden" in the location
+// line, and finally the scrape time. It returns the time and a PostedDateSource constant.
+func resolvePostedDate(postedTimestamp map[string]interface{}, locationStr string, now time.Time) (time.Time, string) {
+	if postedTimestamp != nil {
+		source := getString(postedTimestamp, "source")
+		if posted, ok := parsePostedTimestamp(getString(postedTimestamp, "value")); ok && isMachineReadableSource(source) {
+			// A posting time in the future is clock skew or a parse error
+			if !posted.After(now) {
+				return posted, source
+			}
+		}
+	}
+
+	parts := strings.Split(locationStr, "·")
+	for _, part := range parts[1:] {
+		if posted := parsePostedText(part, now).Posted; posted != nil {
+			return *posted, models.PostedDateSourceRelative
+		}
+	}
+
+	return now, models.PostedDateSourceScrapedAt
+}
+
+func isMachineReadableSource(source string) bool {
+	switch source {
+	case models.PostedDateSourceJSONLD, models.PostedDateSourceCodeBlock, models.PostedDateSourceTimeElement:
+		return true
+	}
+	return false
+}
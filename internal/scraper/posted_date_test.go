@@ -0,0 +1,85 @@
+package scraper
+
+import (
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+func TestParsePostedTimestamp(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"2024-05-01T10:30:00.000Z", time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)},
+		{"2024-05-01T12:30:00+02:00", time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)},
+		{"2024-05-01", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"1714559400000", time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		result, ok := parsePostedTimestamp(tt.input)
+		if !ok || !result.Equal(tt.expected) {
+			t.Errorf("parsePostedTimestamp(%q) = %v, %v, expected %v", tt.input, result, ok, tt.expected)
+		}
+	}
+
+	for _, input := range []string{"", "2 uger siden", "42"} {
+		if _, ok := parsePostedTimestamp(input); ok {
+			t.Errorf("parsePostedTimestamp(%q) should fail", input)
+		}
+	}
+}
+
+func TestResolvePostedDate(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		timestamp      map[string]interface{}
+		location       string
+		expectedSource string
+		expected       time.Time
+	}{
+		{
+			name:           "JSON-LD wins over relative text",
+			timestamp:      map[string]interface{}{"value": "2024-05-01T10:30:00.000Z", "source": "json_ld"},
+			location:       "Copenhagen · 2 uger siden",
+			expectedSource: models.PostedDateSourceJSONLD,
+			expected:       time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:           "code block epoch milliseconds",
+			timestamp:      map[string]interface{}{"value": "1714559400000", "source": "code_block"},
+			expectedSource: models.PostedDateSourceCodeBlock,
+			expected:       time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:           "relative fallback",
+			timestamp:      map[string]interface{}{"value": "", "source": ""},
+			location:       "Copenhagen · 2 dage siden · 15 ansøgere",
+			expectedSource: models.PostedDateSourceRelative,
+			expected:       now.AddDate(0, 0, -2),
+		},
+		{
+			name:           "future timestamp ignored",
+			timestamp:      map[string]interface{}{"value": "2030-01-01", "source": "time_element"},
+			location:       "Copenhagen",
+			expectedSource: models.PostedDateSourceScrapedAt,
+			expected:       now,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posted, source := resolvePostedDate(tt.timestamp, tt.location, now)
+			if source != tt.expectedSource {
+				t.Errorf("source = %q, expected %q", source, tt.expectedSource)
+			}
+			if !posted.Equal(tt.expected) {
+				t.Errorf("posted = %v, expected %v", posted, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+
+	"github.com/chromedp/chromedp"
+	"github.com/sirupsen/logrus"
+)
+
+// jobStatus is the result of revisiting a stored job posting
+type jobStatus struct {
+	Closed     bool // "No longer accepting applications"
+	Removed    bool // Error page or "no longer available" message
+	StatusText string
+	Applicants *int
+
+	// Current page content, recorded in the job's history
+	Title       string
+	Description string
+	WorkType    string
+}
+
+// errAuthRequired means LinkedIn sent the browser to a login page instead of the job, so the
+// session expired and no further job in the batch can be checked
+var errAuthRequired = errors.New("LinkedIn session expired")
+
+// authPaths are where LinkedIn redirects a browser that isn't logged in
+var authPaths = []string{"/login", "/authwall", "/checkpoint", "/uas/"}
+
+// refreshResult is reported by a refresh worker for each job it checked
+type refreshResult struct {
+	job    *models.JobPosting
+	status *jobStatus
+	err    error
+}
+
+// RefreshJobs revisits open jobs and jobs posted more than olderThanDays days ago, least
+// recently refreshed first, using a pool of browser tabs. It records closed dates and
+// updated applicant counts via the data service, and saves a snapshot of each job to its
+// history. A login redirect stops the whole batch.
+func (s *LinkedInScraper) RefreshJobs(olderThanDays, limit, workers int) error {
+	jobs, err := s.dataService.GetJobsToRefresh(olderThanDays, limit)
+	if err != nil {
+		return fmt.Errorf("failed to get jobs to refresh: %w", err)
+	}
+
+	if len(jobs) == 0 {
+		fmt.Println("📭 No jobs need refreshing")
+		return nil
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	ctx, cancel := s.newBrowserContext()
+	defer cancel()
+
+	if err := s.login(ctx); err != nil {
+		return fmt.Errorf("login failed: %w", err)
+	}
+	fmt.Printf("✅ Ready to refresh %d jobs with %d workers!\n", len(jobs), workers)
+
+	jobsCh := make(chan *models.JobPosting)
+	resultsCh := make(chan refreshResult)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// Each worker gets its own tab in the logged-in browser
+			tabCtx, tabCancel := chromedp.NewContext(ctx)
+			defer tabCancel()
+
+			for job := range jobsCh {
+				status, err := s.checkJobStatus(tabCtx, job)
+				resultsCh <- refreshResult{job: job, status: status, err: err}
+				time.Sleep(time.Duration(s.config.Scraper.DelayBetweenRequests) * time.Second)
+			}
+		}()
+	}
+
+	// Closed when the session expires, so the workers get no more jobs
+	stop := make(chan struct{})
+	go func() {
+	feed:
+		for i := range jobs {
+			select {
+			case jobsCh <- &jobs[i]:
+			case <-stop:
+				break feed
+			}
+		}
+		close(jobsCh)
+		wg.Wait()
+		close(resultsCh)
+	}()
+
+	checkedCount := 0
+	closedCount := 0
+	failedCount := 0
+	now := time.Now()
+	var authErr error
+
+	for result := range resultsCh {
+		job := result.job
+		if authErr != nil {
+			// Jobs in flight when the session expired are left for the next refresh
+			continue
+		}
+		if errors.Is(result.err, errAuthRequired) {
+			authErr = result.err
+			close(stop)
+			continue
+		}
+		if result.err != nil {
+			fmt.Printf("❌ Failed to refresh job %d (%s): %v\n", job.LinkedInJobID, job.Title, result.err)
+			failedCount++
+			continue
+		}
+
+		closedBefore := job.JobPostClosedDate != nil
+		changed := applyJobStatus(job, result.status, now)
+
+		// Every refresh is recorded, changed or not, so the history shows when a job was checked
+		if err := s.dataService.RecordJobSnapshot(refreshSnapshot(job, result.status, now)); err != nil {
+			logrus.Warnf("⚠️  Failed to record snapshot for job %d: %v", job.LinkedInJobID, err)
+		}
+
+		if !changed {
+			checkedCount++
+			logrus.Debugf("✅ Job %d unchanged", job.LinkedInJobID)
+			continue
+		}
+
+		if err := s.dataService.UpdateJob(job); err != nil {
+			fmt.Printf("❌ Failed to save refreshed job %d: %v\n", job.LinkedInJobID, err)
+			failedCount++
+			continue
+		}
+
+		checkedCount++
+		if !closedBefore && job.JobPostClosedDate != nil {
+			closedCount++
+			fmt.Printf("🔒 Job closed: %s (ID: %d) - %s\n", job.Title, job.LinkedInJobID, result.status.StatusText)
+		} else if job.Applicants != nil {
+			logrus.Debugf("👥 Job %d now has %d applicants", job.LinkedInJobID, *job.Applicants)
+		}
+	}
+
+	if authErr != nil {
+		fmt.Printf("\n⛔ Job refresh stopped! Checked: %d, Closed: %d, Failed: %d\n", checkedCount, closedCount, failedCount)
+		return fmt.Errorf("refresh stopped: %w", authErr)
+	}
+	fmt.Printf("\n🎉 Job refresh completed! Checked: %d, Closed: %d, Failed: %d\n", checkedCount, closedCount, failedCount)
+	return nil
+}
+
+// checkJobStatus revisits a job posting and reports whether it is closed or removed
+func (s *LinkedInScraper) checkJobStatus(ctx context.Context, job *models.JobPosting) (*jobStatus, error) {
+	navCtx, navCancel := context.WithTimeout(ctx, 15*time.Second)
+	defer navCancel()
+
+	jobURL := fmt.Sprintf("https://www.linkedin.com/jobs/view/%d/", job.LinkedInJobID)
+	err := chromedp.Run(navCtx,
+		chromedp.Navigate(jobURL),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to navigate to job page: %w", err)
+	}
+
+	if waitErr := smartWaitForCondition(ctx, `
+		document.querySelector('.job-details-jobs-unified-top-card__job-title') !== null ||
+		document.querySelector('.jobs-unified-top-card__job-title') !== null ||
+		document.querySelector('.error-page') !== null ||
+		!window.location.pathname.includes('/jobs/view/')
+	`, 10*time.Second); waitErr != nil {
+		logrus.Debugf("Job page %d did not finish rendering: %v", job.LinkedInJobID, waitErr)
+	}
+
+	var statusData map[string]interface{}
+	evalCtx, evalCancel := context.WithTimeout(ctx, 15*time.Second)
+	defer evalCancel()
+
+	err = chromedp.Run(evalCtx,
+		chromedp.Evaluate(s.buildJobStatusScript(), &statusData),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("JavaScript status check failed: %w", err)
+	}
+
+	return jobStatusFromData(statusData)
+}
+
+// jobStatusFromData converts the job status script result to a jobStatus. Landing on a login
+// page returns errAuthRequired; landing anywhere else but the job without an expired or not
+// found message is an error too, as it says nothing about the job.
+func jobStatusFromData(data map[string]interface{}) (*jobStatus, error) {
+	status := &jobStatus{
+		StatusText:  getString(data, "statusText"),
+		Applicants:  parseApplicantsCount(getString(data, "applicantsText")),
+		Title:       getString(data, "title"),
+		Description: getString(data, "description"),
+		WorkType:    getString(data, "workType"),
+	}
+	status.Closed, _ = data["closed"].(bool)
+	status.Removed, _ = data["removed"].(bool)
+
+	landedOn := getString(data, "url")
+	path := landedOn
+	if u, err := url.Parse(landedOn); err == nil {
+		path = u.Path
+	}
+	for _, authPath := range authPaths {
+		if strings.HasPrefix(path, authPath) {
+			return nil, fmt.Errorf("%w: redirected to %s", errAuthRequired, landedOn)
+		}
+	}
+	if !status.Removed && !strings.Contains(path, "/jobs/view/") {
+		return nil, fmt.Errorf("redirected to %s", landedOn)
+	}
+	return status, nil
+}
+
+// applyJobStatus updates a job from its refreshed status and reports whether anything changed.
+// A closed or removed job gets now as its closed date unless it already has one.
+func applyJobStatus(job *models.JobPosting, status *jobStatus, now time.Time) bool {
+	changed := false
+
+	if (status.Closed || status.Removed) && job.JobPostClosedDate == nil {
+		closedDate := now
+		job.JobPostClosedDate = &closedDate
+		changed = true
+	}
+
+	if status.Applicants != nil && (job.Applicants == nil || *job.Applicants != *status.Applicants) {
+		applicants := *status.Applicants
+		job.Applicants = &applicants
+		changed = true
+	}
+
+	return changed
+}
@@ -0,0 +1,86 @@
+package scraper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+func TestApplyJobStatus(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.AddDate(0, 0, -3)
+
+	tests := []struct {
+		name           string
+		job            models.JobPosting
+		status         jobStatus
+		changed        bool
+		closedDate     *time.Time
+		wantApplicants *int
+	}{
+		{"still open, same applicants", models.JobPosting{Applicants: intPtr(10)}, jobStatus{Applicants: intPtr(10)}, false, nil, intPtr(10)},
+		{"still open, more applicants", models.JobPosting{Applicants: intPtr(10)}, jobStatus{Applicants: intPtr(25)}, true, nil, intPtr(25)},
+		{"closed", models.JobPosting{}, jobStatus{Closed: true}, true, &now, nil},
+		{"removed", models.JobPosting{}, jobStatus{Removed: true}, true, &now, nil},
+		{"already closed keeps date", models.JobPosting{JobPostClosedDate: &earlier}, jobStatus{Closed: true}, false, &earlier, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := tt.job
+			if changed := applyJobStatus(&job, &tt.status, now); changed != tt.changed {
+				t.Errorf("applyJobStatus() changed = %v, expected %v", changed, tt.changed)
+			}
+			if (job.JobPostClosedDate == nil) != (tt.closedDate == nil) ||
+				(job.JobPostClosedDate != nil && !job.JobPostClosedDate.Equal(*tt.closedDate)) {
+				t.Errorf("closed date = %v, expected %v", job.JobPostClosedDate, tt.closedDate)
+			}
+			if (job.Applicants == nil) != (tt.wantApplicants == nil) ||
+				(job.Applicants != nil && *job.Applicants != *tt.wantApplicants) {
+				t.Errorf("applicants = %v, expected %v", job.Applicants, tt.wantApplicants)
+			}
+		})
+	}
+}
+
+func TestJobStatusFromData(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        map[string]interface{}
+		wantAuth    bool
+		wantErr     bool
+		wantRemoved bool
+		wantClosed  bool
+	}{
+		{"open", map[string]interface{}{"url": "https://www.linkedin.com/jobs/view/123/"}, false, false, false, false},
+		{"closed", map[string]interface{}{"url": "https://www.linkedin.com/jobs/view/123/", "closed": true}, false, false, false, true},
+		{"expired message", map[string]interface{}{"url": "https://www.linkedin.com/jobs/view/123/", "removed": true}, false, false, true, false},
+		{"not found message after a redirect", map[string]interface{}{"url": "https://www.linkedin.com/jobs/search/?currentJobId=123", "removed": true}, false, false, true, false},
+		{"login redirect", map[string]interface{}{"url": "https://www.linkedin.com/login?session_redirect=%2Fjobs%2Fview%2F123"}, true, true, false, false},
+		{"authwall redirect", map[string]interface{}{"url": "https://www.linkedin.com/authwall?trk=jobs"}, true, true, false, false},
+		{"checkpoint redirect", map[string]interface{}{"url": "https://www.linkedin.com/checkpoint/challenge/abc"}, true, true, false, false},
+		{"uas redirect", map[string]interface{}{"url": "https://www.linkedin.com/uas/login"}, true, true, false, false},
+		{"auth redirect wins over markers", map[string]interface{}{"url": "https://www.linkedin.com/authwall", "removed": true}, true, true, false, false},
+		{"redirect without a marker", map[string]interface{}{"url": "https://www.linkedin.com/jobs/search/"}, false, true, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := jobStatusFromData(tt.data)
+			if errors.Is(err, errAuthRequired) != tt.wantAuth {
+				t.Errorf("jobStatusFromData() error = %v, expected auth error %v", err, tt.wantAuth)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("jobStatusFromData() error = %v, expected error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if status.Removed != tt.wantRemoved || status.Closed != tt.wantClosed {
+				t.Errorf("jobStatusFromData() = %+v, expected removed %v, closed %v", status, tt.wantRemoved, tt.wantClosed)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package scraper
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// relativeDateUnit is a unit of a relative date like "2 uger siden"
+type relativeDateUnit int
+
+const (
+	unitMinute relativeDateUnit = iota
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitYear
+)
+
+// relativeDatePatterns match "<amount> <unit> ago" in each language LinkedIn shows us.
+// The first group is the amount (digits or a number word), the second the unit word.
+var relativeDatePatterns = []struct {
+	language string
+	pattern  *regexp.Regexp
+}{
+	{"da", regexp.MustCompile(`(\d+|en|et|én|to|tre)\s+(minutter|minut|timer|time|dage|dag|uger|uge|måneder|måned|år)\s+siden`)},
+	{"no", regexp.MustCompile(`(\d+|en|ei|ett|to|tre)\s+(minutter|minutt|timer|time|dager|dag|uker|uke|måneder|måned|år)\s+siden`)},
+	{"sv", regexp.MustCompile(`(\d+|en|ett|två|tre)\s+(minuter|minut|timmar|timme|dagar|dag|veckor|vecka|månader|månad|år)\s+sedan`)},
+	{"en", regexp.MustCompile(`(\d+|an|a|one|two|three)\s+(minutes|minute|mins|min|hours|hour|hrs|hr|days|day|weeks|week|months|month|years|year|yrs|yr)\s+ago`)},
+	{"de", regexp.MustCompile(`vor\s+(\d+|einer|einem|einen|eins|zwei|drei)\s+(minuten|minute|stunden|stunde|tagen|tag|wochen|woche|monaten|monat|jahren|jahr)`)},
+}
+
+// relativeDateUnits maps the unit words of all languages to a unit
+var relativeDateUnits = map[string]relativeDateUnit{
+	// Minutes
+	"minut": unitMinute, "minutter": unitMinute, "minutt": unitMinute, "minuter": unitMinute,
+	"minute": unitMinute, "minutes": unitMinute, "min": unitMinute, "mins": unitMinute, "minuten": unitMinute,
+	// Hours
+	"time": unitHour, "timer": unitHour, "timme": unitHour, "timmar": unitHour,
+	"hour": unitHour, "hours": unitHour, "hr": unitHour, "hrs": unitHour, "stunde": unitHour, "stunden": unitHour,
+	// Days
+	"dag": unitDay, "dage": unitDay, "dager": unitDay, "dagar": unitDay,
+	"day": unitDay, "days": unitDay, "tag": unitDay, "tagen": unitDay,
+	// Weeks
+	"uge": unitWeek, "uger": unitWeek, "uke": unitWeek, "uker": unitWeek, "vecka": unitWeek, "veckor": unitWeek,
+	"week": unitWeek, "weeks": unitWeek, "woche": unitWeek, "wochen": unitWeek,
+	// Months
+	"måned": unitMonth, "måneder": unitMonth, "månad": unitMonth, "månader": unitMonth,
+	"month": unitMonth, "months": unitMonth, "monat": unitMonth, "monaten": unitMonth,
+	// Years
+	"år": unitYear, "year": unitYear, "years": unitYear, "yr": unitYear, "yrs": unitYear, "jahr": unitYear, "jahren": unitYear,
+}
+
+// numberWords maps number words used instead of digits, e.g. "en uge siden", "an hour ago", "vor einer Woche"
+var numberWords = map[string]int{
+	"en": 1, "et": 1, "én": 1, "ei": 1, "ett": 1, "a": 1, "an": 1, "one": 1,
+	"einer": 1, "einem": 1, "einen": 1, "eins": 1,
+	"to": 2, "två": 2, "two": 2, "zwei": 2,
+	"tre": 3, "three": 3, "drei": 3,
+}
+
+// justNowPhrases mean the job was posted moments ago
+var justNowPhrases = []string{"just now", "lige nu", "akkurat nå", "just nu", "nyss", "gerade eben", "soeben"}
+
+// repostedMarkers prefix the date of a posting that was closed and posted again,
+// e.g. "Reposted 2 weeks ago" or "Genopslået for 2 uger siden"
+var repostedMarkers = []string{
+	"reposted", "genopslået", "slået op igen", "publisert på nytt", "publicerades igen", "publicerad igen",
+	"erneut veröffentlicht", "erneut gepostet",
+}
+
+// promotedMarkers flag sponsored postings, which LinkedIn shows instead of or beside the date
+var promotedMarkers = []string{"promoted", "promoveret", "fremhævet", "promotert", "marknadsförd", "gesponsert", "beworben"}
+
+// postedText is the result of parsing a posting-date text like "Reposted 2 weeks ago"
+type postedText struct {
+	Posted   *time.Time
+	Reposted bool
+	Promoted bool
+}
+
+// parsePostedText parses a relative posting date in Danish, English, Swedish, Norwegian or German,
+// along with "reposted" and "promoted" markers, relative to now
+func parsePostedText(text string, now time.Time) postedText {
+	text = strings.ToLower(strings.TrimSpace(text))
+	result := postedText{
+		Reposted: containsAny(text, repostedMarkers),
+		Promoted: containsAny(text, promotedMarkers),
+	}
+
+	if containsAny(text, justNowPhrases) {
+		result.Posted = &now
+		return result
+	}
+
+	for _, language := range relativeDatePatterns {
+		matches := language.pattern.FindStringSubmatch(text)
+		if matches == nil {
+			continue
+		}
+
+		amount, ok := numberWords[matches[1]]
+		if !ok {
+			var err error
+			if amount, err = strconv.Atoi(matches[1]); err != nil {
+				continue
+			}
+		}
+
+		posted := subtractRelative(now, amount, relativeDateUnits[matches[2]])
+		result.Posted = &posted
+		return result
+	}
+
+	return result
+}
+
+// parseRelativeDate parses a relative posting date like "2 uger siden" or "3 hours ago"
+func parseRelativeDate(dateStr string) *time.Time {
+	return parsePostedText(dateStr, time.Now()).Posted
+}
+
+// subtractRelative returns the time amount units before now
+func subtractRelative(now time.Time, amount int, unit relativeDateUnit) time.Time {
+	switch unit {
+	case unitMinute:
+		return now.Add(-time.Duration(amount) * time.Minute)
+	case unitHour:
+		return now.Add(-time.Duration(amount) * time.Hour)
+	case unitWeek:
+		return now.AddDate(0, 0, -amount*7)
+	case unitMonth:
+		return now.AddDate(0, -amount, 0)
+	case unitYear:
+		return now.AddDate(-amount, 0, 0)
+	default:
+		return now.AddDate(0, 0, -amount)
+	}
+}
+
+func containsAny(text string, phrases []string) bool {
+	for _, phrase := range phrases {
+		if strings.Contains(text, phrase) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,265 @@
+package scraper
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// salaryInfo holds a salary range normalised from free text
+type salaryInfo struct {
+	Min          float64
+	Max          float64
+	Currency     string
+	Period       string
+	AnnualMinDKK *int
+	AnnualMaxDKK *int
+}
+
+const currencyToken = `dkk|eur|usd|gbp|sek|nok|chf|kr\.?|€|\$|£`
+const amountToken = `\d{1,3}(?:[.,  ]\d{3})+(?:,\d{1,2})?|\d+(?:[.,]\d{1,2})?`
+const multiplierToken = `k\b|t\.?\s?kr\.?|tusind|mio\.?|million`
+
+// salaryPattern matches a single amount or a range with a currency on either side, e.g.
+// "kr. 55.000", "DKK 650k/year", "€70,000–€85,000", "600.000 - 700.000 kr."
+var salaryPattern = regexp.MustCompile(`(?i)(` + currencyToken + `)?\s?(` + amountToken + `)\s?(` + multiplierToken + `)?\s?(` + currencyToken + `)?` +
+	`(?:\s?/\s?[a-zæøå.]+)?` +
+	`(?:\s?(?:-|–|—|til|to)\s?(` + currencyToken + `)?\s?(` + amountToken + `)\s?(` + multiplierToken + `)?\s?(` + currencyToken + `)?)?`)
+
+// periodPatterns maps period keywords (Danish and English) to normalised salary periods.
+// Order matters: more specific phrases are checked first.
+var periodPatterns = []struct {
+	period   string
+	keywords []string
+}{
+	{models.SalaryPeriodHour, []string{"/hr", "/hour", "per hour", "an hour", "hourly", "pr. time", "pr time", "i timen", "timeløn", "/time", "/t."}},
+	{models.SalaryPeriodDay, []string{"/day", "per day", "a day", "daily", "pr. dag", "pr dag", "om dagen", "dagpenge"}},
+	{models.SalaryPeriodWeek, []string{"/wk", "/week", "per week", "a week", "weekly", "pr. uge", "pr uge", "om ugen"}},
+	{models.SalaryPeriodMonth, []string{"/mo", "/month", "per month", "a month", "monthly", "pr. måned", "pr måned", "pr. md", "pr md", "om måneden", "månedsløn", "/md", "/måned", "månedligt", "mdr"}},
+	{models.SalaryPeriodYear, []string{"/yr", "/year", "per year", "a year", "annual", "annually", "per annum", "p.a.", "pr. år", "pr år", "om året", "årsløn", "årligt", "/år"}},
+}
+
+// parseSalary parses a salary text like LinkedIn's salary insights ("kr.600K/yr - kr.800K/yr")
+func parseSalary(text string) *salaryInfo {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+
+	for _, match := range salaryPattern.FindAllStringSubmatchIndex(text, -1) {
+		info := salaryFromMatch(text, match)
+		if info == nil {
+			continue
+		}
+
+		// The whole text is about salary, so look for the period anywhere in it
+		info.Period = detectSalaryPeriod(strings.ToLower(text), info)
+		info.normalise()
+		return info
+	}
+
+	return nil
+}
+
+// parseSalaryFromDescription finds the first plausible salary mention in a job description
+func parseSalaryFromDescription(description string) *salaryInfo {
+	if description == "" {
+		return nil
+	}
+
+	for _, match := range salaryPattern.FindAllStringSubmatchIndex(description, -1) {
+		info := salaryFromMatch(description, match)
+		if info == nil {
+			continue
+		}
+
+		// Only look at the text right after the amount for a period keyword
+		windowEnd := minInt(len(description), match[1]+30)
+		window := strings.ToLower(description[match[0]:windowEnd])
+		info.Period = detectSalaryPeriod(window, info)
+		info.normalise()
+		return info
+	}
+
+	return nil
+}
+
+// salaryFromMatch builds a salaryInfo from a salaryPattern submatch, or nil if it doesn't look like a salary
+func salaryFromMatch(text string, match []int) *salaryInfo {
+	group := func(i int) string {
+		if match[2*i] < 0 {
+			return ""
+		}
+		return text[match[2*i]:match[2*i+1]]
+	}
+
+	currency := normaliseCurrency(firstNonEmpty(group(1), group(4), group(5), group(8)))
+	if currency == "" {
+		// Without a currency marker numbers are too ambiguous (dates, phone numbers, headcounts)
+		return nil
+	}
+
+	minAmount, ok := parseSalaryAmount(group(2), group(3))
+	if !ok {
+		return nil
+	}
+
+	maxAmount := minAmount
+	if group(6) != "" {
+		// "650-750k" carries the multiplier on the upper bound only
+		multiplier := firstNonEmpty(group(7), group(3))
+		if upper, ok := parseSalaryAmount(group(6), multiplier); ok {
+			if group(3) == "" && group(7) != "" {
+				minAmount, _ = parseSalaryAmount(group(2), group(7))
+			}
+			maxAmount = upper
+		}
+	}
+
+	if maxAmount < minAmount {
+		minAmount, maxAmount = maxAmount, minAmount
+	}
+
+	// Ignore tiny amounts like "kr. 5" which are never wages
+	if minAmount < 50 {
+		return nil
+	}
+
+	return &salaryInfo{
+		Min:      minAmount,
+		Max:      maxAmount,
+		Currency: currency,
+	}
+}
+
+// normalise fills the annualised DKK amounts
+func (si *salaryInfo) normalise() {
+	if minDKK, ok := models.AnnualiseToDKK(si.Min, si.Currency, si.Period); ok {
+		si.AnnualMinDKK = &minDKK
+	}
+	if maxDKK, ok := models.AnnualiseToDKK(si.Max, si.Currency, si.Period); ok {
+		si.AnnualMaxDKK = &maxDKK
+	}
+}
+
+// applyTo copies the salary fields onto a job posting
+func (si *salaryInfo) applyTo(job *models.JobPosting) {
+	minAmount, maxAmount := si.Min, si.Max
+	currency, period := si.Currency, si.Period
+
+	job.SalaryMin = &minAmount
+	job.SalaryMax = &maxAmount
+	job.SalaryCurrency = &currency
+	job.SalaryPeriod = &period
+	job.SalaryAnnualMinDKK = si.AnnualMinDKK
+	job.SalaryAnnualMaxDKK = si.AnnualMaxDKK
+}
+
+// detectSalaryPeriod finds a period keyword in text, falling back to a guess based on the amount
+func detectSalaryPeriod(text string, info *salaryInfo) string {
+	for _, candidate := range periodPatterns {
+		for _, keyword := range candidate.keywords {
+			if strings.Contains(text, keyword) {
+				return candidate.period
+			}
+		}
+	}
+
+	// No explicit period - guess from magnitude in DKK terms
+	rate := models.DKKExchangeRates[info.Currency]
+	amountDKK := info.Min * rate
+	switch {
+	case amountDKK < 2000:
+		return models.SalaryPeriodHour
+	case amountDKK < 150000:
+		return models.SalaryPeriodMonth
+	default:
+		return models.SalaryPeriodYear
+	}
+}
+
+// normaliseCurrency maps currency symbols and abbreviations to ISO 4217 codes
+func normaliseCurrency(token string) string {
+	token = strings.ToLower(strings.TrimSpace(token))
+	switch token {
+	case "":
+		return ""
+	case "kr", "kr.", "dkk":
+		// "kr" is also used for SEK/NOK, but we scrape Danish jobs so DKK is the safe default
+		return "DKK"
+	case "€", "eur":
+		return "EUR"
+	case "$", "usd":
+		return "USD"
+	case "£", "gbp":
+		return "GBP"
+	default:
+		return strings.ToUpper(token)
+	}
+}
+
+// parseSalaryAmount parses "55.000", "70,000", "650" + "k", "1,2" + "mio" into a number
+func parseSalaryAmount(amount, multiplier string) (float64, bool) {
+	amount = strings.NewReplacer(" ", "", " ", "").Replace(strings.TrimSpace(amount))
+	if amount == "" {
+		return 0, false
+	}
+
+	lastDot := strings.LastIndex(amount, ".")
+	lastComma := strings.LastIndex(amount, ",")
+
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		// Both separators present - the last one is the decimal separator
+		if lastComma > lastDot {
+			amount = strings.ReplaceAll(amount, ".", "")
+			amount = strings.Replace(amount, ",", ".", 1)
+		} else {
+			amount = strings.ReplaceAll(amount, ",", "")
+		}
+	case lastDot >= 0 || lastComma >= 0:
+		separator := "."
+		if lastComma >= 0 {
+			separator = ","
+		}
+		groups := strings.Split(amount, separator)
+		thousands := true
+		for _, g := range groups[1:] {
+			if len(g) != 3 {
+				thousands = false
+			}
+		}
+		if thousands {
+			amount = strings.Join(groups, "")
+		} else {
+			amount = strings.Replace(amount, separator, ".", 1)
+		}
+	}
+
+	value, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	switch m := strings.ToLower(strings.TrimSpace(multiplier)); {
+	case m == "":
+	case m == "k" || m == "tusind" || strings.HasPrefix(m, "t"):
+		value *= 1000
+	case strings.HasPrefix(m, "mio") || m == "million":
+		value *= 1000000
+	}
+
+	return value, true
+}
+
+// firstNonEmpty returns the first non-empty string
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
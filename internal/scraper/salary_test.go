@@ -0,0 +1,140 @@
+package scraper
+
+import (
+	"testing"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+func TestParseSalary(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		expectedMin  float64
+		expectedMax  float64
+		currency     string
+		period       string
+		annualMinDKK int
+	}{
+		{
+			name:         "Danish monthly salary",
+			input:        "kr. 55.000 pr. måned",
+			expectedMin:  55000,
+			expectedMax:  55000,
+			currency:     "DKK",
+			period:       models.SalaryPeriodMonth,
+			annualMinDKK: 660000,
+		},
+		{
+			name:         "DKK yearly with k suffix",
+			input:        "DKK 650k/year",
+			expectedMin:  650000,
+			expectedMax:  650000,
+			currency:     "DKK",
+			period:       models.SalaryPeriodYear,
+			annualMinDKK: 650000,
+		},
+		{
+			name:         "Euro range with en dash",
+			input:        "€70,000–€85,000",
+			expectedMin:  70000,
+			expectedMax:  85000,
+			currency:     "EUR",
+			period:       models.SalaryPeriodYear,
+			annualMinDKK: 522200,
+		},
+		{
+			name:         "LinkedIn salary insights pill",
+			input:        "kr.600K/yr - kr.800K/yr",
+			expectedMin:  600000,
+			expectedMax:  800000,
+			currency:     "DKK",
+			period:       models.SalaryPeriodYear,
+			annualMinDKK: 600000,
+		},
+		{
+			name:         "Danish range with currency after",
+			input:        "45.000 - 52.000 kr. om måneden",
+			expectedMin:  45000,
+			expectedMax:  52000,
+			currency:     "DKK",
+			period:       models.SalaryPeriodMonth,
+			annualMinDKK: 540000,
+		},
+		{
+			name:         "Hourly wage",
+			input:        "DKK 185 per hour",
+			expectedMin:  185,
+			expectedMax:  185,
+			currency:     "DKK",
+			period:       models.SalaryPeriodHour,
+			annualMinDKK: 355940,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parseSalary(tt.input)
+			if result == nil {
+				t.Fatalf("parseSalary(%q) returned nil", tt.input)
+			}
+			if result.Min != tt.expectedMin || result.Max != tt.expectedMax {
+				t.Errorf("parseSalary(%q) range = %v-%v, expected %v-%v", tt.input, result.Min, result.Max, tt.expectedMin, tt.expectedMax)
+			}
+			if result.Currency != tt.currency {
+				t.Errorf("parseSalary(%q) currency = %q, expected %q", tt.input, result.Currency, tt.currency)
+			}
+			if result.Period != tt.period {
+				t.Errorf("parseSalary(%q) period = %q, expected %q", tt.input, result.Period, tt.period)
+			}
+			if result.AnnualMinDKK == nil || *result.AnnualMinDKK != tt.annualMinDKK {
+				t.Errorf("parseSalary(%q) annual min DKK = %v, expected %d", tt.input, result.AnnualMinDKK, tt.annualMinDKK)
+			}
+		})
+	}
+}
+
+func TestParseSalaryFromDescription(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectsInfo bool
+		expectedMin float64
+	}{
+		{
+			name:        "Salary in Danish description",
+			input:       "Vi tilbyder en løn på kr. 48.000 pr. måned plus pension og frokostordning.",
+			expectsInfo: true,
+			expectedMin: 48000,
+		},
+		{
+			name:        "Salary in English description",
+			input:       "The base salary for this role is DKK 700k per year depending on experience.",
+			expectsInfo: true,
+			expectedMin: 700000,
+		},
+		{
+			name:        "Numbers without currency",
+			input:       "Founded in 2012, we are 250 people across 3 offices.",
+			expectsInfo: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parseSalaryFromDescription(tt.input)
+			if !tt.expectsInfo {
+				if result != nil {
+					t.Errorf("parseSalaryFromDescription(%q) = %+v, expected nil", tt.input, result)
+				}
+				return
+			}
+			if result == nil {
+				t.Fatalf("parseSalaryFromDescription(%q) returned nil", tt.input)
+			}
+			if result.Min != tt.expectedMin {
+				t.Errorf("parseSalaryFromDescription(%q) min = %v, expected %v", tt.input, result.Min, tt.expectedMin)
+			}
+		})
+	}
+}
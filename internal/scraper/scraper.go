@@ -2,9 +2,11 @@ package scraper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"linkedin-job-scraper/internal/config"
 	"linkedin-job-scraper/internal/services"
+	"linkedin-job-scraper/internal/skills"
 	"os"
 	"strconv"
 	"strings"
@@ -16,13 +18,20 @@ import (
 type LinkedInScraper struct {
 	config      *config.Config
 	dataService *services.DataService
+	skills      *skills.Extractor // Nil if the taxonomy couldn't be loaded
 }
 
 // NewLinkedInScraper creates a new LinkedIn scraper
 func NewLinkedInScraper(cfg *config.Config, dataService *services.DataService) *LinkedInScraper {
+	taxonomy, err := skills.LoadTaxonomy(cfg.Skills.TaxonomyPath)
+	if err != nil {
+		fmt.Printf("⚠️  Skills will not be normalised: %v\n", err)
+	}
+
 	return &LinkedInScraper{
 		config:      cfg,
 		dataService: dataService,
+		skills:      skills.NewExtractor(taxonomy),
 	}
 }
 
@@ -94,11 +103,11 @@ func (s *LinkedInScraper) ScrapeJobs(keywords, location string, totalJobs int) e
 		fmt.Println("📝 Continuing without preload - will check individual jobs via API")
 	}
 
-	// Preload existing company names to Redis cache for faster lookup
-	fmt.Println("🔄 Preloading existing company names to cache...")
-	if err := s.dataService.PreloadCompanyNamesToCache(); err != nil {
-		fmt.Printf("⚠️  Failed to preload company names to cache: %v\n", err)
-		fmt.Println("📝 Continuing without company preload - will check individual companies via API")
+	// Preload company aliases so merged company names resolve without a lookup
+	fmt.Println("🔄 Preloading company aliases to cache...")
+	if err := s.dataService.PreloadCompanyAliasesToCache(); err != nil {
+		fmt.Printf("⚠️  Failed to preload company aliases to cache: %v\n", err)
+		fmt.Println("📝 Continuing without alias preload - will check individual companies in the store")
 	}
 
 	// Dynamic pagination based on job URLs FOUND on LinkedIn (not jobs saved to DB)
@@ -135,6 +144,9 @@ func (s *LinkedInScraper) ScrapeJobs(keywords, location string, totalJobs int) e
 		// Log page results
 		fmt.Printf("📄 Page %d: Found %d jobs, Saved %d new jobs, Skipped %d existing jobs\n",
 			page, pageResult.TotalJobsFound, pageResult.JobsSaved, pageResult.JobsSkipped)
+		if pageResult.JobsConflicted > 0 {
+			fmt.Printf("⏭️  Skipped %d jobs whose company name belongs to another LinkedIn company\n", pageResult.JobsConflicted)
+		}
 		fmt.Printf("📊 Progress: %d/%d jobs saved (%.1f%%)\n",
 			totalJobsSaved, totalJobs, float64(totalJobsSaved)/float64(totalJobs)*100)
 
@@ -156,6 +168,7 @@ type PageResult struct {
     TotalJobsFound int // Number of job URLs found on the page
     JobsSaved      int // Number of jobs actually saved to database
     JobsSkipped    int // Number of jobs skipped (already exist)
+    JobsConflicted int // Number of jobs skipped because another LinkedIn company has their company's name
 }
 
 // scrapePageWithDetails scrapes a page and returns detailed results
@@ -193,7 +206,7 @@ func (s *LinkedInScraper) scrapePageWithDetails(ctx context.Context, pageURL str
 	fmt.Printf("🆕 Processing %d new jobs...\n", len(newJobURLs))
 
 	// Process new jobs with logging
-	result.JobsSaved = s.processNewJobs(ctx, newJobURLs)
+	result.JobsSaved, result.JobsConflicted = s.processNewJobs(ctx, newJobURLs)
 
 	return result, nil
 }
@@ -237,13 +250,15 @@ func (s *LinkedInScraper) isJobNew(jobURL string) bool {
 	return !exists
 }
 
-// processNewJobs scrapes and saves new jobs with logging
-func (s *LinkedInScraper) processNewJobs(ctx context.Context, jobURLs []string) int {
+// processNewJobs scrapes and saves new jobs with logging. Returns the number of jobs saved
+// and the number skipped because of a company name conflict.
+func (s *LinkedInScraper) processNewJobs(ctx context.Context, jobURLs []string) (int, int) {
 	if len(jobURLs) == 0 {
-		return 0
+		return 0, 0
 	}
 
 	savedCount := 0
+	conflictCount := 0
 
 	for _, jobURL := range jobURLs {
 		job, err := s.scrapeJobDetails(ctx, jobURL)
@@ -252,7 +267,12 @@ func (s *LinkedInScraper) processNewJobs(ctx context.Context, jobURLs []string)
 			continue
 		}
 
-		if err := s.saveJob(job); err != nil {
+		if err := s.saveJob(ctx, job); err != nil {
+			if errors.Is(err, services.ErrCompanyNameConflict) {
+				fmt.Printf("⏭️  Skipped job %d: %v\n", job.LinkedInJobID, err)
+				conflictCount++
+				continue
+			}
 			fmt.Printf("❌ Failed to save job: %v\n", err)
 			continue
 		}
@@ -261,7 +281,7 @@ func (s *LinkedInScraper) processNewJobs(ctx context.Context, jobURLs []string)
 		fmt.Printf("✅ Saved job: %s (ID: %d)\n", job.Title, job.JobID)
 	}
 
-	return savedCount
+	return savedCount, conflictCount
 }
 
 // DiscoverJobIDs discovers new job IDs and stores them in Redis queue (no detailed scraping)
@@ -446,15 +466,16 @@ func (s *LinkedInScraper) ProcessJobsFromQueue(limit int) error {
 	}
 	fmt.Println("✅ Ready to process jobs from queue!")
 
-	// Preload company names for faster processing
-	fmt.Println("🔄 Preloading existing company names to cache...")
-	if err := s.dataService.PreloadCompanyNamesToCache(); err != nil {
-		fmt.Printf("⚠️  Failed to preload company names to cache: %v\n", err)
-		fmt.Println("📝 Continuing without company preload")
+	// Preload company aliases for faster processing
+	fmt.Println("🔄 Preloading company aliases to cache...")
+	if err := s.dataService.PreloadCompanyAliasesToCache(); err != nil {
+		fmt.Printf("⚠️  Failed to preload company aliases to cache: %v\n", err)
+		fmt.Println("📝 Continuing without alias preload")
 	}
 
 	processedCount := 0
 	failedCount := 0
+	conflictCount := 0
 
 	for processedCount < limit {
 		// Get next job from queue
@@ -476,17 +497,23 @@ func (s *LinkedInScraper) ProcessJobsFromQueue(limit int) error {
 		if err != nil {
 			fmt.Printf("❌ Failed to scrape job details for ID %s: %v\n", jobID, err)
 			failedCount++
-			// Remove failed job from queue
-			s.dataService.RemoveJobFromQueue(jobID)
+			// Mark failed job in queue
+			s.dataService.FailJobInQueue(jobID)
 			continue
 		}
 
 		// Save job to database via API
-		if err := s.saveJob(job); err != nil {
+		if err := s.saveJob(ctx, job); errors.Is(err, services.ErrCompanyNameConflict) {
+			// Retrying won't help until the store allows two companies with the same name
+			fmt.Printf("⏭️  Skipped job ID %s: %v\n", jobID, err)
+			conflictCount++
+			s.dataService.RemoveJobFromQueue(jobID)
+			continue
+		} else if err != nil {
 			fmt.Printf("❌ Failed to save job ID %s: %v\n", jobID, err)
 			failedCount++
-			// Remove failed job from queue
-			s.dataService.RemoveJobFromQueue(jobID)
+			// Mark failed job in queue
+			s.dataService.FailJobInQueue(jobID)
 			continue
 		}
 
@@ -500,7 +527,8 @@ func (s *LinkedInScraper) ProcessJobsFromQueue(limit int) error {
 			job.Title, job.JobID, processedCount, limit)
 	}
 
-	fmt.Printf("\n🎉 Job processing completed! Processed: %d, Failed: %d\n", processedCount, failedCount)
+	fmt.Printf("\n🎉 Job processing completed! Processed: %d, Failed: %d, Skipped (company name conflict): %d\n",
+		processedCount, failedCount, conflictCount)
 	return nil
 }
 
@@ -509,7 +537,7 @@ func (s *LinkedInScraper) RescrapeFromQueue(limit int) error {
 	return fmt.Errorf("RescrapeFromQueue is disabled - database functionality replaced with API")
 }
 
-// CheckJobClosureStatus is disabled - use API instead
+// CheckJobClosureStatus checks all open jobs for closure in a single browser tab
 func (s *LinkedInScraper) CheckJobClosureStatus(limit int) error {
-	return fmt.Errorf("CheckJobClosureStatus is disabled - database functionality replaced with API")
+	return s.RefreshJobs(0, limit, 1)
 }
@@ -99,10 +99,15 @@ func (s *LinkedInScraper) buildJobExtractionScript() string {
 				title: getTitleText(),
 				company: getCompanyText(),
 				companyImageUrl: getCompanyImageUrl(),
+				companyUrl: getCompanyUrl(),
+				companyLinkedinId: getCompanyLinkedInId(),
 				location: getLocationData(),
 				description: getDescriptionText(),
 				applyUrl: getApplyUrl(),
-				postedDate: getPostedDate()
+				postedDate: getPostedDate(),
+				postedTimestamp: getPostedTimestamp(),
+				salary: getSalaryText(),
+				criteria: getJobCriteria()
 			};
 			
 			// Execute skills extraction
@@ -113,11 +118,16 @@ func (s *LinkedInScraper) buildJobExtractionScript() string {
 				title: jobDetails.title,
 				company: jobDetails.company,
 				companyImageUrl: jobDetails.companyImageUrl,
+				companyUrl: jobDetails.companyUrl,
+				companyLinkedinId: jobDetails.companyLinkedinId,
 				location: jobDetails.location,
 				description: jobDetails.description,
 				applyUrl: jobDetails.applyUrl,
 				workType: workTypeAndSkills.workType,
-				skills: workTypeAndSkills.skills
+				skills: workTypeAndSkills.skills,
+				salary: jobDetails.salary,
+				criteria: jobDetails.criteria,
+				postedTimestamp: jobDetails.postedTimestamp
 			};
 			
 			console.log('=== EXTRACTION COMPLETE ===');
@@ -203,6 +213,50 @@ func (s *LinkedInScraper) buildClickInsightsScript() string {
 	return utilsScriptContent + "\n" + script
 }
 
+// buildCompanyAboutScript builds script for extracting company details from its About page
+func (s *LinkedInScraper) buildCompanyAboutScript() string {
+	utilsScriptContent, err := loadScript(utilsScript)
+	if err != nil {
+		return `({ name: document.title })`
+	}
+
+	script, err := loadTypeScript("company_about.ts")
+	if err != nil {
+		return `({ name: document.title })`
+	}
+
+	return utilsScriptContent + "\n" + script
+}
+
+// buildJobStatusScript builds script for checking whether a job posting is still open.
+// The job details and skills functions are included so the current title, description
+// and work type can be recorded in the job's history.
+func (s *LinkedInScraper) buildJobStatusScript() string {
+	fallback := `({ url: window.location.href, closed: false, removed: false, statusText: '', applicantsText: '', title: '', description: '', workType: '' })`
+
+	utilsScriptContent, err := loadScript(utilsScript)
+	if err != nil {
+		return fallback
+	}
+
+	jobDetailsScript, err := loadScript("job_details.js")
+	if err != nil {
+		return fallback
+	}
+
+	skillsScript, err := loadScript("skills.js")
+	if err != nil {
+		return fallback
+	}
+
+	script, err := loadTypeScript("job_status.ts")
+	if err != nil {
+		return fallback
+	}
+
+	return utilsScriptContent + "\n" + jobDetailsScript + "\n" + skillsScript + "\n" + script
+}
+
 // buildIsLoggedInScript builds script for checking login status
 func (s *LinkedInScraper) buildIsLoggedInScript() string {
 	utilsScriptContent, err := loadScript(utilsScript)
@@ -305,7 +359,8 @@ func (s *LinkedInScraper) buildFallbackJobExtractionScript() string {
 				description: '',
 				applyUrl: window.location.href,
 				workType: '',
-				skills: []
+				skills: [],
+				salary: ''
 			};
 		})();
 	`
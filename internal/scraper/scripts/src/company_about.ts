@@ -0,0 +1,93 @@
+/// <reference path="types.ts" />
+/// <reference path="utils.ts" />
+
+// Extract company details from a LinkedIn company "About" page
+(function(): CompanyAbout {
+    console.log('=== EXTRACTING COMPANY ABOUT DATA ===');
+
+    const result: CompanyAbout = {
+        name: '',
+        linkedinCompanyId: '',
+        website: '',
+        industry: '',
+        companySize: '',
+        headquarters: '',
+        followers: ''
+    };
+
+    const nameElem = Utils.safeQuery<HTMLElement>('h1.org-top-card-summary__title, h1');
+    if (nameElem && nameElem.innerText) {
+        result.name = nameElem.innerText.trim();
+    }
+
+    // The overview is rendered as a definition list of label/value pairs
+    const labels: { key: keyof CompanyAbout; patterns: string[] }[] = [
+        { key: 'website', patterns: ['website', 'websted', 'hjemmeside'] },
+        { key: 'industry', patterns: ['industry', 'branche'] },
+        { key: 'companySize', patterns: ['company size', 'virksomhedsstørrelse', 'størrelse'] },
+        { key: 'headquarters', patterns: ['headquarters', 'hovedkontor', 'hovedsæde'] }
+    ];
+
+    const terms = Utils.safeQueryAll<HTMLElement>('dl dt');
+    if (terms) {
+        for (const term of Array.from(terms)) {
+            const label = (term.innerText || '').trim().toLowerCase();
+            const value = term.nextElementSibling as HTMLElement | null;
+            if (!value) {
+                continue;
+            }
+            for (const candidate of labels) {
+                if (!result[candidate.key] && candidate.patterns.some(p => label.includes(p))) {
+                    const link = value.querySelector<HTMLAnchorElement>('a[href]');
+                    result[candidate.key] = candidate.key === 'website' && link ? link.href : (value.innerText || '').trim();
+                    console.log('🏢 Found', candidate.key, ':', result[candidate.key]);
+                }
+            }
+        }
+    }
+
+    // Follower count is part of the top card summary ("12,345 followers")
+    const summaryItems = Utils.safeQueryAll<HTMLElement>('.org-top-card-summary-info-list__info-item, .org-top-card-summary__follower-count');
+    if (summaryItems) {
+        for (const item of Array.from(summaryItems)) {
+            const text = (item.innerText || '').trim();
+            if (/followers|følgere/i.test(text)) {
+                result.followers = text;
+                break;
+            }
+        }
+    }
+
+    // The numeric company ID appears in entity URNs and in embedded code blocks
+    const urnPattern = /urn:li:(?:fsd_company|company|organization):(\d+)/;
+    const urnElem = Utils.safeQuery<HTMLElement>('[data-entity-urn*="company:"], [data-urn*="company:"]');
+    if (urnElem) {
+        const urn = urnElem.getAttribute('data-entity-urn') || urnElem.getAttribute('data-urn') || '';
+        const match = urn.match(urnPattern);
+        if (match) {
+            result.linkedinCompanyId = match[1];
+        }
+    }
+    if (!result.linkedinCompanyId) {
+        const codeBlocks = Utils.safeQueryAll<HTMLElement>('code');
+        if (codeBlocks) {
+            for (const block of Array.from(codeBlocks)) {
+                const match = (block.textContent || '').match(urnPattern);
+                if (match) {
+                    result.linkedinCompanyId = match[1];
+                    break;
+                }
+            }
+        }
+    }
+    if (!result.linkedinCompanyId) {
+        const jobsLink = Utils.safeQuery<HTMLAnchorElement>('a[href*="f_C="]');
+        const match = jobsLink ? jobsLink.href.match(/f_C=(\d+)/) : null;
+        if (match) {
+            result.linkedinCompanyId = match[1];
+        }
+    }
+
+    console.log('Final company about data:', result);
+    return result;
+})();
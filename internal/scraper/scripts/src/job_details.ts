@@ -95,6 +95,63 @@ const getCompanyText = function(): string {
     return '';
 };
 
+// Company LinkedIn page URL extraction
+const getCompanyUrl = function(): string {
+    const selectors = [
+        'a.topcard__org-name-link',
+        'span.topcard__flavor-row > a',
+        '.job-details-jobs-unified-top-card__company-name a',
+        '.jobs-unified-top-card__company-name a',
+        '.job-details-jobs-unified-top-card__company-logo a',
+        'a[data-tracking-control-name="public_jobs_topcard-org-name"]'
+    ];
+    for (const sel of selectors) {
+        const elem = Utils.safeQuery<HTMLAnchorElement>(sel);
+        if (elem && elem.href && elem.href.includes('/company/')) {
+            console.log('🏢 Found company URL with selector:', sel, 'url:', elem.href);
+            return elem.href;
+        }
+    }
+    console.log('❌ No company URL found');
+    return '';
+};
+
+// LinkedIn company ID extraction - the numeric ID is a stable identity, unlike the display name
+const getCompanyLinkedInId = function(): string {
+    // Company links on authenticated job pages often use the numeric ID instead of the slug
+    const companyUrl = getCompanyUrl();
+    const idInUrl = companyUrl.match(/\/company\/(\d+)(?:[/?#]|$)/);
+    if (idInUrl) {
+        console.log('🏢 Found company ID in company URL:', idInUrl[1]);
+        return idInUrl[1];
+    }
+
+    // Otherwise look for the company URN in the embedded data blocks
+    const urnPattern = /urn:li:(?:fsd_company|company|organization):(\d+)/;
+    const urnElem = Utils.safeQuery<HTMLElement>('.job-details-jobs-unified-top-card__company-name [data-entity-urn], [data-entity-urn*="company:"]');
+    if (urnElem) {
+        const match = (urnElem.getAttribute('data-entity-urn') || '').match(urnPattern);
+        if (match) {
+            console.log('🏢 Found company ID in entity URN:', match[1]);
+            return match[1];
+        }
+    }
+
+    const codeBlocks = Utils.safeQueryAll<HTMLElement>('code');
+    if (codeBlocks) {
+        for (const block of Array.from(codeBlocks)) {
+            const match = (block.textContent || '').match(urnPattern);
+            if (match) {
+                console.log('🏢 Found company ID in code block:', match[1]);
+                return match[1];
+            }
+        }
+    }
+
+    console.log('❌ No company ID found');
+    return '';
+};
+
 // Company Image URL extraction
 const getCompanyImageUrl = function(): string {
     const selectors = [
@@ -276,3 +333,190 @@ const getPostedDate = function(): string {
     console.log('No posted date found');
     return '';
 };
+
+// LinkedIn job ID of the page, from /jobs/view/<id>/ or the currentJobId parameter
+const getCurrentJobId = function(): string {
+    const pathMatch = window.location.pathname.match(/\/jobs\/view\/(?:[^/]*?-)?(\d+)/);
+    if (pathMatch) {
+        return pathMatch[1];
+    }
+    return new URLSearchParams(window.location.search).get('currentJobId') || '';
+};
+
+// "listedAt" of the entity in a code block's JSON whose URN ends with the job ID
+const findListedAt = function(text: string, jobId: string): string {
+    if (!text.includes('"listedAt"') || !text.includes(jobId)) {
+        return '';
+    }
+    let data: unknown;
+    try {
+        data = JSON.parse(text);
+    } catch (e) {
+        return '';
+    }
+
+    const urnSuffix = ':' + jobId;
+    const stack: unknown[] = [data];
+    while (stack.length > 0) {
+        const value = stack.pop();
+        if (!value || typeof value !== 'object') {
+            continue;
+        }
+        if (Array.isArray(value)) {
+            stack.push(...value);
+            continue;
+        }
+        const entity = value as Record<string, unknown>;
+        const urn = String(entity.entityUrn || entity.dashEntityUrn || '');
+        const listedAt = entity.listedAt;
+        if (typeof listedAt === 'number' && (urn.endsWith(urnSuffix) || String(entity.jobPostingId || '') === jobId)) {
+            return String(listedAt);
+        }
+        stack.push(...Object.values(entity));
+    }
+    return '';
+};
+
+// Machine-readable posting time: JSON-LD datePosted, the current job's "listedAt" epoch
+// milliseconds in the embedded code blocks, or a <time datetime> attribute - in order of precision
+const getPostedTimestamp = function(): PostedTimestamp {
+    const scripts = Utils.safeQueryAll<HTMLScriptElement>('script[type="application/ld+json"]');
+    if (scripts) {
+        for (const script of Array.from(scripts)) {
+            try {
+                const data = JSON.parse(script.textContent || '{}');
+                const postings = Array.isArray(data) ? data : [data];
+                for (const posting of postings) {
+                    if (posting && posting.datePosted) {
+                        console.log('Found posted timestamp in JSON-LD:', posting.datePosted);
+                        return { value: String(posting.datePosted), source: 'json_ld' };
+                    }
+                }
+            } catch (e) {
+                console.log('Could not parse JSON-LD block:', e);
+            }
+        }
+    }
+
+    // The page also embeds similar and recommended jobs, so only the current job's entity counts
+    const jobId = getCurrentJobId();
+    const codeBlocks = jobId ? Utils.safeQueryAll<HTMLElement>('code') : null;
+    if (codeBlocks) {
+        for (const block of Array.from(codeBlocks)) {
+            const listedAt = findListedAt(block.textContent || '', jobId);
+            if (listedAt) {
+                console.log('Found posted timestamp in code block:', listedAt);
+                return { value: listedAt, source: 'code_block' };
+            }
+        }
+    }
+
+    const timeElem = Utils.safeQuery<HTMLTimeElement>('time[datetime]');
+    if (timeElem) {
+        const datetime = timeElem.getAttribute('datetime') || '';
+        if (datetime) {
+            console.log('Found posted timestamp in time element:', datetime);
+            return { value: datetime.trim(), source: 'time_element' };
+        }
+    }
+
+    console.log('No machine-readable posted timestamp found');
+    return { value: '', source: '' };
+};
+
+// Salary extraction (salary insights card or top card pill)
+const getSalaryText = function(): string {
+    const selectors = [
+        '#SALARY .salary-main-rail__data-amount',
+        '.jobs-details__salary-main-rail-card .salary-main-rail__data-body',
+        '#SALARY',
+        '.salary.compensation__salary',
+        '.compensation__salary-range',
+        '.job-details-preferences-and-skills__pill',
+        '.job-details-jobs-unified-top-card__job-insight span'
+    ];
+    for (const sel of selectors) {
+        const elems = Utils.safeQueryAll<HTMLElement>(sel);
+        if (!elems) {
+            continue;
+        }
+        for (const elem of Array.from(elems)) {
+            const text = (elem.innerText || '').trim();
+            // Only accept text that looks like an amount with a currency
+            if (text && /\d/.test(text) && /(kr|dkk|eur|usd|gbp|sek|nok|€|\$|£)/i.test(text)) {
+                console.log('💰 Found salary with selector:', sel, 'text:', text);
+                return text;
+            }
+        }
+    }
+    console.log('No salary found');
+    return '';
+};
+
+// Job criteria extraction (seniority level, employment type, job function, industries)
+const getJobCriteria = function(): JobCriteria {
+    const criteria: JobCriteria = { seniorityLevel: '', employmentType: '', jobFunction: '', industries: '' };
+
+    // Header labels in English and Danish mapped to criteria keys
+    const labels: { key: keyof JobCriteria; patterns: string[] }[] = [
+        { key: 'seniorityLevel', patterns: ['seniority level', 'anciennitetsniveau', 'senioritetsniveau'] },
+        { key: 'employmentType', patterns: ['employment type', 'ansættelsestype', 'jobtype'] },
+        { key: 'jobFunction', patterns: ['job function', 'jobfunktion'] },
+        { key: 'industries', patterns: ['industries', 'industry', 'brancher', 'branche'] }
+    ];
+
+    // Criteria list shown on job pages ("Seniority level: Mid-Senior level")
+    const items = Utils.safeQueryAll<HTMLElement>('.description__job-criteria-item, .job-criteria__item');
+    if (items) {
+        for (const item of Array.from(items)) {
+            const header = Utils.safeQuery<HTMLElement>('.description__job-criteria-subheader, .job-criteria__subtitle, h3', item);
+            const value = Utils.safeQuery<HTMLElement>('.description__job-criteria-text, .job-criteria__text, span', item);
+            if (!header || !value) {
+                continue;
+            }
+            const headerText = (header.innerText || '').trim().toLowerCase();
+            for (const label of labels) {
+                if (label.patterns.some(p => headerText.includes(p))) {
+                    criteria[label.key] = (value.innerText || '').trim();
+                    console.log('📋 Found criteria', label.key, ':', criteria[label.key]);
+                }
+            }
+        }
+    }
+
+    // Logged-in top card shows "Full-time · Mid-Senior level" as job insights
+    if (!criteria.employmentType || !criteria.seniorityLevel) {
+        const insights = Utils.safeQueryAll<HTMLElement>('.job-details-jobs-unified-top-card__job-insight, .job-details-preferences-and-skills__pill, .job-details-fit-level-preferences button');
+        if (insights) {
+            const employmentPattern = /(full-time|part-time|contract|temporary|internship|volunteer|fuldtid|deltid|kontrakt|midlertidig|praktik|frivillig)/i;
+            const seniorityPattern = /(entry level|associate|mid-senior level|director|executive|begynderniveau|mellemniveau|seniorniveau|direktør)/i;
+            for (const insight of Array.from(insights)) {
+                const text = (insight.innerText || '').trim();
+                for (const part of text.split('·')) {
+                    const employmentMatch = part.match(employmentPattern);
+                    if (!criteria.employmentType && employmentMatch) {
+                        criteria.employmentType = employmentMatch[1];
+                    }
+                    const seniorityMatch = part.match(seniorityPattern);
+                    if (!criteria.seniorityLevel && seniorityMatch) {
+                        criteria.seniorityLevel = seniorityMatch[1];
+                    }
+                }
+            }
+        }
+    }
+
+    // Logged-in pages show the industry in the "About the company" card
+    if (!criteria.industries) {
+        const companyInfo = Utils.safeQuery<HTMLElement>('.jobs-company__box .t-14.mt5, .job-details-jobs-unified-top-card__company-info');
+        if (companyInfo && companyInfo.innerText) {
+            const industry = companyInfo.innerText.split('\n')[0].trim();
+            if (industry && !/\d/.test(industry)) {
+                criteria.industries = industry;
+            }
+        }
+    }
+
+    console.log('Final job criteria:', criteria);
+    return criteria;
+};
@@ -0,0 +1,85 @@
+/// <reference path="types.ts" />
+/// <reference path="utils.ts" />
+/// <reference path="job_details.ts" />
+/// <reference path="skills.ts" />
+
+// Check whether a stored job posting is still open, closed or removed
+(function(): JobStatus {
+    console.log('=== CHECKING JOB STATUS ===');
+
+    const result: JobStatus = {
+        url: window.location.href,
+        closed: false,
+        removed: false,
+        statusText: '',
+        applicantsText: '',
+        title: '',
+        description: '',
+        workType: ''
+    };
+
+    // Only an explicit error page or expired message means the posting is gone. Landing
+    // anywhere else, like a login or authwall redirect, is judged by the caller from the URL.
+    if (Utils.safeQuery('.error-page, .not-found, [data-test-id="expired-job-message"]') !== null) {
+        result.removed = true;
+        console.log('❌ Job posting removed, landed on:', result.url);
+        return result;
+    }
+
+    const bodyText = document.body ? document.body.innerText : '';
+    const removedMarkers = [
+        'This job is no longer available',
+        'The job you were looking for was not found',
+        'Jobbet er ikke længere tilgængeligt',
+        'Dette job er ikke længere tilgængeligt'
+    ];
+    for (const marker of removedMarkers) {
+        if (bodyText.includes(marker)) {
+            result.removed = true;
+            result.statusText = marker;
+            console.log('❌ Job posting removed:', marker);
+            return result;
+        }
+    }
+
+    const closedMarkers = [
+        'No longer accepting applications',
+        'Modtager ikke længere ansøgninger',
+        'Tager ikke længere imod ansøgninger',
+        'Accepterer ikke længere ansøgninger'
+    ];
+    const feedbackElem = Utils.safeQuery<HTMLElement>('.jobs-details-top-card__apply-error, .artdeco-inline-feedback__message, .closed-job');
+    const feedbackText = feedbackElem && feedbackElem.innerText ? feedbackElem.innerText.trim() : '';
+    for (const marker of closedMarkers) {
+        if (feedbackText.includes(marker) || bodyText.includes(marker)) {
+            result.closed = true;
+            result.statusText = marker;
+            console.log('🔒 Job posting closed:', marker);
+            break;
+        }
+    }
+
+    // Applicant count from the top card, e.g. "Over 100 applicants" or "25 ansøgere"
+    const applicantSelectors = [
+        '.num-applicants__caption',
+        '.jobs-unified-top-card__applicant-count',
+        '.job-details-jobs-unified-top-card__primary-description-container',
+        '.job-details-jobs-unified-top-card__tertiary-description-container'
+    ];
+    for (const sel of applicantSelectors) {
+        const elem = Utils.safeQuery<HTMLElement>(sel);
+        if (elem && elem.innerText && /applicant|ansøger/i.test(elem.innerText)) {
+            const part = elem.innerText.split('·').find(p => /applicant|ansøger/i.test(p));
+            result.applicantsText = (part || elem.innerText).trim();
+            break;
+        }
+    }
+
+    // Current title, description and work type, recorded in the job's history
+    result.title = getTitleText();
+    result.description = getDescriptionText();
+    result.workType = getWorkTypeAndSkills().workType;
+
+    console.log('Final job status:', result);
+    return result;
+})();
@@ -22,6 +22,44 @@ interface JobExtractionResult {
     applyUrl: string;
     workType: string;
     skills: string[];
+    salary: string;
+    criteria: JobCriteria;
+    companyUrl: string;
+    companyLinkedinId: string;
+    postedTimestamp: PostedTimestamp;
+}
+
+interface PostedTimestamp {
+    value: string;
+    source: string;
+}
+
+interface JobCriteria {
+    seniorityLevel: string;
+    employmentType: string;
+    jobFunction: string;
+    industries: string;
+}
+
+interface CompanyAbout {
+    name: string;
+    linkedinCompanyId: string;
+    website: string;
+    industry: string;
+    companySize: string;
+    headquarters: string;
+    followers: string;
+}
+
+interface JobStatus {
+    url: string;
+    closed: boolean;
+    removed: boolean;
+    statusText: string;
+    applicantsText: string;
+    title: string;
+    description: string;
+    workType: string;
 }
 
 interface PageAnalysis {
@@ -0,0 +1,52 @@
+package scraper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// descriptionHash returns a SHA-256 hash of a job description with whitespace normalised,
+// so re-rendered but unchanged descriptions hash the same. Empty descriptions hash to "".
+func descriptionHash(description string) string {
+	normalised := strings.Join(strings.Fields(description), " ")
+	if normalised == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(normalised))
+	return hex.EncodeToString(sum[:])
+}
+
+// newJobSnapshot captures the current state of a job posting
+func newJobSnapshot(job *models.JobPosting, capturedAt time.Time) *models.JobSnapshot {
+	return &models.JobSnapshot{
+		JobID:           job.JobID,
+		LinkedInJobID:   job.LinkedInJobID,
+		CapturedAt:      capturedAt,
+		Applicants:      job.Applicants,
+		Title:           job.Title,
+		DescriptionHash: descriptionHash(job.Description),
+		WorkType:        job.WorkType,
+		IsOpen:          job.JobPostClosedDate == nil,
+	}
+}
+
+// refreshSnapshot captures a refreshed job, preferring what is on the page now over the stored
+// values. Removed postings have no title or description left, so the stored ones are kept.
+func refreshSnapshot(job *models.JobPosting, status *jobStatus, capturedAt time.Time) *models.JobSnapshot {
+	snapshot := newJobSnapshot(job, capturedAt)
+	if status.Title != "" {
+		snapshot.Title = status.Title
+	}
+	if hash := descriptionHash(status.Description); hash != "" {
+		snapshot.DescriptionHash = hash
+	}
+	if status.WorkType != "" {
+		workType := status.WorkType
+		snapshot.WorkType = &workType
+	}
+	return snapshot
+}
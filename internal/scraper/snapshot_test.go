@@ -0,0 +1,40 @@
+package scraper
+
+import (
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+func TestDescriptionHash(t *testing.T) {
+	if descriptionHash("") != "" || descriptionHash("  \n ") != "" {
+		t.Error("empty descriptions should hash to an empty string")
+	}
+	if descriptionHash("We are hiring\n  a Go developer") != descriptionHash("We are hiring a Go developer ") {
+		t.Error("whitespace differences should not change the hash")
+	}
+	if descriptionHash("We are hiring a Go developer") == descriptionHash("We are hiring a Rust developer") {
+		t.Error("different descriptions should hash differently")
+	}
+}
+
+func TestRefreshSnapshot(t *testing.T) {
+	now := time.Now()
+	onSite := "On-site"
+	job := &models.JobPosting{JobID: 7, LinkedInJobID: 123, Title: "Go Developer", Description: "Old text", WorkType: &onSite}
+
+	removed := refreshSnapshot(job, &jobStatus{Removed: true}, now)
+	if removed.Title != "Go Developer" || removed.DescriptionHash != descriptionHash("Old text") || *removed.WorkType != "On-site" {
+		t.Errorf("removed posting should keep stored values, got %+v", removed)
+	}
+
+	job.JobPostClosedDate = &now
+	updated := refreshSnapshot(job, &jobStatus{Title: "Senior Go Developer", Description: "New text", WorkType: "Remote"}, now)
+	if updated.Title != "Senior Go Developer" || updated.DescriptionHash != descriptionHash("New text") || *updated.WorkType != "Remote" {
+		t.Errorf("snapshot should use current page values, got %+v", updated)
+	}
+	if updated.IsOpen {
+		t.Error("job with a closed date should not be open")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/chromedp/chromedp"
@@ -109,150 +110,77 @@ func getSliceValue(m map[string]interface{}, key string) []interface{} {
 	return []interface{}{}
 }
 
-// Date parsing utilities
-func parseRelativeDate(dateStr string) *time.Time {
-	dateStr = strings.ToLower(strings.TrimSpace(dateStr))
-	now := time.Now()
-
-	// Handle Danish time expressions
-	if strings.Contains(dateStr, "siden") {
-		if date := parseDanishRelativeDate(dateStr, now); date != nil {
-			return date
-		}
-	}
-
-	// Handle English time expressions
-	if strings.Contains(dateStr, "ago") {
-		if date := parseEnglishRelativeDate(dateStr, now); date != nil {
-			return date
-		}
-	}
-
-	return nil
-}
-
-func parseDanishRelativeDate(dateStr string, now time.Time) *time.Time {
-	if strings.Contains(dateStr, "dag") {
-		if strings.Contains(dateStr, "1 dag") || strings.Contains(dateStr, "en dag") {
-			result := now.AddDate(0, 0, -1)
-			return &result
-		}
-		if num := extractNumber(dateStr, "dag"); num > 0 {
-			result := now.AddDate(0, 0, -num)
-			return &result
-		}
-	}
-	
-	if strings.Contains(dateStr, "uge") {
-		if strings.Contains(dateStr, "1 uge") || strings.Contains(dateStr, "en uge") {
-			result := now.AddDate(0, 0, -7)
-			return &result
-		}
-		if num := extractNumber(dateStr, "uge"); num > 0 {
-			result := now.AddDate(0, 0, -num*7)
-			return &result
-		}
-	}
-	
-	if strings.Contains(dateStr, "måned") {
-		if strings.Contains(dateStr, "1 måned") || strings.Contains(dateStr, "en måned") {
-			result := now.AddDate(0, -1, 0)
-			return &result
-		}
-		if num := extractNumber(dateStr, "måned"); num > 0 {
-			result := now.AddDate(0, -num, 0)
-			return &result
-		}
-	}
-	
-	return nil
-}
+// Applicants parsing utilities
 
-func parseEnglishRelativeDate(dateStr string, now time.Time) *time.Time {
-	if strings.Contains(dateStr, "day") {
-		if strings.Contains(dateStr, "1 day") || strings.Contains(dateStr, "a day") {
-			result := now.AddDate(0, 0, -1)
-			return &result
-		}
-		if num := extractNumber(dateStr, "day"); num > 0 {
-			result := now.AddDate(0, 0, -num)
-			return &result
-		}
-	}
-	
-	if strings.Contains(dateStr, "week") {
-		if strings.Contains(dateStr, "1 week") || strings.Contains(dateStr, "a week") {
-			result := now.AddDate(0, 0, -7)
-			return &result
-		}
-		if num := extractNumber(dateStr, "week"); num > 0 {
-			result := now.AddDate(0, 0, -num*7)
-			return &result
-		}
-	}
-	
-	if strings.Contains(dateStr, "month") {
-		if strings.Contains(dateStr, "1 month") || strings.Contains(dateStr, "a month") {
-			result := now.AddDate(0, -1, 0)
-			return &result
-		}
-		if num := extractNumber(dateStr, "month"); num > 0 {
-			result := now.AddDate(0, -num, 0)
-			return &result
-		}
-	}
-	
-	return nil
+// applicantsPatterns are tried in order against the lowercased applicants text. The first
+// group captures the count; "over 100" and "among the first 25" give the bound LinkedIn shows.
+var applicantsPatterns = []string{
+	// "Over 100 applicants", "Mere end 200 ansøgere", "Fler än 100 sökande", "Mer enn 100 søkere", "Über 200 Bewerber"
+	`(?:more than|over|mere end|flere end|fler än|över|mer enn|flere enn|über|mehr als)\s+(\d(?:[\d.,\x{00a0}]*\d)?)`,
+	// "Be among the first 25 applicants", "Vær blandt de første 25 ansøgere", "Bli en av de första 25 sökande"
+	`(?:among the first|blandt de første|blant de første|bland de första|de första|unter den ersten|zu den ersten)\s+(\d(?:[\d.,\x{00a0}]*\d)?)`,
+	// "25 applicants", "1.234 ansøgere", "12 sökande", "7 søkere", "30 Bewerber"
+	`(\d(?:[\d.,\x{00a0}]*\d)?)\s+(?:applicants?|applications?|ansøgere?|ansøgninger|søkere?|søknader|sökande|ansökningar|bewerber(?:innen)?|bewerbungen)`,
+	// "25 people clicked apply", "25 personer har klikket på Ansøg", "25 Personen haben sich beworben"
+	`(\d(?:[\d.,\x{00a0}]*\d)?)\s+(?:people|personer|personen)\s+(?:clicked|har|haben)`,
 }
 
-func extractNumber(text, unit string) int {
-	parts := strings.Fields(text)
-	for i, part := range parts {
-		if num, err := strconv.Atoi(part); err == nil && i+1 < len(parts) && strings.Contains(parts[i+1], unit) {
-			return num
-		}
-	}
-	return 0
-}
+// noApplicantsPhrases mean nobody has applied yet
+var noApplicantsPhrases = []string{"no applicants", "ingen ansøgere", "inga sökande", "ingen søkere", "keine bewerber", "noch keine bewerbungen"}
 
-// Applicants parsing utilities
 func parseApplicantsCount(applicantsStr string) *int {
 	applicantsStr = strings.ToLower(strings.TrimSpace(applicantsStr))
-	
-	// Handle "more than X" cases in Danish
-	if strings.Contains(applicantsStr, "mere end") {
-		if num := extractNumberFromPattern(applicantsStr, `mere\s*end\s*(\d+)`); num > 0 {
-			return &num
-		}
+	if applicantsStr == "" {
+		return nil
 	}
-	
-	// Handle "more than X" cases in English
-	if strings.Contains(applicantsStr, "more than") || strings.Contains(applicantsStr, "over") {
-		if num := extractNumberFromPattern(applicantsStr, `(?:more\s*than|over)\s*(\d+)`); num > 0 {
+
+	for _, pattern := range applicantsPatterns {
+		if num := extractNumberFromPattern(applicantsStr, pattern); num > 0 {
 			return &num
 		}
 	}
-	
-	// Handle exact numbers
-	if strings.Contains(applicantsStr, "ansøger") || strings.Contains(applicantsStr, "applicant") {
-		if num := extractNumberFromPattern(applicantsStr, `(\d+)\s*(?:ansøgere?|applicants?)`); num > 0 {
-			return &num
-		}
+
+	if containsAny(applicantsStr, noApplicantsPhrases) {
+		none := 0
+		return &none
 	}
-	
+
 	return nil
 }
 
+var (
+	patternCache   = map[string]*regexp.Regexp{}
+	patternCacheMu sync.Mutex
+)
+
+// extractNumberFromPattern returns the number captured by the first group of pattern in text,
+// ignoring thousand separators ("1.234", "1,234" or a non-breaking space), or 0 if the pattern doesn't match
 func extractNumberFromPattern(text, pattern string) int {
-	// This is a simplified version - in a real implementation you'd use regexp
-	// For now, let's extract numbers manually
-	parts := strings.Fields(text)
-	for _, part := range parts {
-		if num, err := strconv.Atoi(part); err == nil && num > 0 {
-			return num
+	patternCacheMu.Lock()
+	re, ok := patternCache[pattern]
+	if !ok {
+		re = regexp.MustCompile(pattern)
+		patternCache[pattern] = re
+	}
+	patternCacheMu.Unlock()
+
+	matches := re.FindStringSubmatch(text)
+	if len(matches) < 2 {
+		return 0
+	}
+
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
 		}
+		return -1
+	}, matches[1])
+
+	num, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0
 	}
-	return 0
+	return num
 }
 
 // Helper function to get map keys for debugging
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"fmt"
+	"linkedin-job-scraper/internal/addresses"
+	"linkedin-job-scraper/internal/models"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ResolveAddresses looks up the street address of up to limit jobs without one in the offline
+// address index, checking the location line before the description, and saves it as the job's
+// OpenaiAdresse. With dryRun the addresses are only logged. Returns the number of jobs checked
+// and addresses found.
+func (ds *DataService) ResolveAddresses(index *addresses.Index, limit int, dryRun bool) (processed int, resolved int, err error) {
+	jobs, err := ds.store.ListJobs(models.JobFilter{MissingAddress: true, Limit: limit})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get jobs without address: %w", err)
+	}
+
+	for i := range jobs {
+		job := &jobs[i]
+		processed++
+
+		address, ok := index.ResolveFirst(job.Location, job.Description)
+		if !ok {
+			logrus.Debugf("⏭️  No address found for job %d (%s)", job.LinkedInJobID, job.Title)
+			continue
+		}
+
+		formatted := address.String()
+		job.OpenaiAdresse = &formatted
+		resolved++
+		logrus.Infof("📍 Job %d (%s): %s", job.LinkedInJobID, job.Title, formatted)
+
+		if dryRun {
+			continue
+		}
+		if err := ds.UpdateJob(job); err != nil {
+			return processed, resolved, err
+		}
+	}
+
+	return processed, resolved, nil
+}
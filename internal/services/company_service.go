@@ -1,145 +1,219 @@
 package services
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
+	"linkedin-job-scraper/internal/api"
+	"linkedin-job-scraper/internal/dedupe"
 	"linkedin-job-scraper/internal/models"
-	"net/http"
-	"net/url"
-	"os"
-	"strings"
+
+	"github.com/sirupsen/logrus"
 )
 
-// CompanyExistsResponse represents the response from the company exists API
-type CompanyExistsResponse struct {
-	Exists  bool              `json:"exists"`
-	Company *models.Company   `json:"company"`
-}
+// CompanyExists looks up a company by its LinkedIn company ID, then its LinkedIn slug,
+// and only falls back to the display name. Names merged by `companies dedupe` resolve to
+// their canonical company through the alias table. Returns nil if the company doesn't exist.
+func (ds *DataService) CompanyExists(ref *models.Company) (*models.Company, error) {
+	ref = ds.resolveCompanyAlias(ref)
 
-// CreateCompanyRequest represents the request to create a company
-type CreateCompanyRequest struct {
-	Name     string `json:"name"`
-	ImageURL string `json:"image_url"`
-}
+	// Check cache first, most specific identity first
+	for _, identityKey := range ref.IdentityKeys() {
+		company, found := ds.cache.GetCompany(identityKey)
+		if !found {
+			continue
+		}
+		if company.ConflictsWith(ref) {
+			// Same display name, different LinkedIn company
+			logrus.Debugf("⚠️  Cached company %s (%s) does not match %s", company.Name, company.IdentityKey(), ref.IdentityKey())
+			continue
+		}
+		return ds.adoptLinkedInIdentity(company, ref), nil
+	}
 
-// CreateCompanyResponse represents the response from creating a company
-type CreateCompanyResponse struct {
-	Success bool            `json:"success"`
-	Message string          `json:"message"`
-	Company models.Company  `json:"company"`
+	// Cache miss, check the store
+	logrus.Debugf("💻 Cache miss, checking company in store: %s (%s)", ref.Name, ref.IdentityKey())
+	company, err := ds.store.FindCompany(ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check company existence: %w", err)
+	}
+	if company == nil {
+		return nil, nil
+	}
+	if company.ConflictsWith(ref) {
+		logrus.Debugf("⚠️  Company %s matched by name only but is %s, not %s", company.Name, company.IdentityKey(), ref.IdentityKey())
+		return nil, nil
+	}
+
+	company = ds.adoptLinkedInIdentity(company, ref)
+	ds.cache.SetCompany(company)
+	return company, nil
 }
 
-// CompanyExists checks if a company exists by name
-func (ds *DataService) CompanyExists(companyName string) (bool, int, error) {
-	// Get API configuration from environment
-	baseURL := os.Getenv("API_BASE_URL")
-	apiKey := os.Getenv("API_KEY")
-	
-	if baseURL == "" {
-		return false, 0, fmt.Errorf("API_BASE_URL not configured")
-	}
-	if apiKey == "" {
-		return false, 0, fmt.Errorf("API_KEY not configured")
-	}
-	
-	// URL encode the company name
-	encodedName := url.QueryEscape(companyName)
-	requestURL := fmt.Sprintf("%s/companies/exists?name=%s", baseURL, encodedName)
-	
-	req, err := http.NewRequest("GET", requestURL, nil)
-	if err != nil {
-		return false, 0, fmt.Errorf("failed to create request: %w", err)
-	}
-	
-	req.Header.Set("X-API-Key", apiKey)
-	req.Header.Set("Content-Type", "application/json")
-	
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return false, 0, fmt.Errorf("failed to make request: %w", err)
+// ErrCompanyNameConflict means a company can't be created because the store only allows one
+// company per name and another LinkedIn company has it. Jobs of such a company are skipped.
+var ErrCompanyNameConflict = errors.New("company name is used by another LinkedIn company")
+
+// CreateCompany creates a new company from a reference holding its name, image and LinkedIn identity
+func (ds *DataService) CreateCompany(ref *models.Company) (*models.Company, error) {
+	logrus.Debugf("🆕 Creating new company: %s (%s)", ref.Name, ref.IdentityKey())
+	company, err := ds.store.CreateCompany(ref)
+	if errors.Is(err, api.ErrCompanyNameTaken) {
+		return nil, fmt.Errorf("%w: cannot create %q (%s)", ErrCompanyNameConflict, ref.Name, ref.IdentityKey())
 	}
-	defer resp.Body.Close()
-	
-	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return false, 0, fmt.Errorf("failed to read response: %w", err)
+		return nil, fmt.Errorf("failed to create company: %w", err)
+	}
+	if company == nil {
+		return nil, fmt.Errorf("store returned no company for %s", ref.Name)
+	}
+	if company.ConflictsWith(ref) {
+		// The store refused a second company with the same name and returned the existing one.
+		// Saving the job under it would attribute it to the wrong employer.
+		return nil, fmt.Errorf("%w: %q is %s, cannot create %s",
+			ErrCompanyNameConflict, ref.Name, company.IdentityKey(), ref.IdentityKey())
 	}
-	
-	if resp.StatusCode != http.StatusOK {
-		return false, 0, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+
+	ds.cache.SetCompany(company)
+	return company, nil
+}
+
+// adoptLinkedInIdentity backfills the LinkedIn ID and slug on a company that was found
+// by a weaker identity (typically its name), so later lookups can use the stronger key
+func (ds *DataService) adoptLinkedInIdentity(company, ref *models.Company) *models.Company {
+	changed := false
+	if company.LinkedInCompanyID == "" && ref.LinkedInCompanyID != "" {
+		company.LinkedInCompanyID = ref.LinkedInCompanyID
+		changed = true
 	}
-	
-	var response CompanyExistsResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return false, 0, fmt.Errorf("failed to parse response: %w", err)
+	if company.LinkedInSlug == "" && ref.LinkedInSlug != "" {
+		company.LinkedInSlug = ref.LinkedInSlug
+		changed = true
 	}
-	
-	if response.Exists && response.Company != nil {
-		return true, response.Company.CompanyID, nil
+	if company.LinkedInURL == "" && ref.LinkedInURL != "" {
+		company.LinkedInURL = ref.LinkedInURL
+		changed = true
 	}
-	
-	return false, 0, nil
+	if !changed {
+		return company
+	}
+
+	// Best effort - the company is still usable if the backfill fails
+	if err := ds.UpdateCompany(company); err != nil {
+		logrus.Warnf("⚠️  Failed to save LinkedIn identity for company %s: %v", company.Name, err)
+		ds.cache.SetCompany(company)
+	}
+	return company
 }
 
-// CreateCompany creates a new company
-func (ds *DataService) CreateCompany(companyName, imageURL string) (int, error) {
-	// Get API configuration from environment
-	baseURL := os.Getenv("API_BASE_URL")
-	apiKey := os.Getenv("API_KEY")
-	
-	if baseURL == "" {
-		return 0, fmt.Errorf("API_BASE_URL not configured")
-	}
-	if apiKey == "" {
-		return 0, fmt.Errorf("API_KEY not configured")
-	}
-	
-	requestURL := fmt.Sprintf("%s/companies", baseURL)
-	
-	reqBody := CreateCompanyRequest{
-		Name:     companyName,
-		ImageURL: imageURL,
-	}
-	
-	jsonData, err := json.Marshal(reqBody)
-	if err != nil {
-		return 0, fmt.Errorf("failed to marshal request: %w", err)
+// resolveCompanyAlias returns a copy of ref using the canonical company name when its name is a
+// known alias, e.g. "Novo Nordisk A/S" after it was merged into "Novo Nordisk"
+func (ds *DataService) resolveCompanyAlias(ref *models.Company) *models.Company {
+	if ref.Name == "" {
+		return ref
 	}
-	
-	req, err := http.NewRequest("POST", requestURL, strings.NewReader(string(jsonData)))
-	if err != nil {
-		return 0, fmt.Errorf("failed to create request: %w", err)
-	}
-	
-	req.Header.Set("X-API-Key", apiKey)
-	req.Header.Set("Content-Type", "application/json")
-	
-	client := &http.Client{}
-	resp, err := client.Do(req)
+
+	canonicalName, found := ds.cache.GetCompanyAlias(dedupe.NormaliseCompanyName(ref.Name))
+	if !found || canonicalName == ref.Name {
+		return ref
+	}
+
+	logrus.Debugf("🔀 Company alias: %s -> %s", ref.Name, canonicalName)
+	resolved := *ref
+	resolved.Name = canonicalName
+	return &resolved
+}
+
+// GetAllCompanyNames returns the names of all companies
+func (ds *DataService) GetAllCompanyNames() ([]string, error) {
+	names, err := ds.store.CompanyNames()
 	if err != nil {
-		return 0, fmt.Errorf("failed to make request: %w", err)
+		return nil, fmt.Errorf("failed to fetch company names: %w", err)
 	}
-	defer resp.Body.Close()
-	
-	body, err := io.ReadAll(resp.Body)
+	return names, nil
+}
+
+// MergeCompanies merges the companies named duplicateNames into the company named canonicalName
+// and records every name as an alias of the canonical company. Returns the number of jobs moved.
+func (ds *DataService) MergeCompanies(canonicalName string, duplicateNames []string) (int, error) {
+	canonical, err := ds.store.FindCompany(&models.Company{Name: canonicalName})
 	if err != nil {
-		return 0, fmt.Errorf("failed to read response: %w", err)
+		return 0, fmt.Errorf("failed to look up canonical company %q: %w", canonicalName, err)
+	}
+	if canonical == nil {
+		return 0, fmt.Errorf("canonical company %q does not exist", canonicalName)
+	}
+
+	var duplicates []*models.Company
+	var duplicateIDs []int
+	for _, name := range duplicateNames {
+		duplicate, err := ds.store.FindCompany(&models.Company{Name: name})
+		if err != nil {
+			return 0, fmt.Errorf("failed to look up company %q: %w", name, err)
+		}
+		if duplicate == nil || duplicate.CompanyID == canonical.CompanyID {
+			// Already merged or renamed - the alias below still applies
+			continue
+		}
+		if duplicate.ConflictsWith(canonical) {
+			return 0, fmt.Errorf("company %q (%s) is a different LinkedIn company than %q (%s)",
+				name, duplicate.IdentityKey(), canonicalName, canonical.IdentityKey())
+		}
+		duplicates = append(duplicates, duplicate)
+		duplicateIDs = append(duplicateIDs, duplicate.CompanyID)
+	}
+
+	jobsMoved := 0
+	if len(duplicateIDs) > 0 {
+		jobsMoved, err = ds.store.MergeCompanies(canonical.CompanyID, duplicateIDs)
+		if err != nil {
+			return 0, fmt.Errorf("failed to merge companies: %w", err)
+		}
 	}
-	
-	if resp.StatusCode != http.StatusCreated {
-		return 0, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+
+	for _, duplicate := range duplicates {
+		ds.cache.DeleteCompany(duplicate)
 	}
-	
-	var response CreateCompanyResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return 0, fmt.Errorf("failed to parse response: %w", err)
+
+	// The canonical name's own normalised form is an alias too, so future variants
+	// like "NOVO NORDISK A/S" resolve without having been seen before
+	names := append([]string{canonicalName}, duplicateNames...)
+	aliased := make(map[string]bool)
+	for _, name := range names {
+		normalised := dedupe.NormaliseCompanyName(name)
+		if normalised == "" || aliased[normalised] {
+			continue
+		}
+		aliased[normalised] = true
+
+		alias := &models.CompanyAlias{
+			Alias:          name,
+			NormalisedName: normalised,
+			CompanyID:      canonical.CompanyID,
+			CompanyName:    canonical.Name,
+		}
+		if _, err := ds.store.CreateCompanyAlias(alias); err != nil {
+			return jobsMoved, fmt.Errorf("failed to create company alias %q: %w", name, err)
+		}
+		ds.cache.SetCompanyAlias(normalised, canonical.Name)
 	}
-	
-	if !response.Success {
-		return 0, fmt.Errorf("failed to create company: %s", response.Message)
+
+	return jobsMoved, nil
+}
+
+// PreloadCompanyAliasesToCache fetches the company alias table from the store and populates the cache
+func (ds *DataService) PreloadCompanyAliasesToCache() error {
+	aliases, err := ds.store.CompanyAliases()
+	if err != nil {
+		return fmt.Errorf("failed to fetch company aliases: %w", err)
 	}
-	
-	return response.Company.CompanyID, nil
+
+	for _, alias := range aliases {
+		if alias.NormalisedName == "" || alias.CompanyName == "" {
+			continue
+		}
+		ds.cache.SetCompanyAlias(alias.NormalisedName, alias.CompanyName)
+	}
+
+	logrus.Infof("✅ Successfully preloaded %d company aliases to Redis cache", len(aliases))
+	return nil
 }
@@ -5,30 +5,68 @@ import (
 	"linkedin-job-scraper/internal/api"
 	"linkedin-job-scraper/internal/cache"
 	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/database"
 	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/storage"
 	"strconv"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
 
-// DataService handles data operations with caching and API integration
+// DataService handles data operations with caching and API integration.
+// Jobs, companies and everything about them live in the configured store; only user
+// profiles, which belong to linkedin-user-scraper, always come from the API.
 type DataService struct {
 	apiClient *api.Client
-	cache     *cache.RedisCache
+	store     storage.Store
+	queue     storage.Queue
+	cache     cache.Cache
+
+	standalone bool // No Laravel API, so no user profiles
 }
 
-// NewDataService creates a new data service with API and cache
+// NewDataService creates a new data service with API, store, queue and cache
 func NewDataService(cfg *config.Config) *DataService {
 	apiClient := api.NewClient(&cfg.API)
-	redisCache := cache.NewRedisCache(&cfg.Redis)
+
+	store, err := storage.Open(cfg)
+	if err != nil {
+		logrus.Fatal("Failed to open storage: ", err)
+	}
+	logrus.Debugf("💾 Using %s storage", cfg.Storage.Backend)
+
+	// An SQLite file holds the queue next to the jobs; otherwise it is a Redis list
+	var queue storage.Queue
+	if sqlStore, ok := store.(*storage.SQLStore); ok && sqlStore.DB().Driver == database.DriverSQLite {
+		if queue, err = storage.NewSQLQueue(sqlStore.DB()); err != nil {
+			logrus.Fatal("Failed to open queue: ", err)
+		}
+	}
+
+	var dataCache cache.Cache
+	if cfg.Standalone {
+		logrus.Info("🏝️  Standalone mode: SQLite storage at ", cfg.Storage.SQLitePath, " and an in-memory cache")
+		dataCache = cache.NewMemoryCache(&cfg.Redis)
+	} else {
+		redisCache := cache.NewRedisCache(&cfg.Redis)
+		dataCache = redisCache
+		if queue == nil {
+			queue = storage.NewRedisQueue(redisCache)
+		}
+	}
 
 	return &DataService{
 		apiClient: apiClient,
-		cache:     redisCache,
+		store:     store,
+		queue:     queue,
+		cache:     dataCache,
+
+		standalone: cfg.Standalone,
 	}
 }
 
-// JobExists checks if a job exists, using cache first, then API
+// JobExists checks if a job exists, using cache first, then the store
 func (s *DataService) JobExists(linkedinJobID int) (bool, error) {
 	// Check cache first
 	if exists, found := s.cache.JobExistsInCache(linkedinJobID); found {
@@ -36,11 +74,11 @@ func (s *DataService) JobExists(linkedinJobID int) (bool, error) {
 		return exists, nil
 	}
 
-	// Cache miss, check via API
-	logrus.Debugf("💻 Cache miss, checking job via API: %d", linkedinJobID)
-	exists, err := s.apiClient.CheckJobExists(linkedinJobID)
+	// Cache miss, check the store
+	logrus.Debugf("💻 Cache miss, checking job in store: %d", linkedinJobID)
+	exists, err := s.store.JobExists(linkedinJobID)
 	if err != nil {
-		return false, fmt.Errorf("failed to check job existence via API: %w", err)
+		return false, fmt.Errorf("failed to check job existence: %w", err)
 	}
 
 	// Cache the result
@@ -58,11 +96,11 @@ func (s *DataService) JobExistsForDiscovery(linkedinJobID int) (bool, error) {
 		return true, nil
 	}
 
-	// Cache miss or negative result, check via API
-	logrus.Debugf("💻 Checking job via API (discovery): %d", linkedinJobID)
-	exists, err := s.apiClient.CheckJobExists(linkedinJobID)
+	// Cache miss or negative result, check the store
+	logrus.Debugf("💻 Checking job in store (discovery): %d", linkedinJobID)
+	exists, err := s.store.JobExists(linkedinJobID)
 	if err != nil {
-		return false, fmt.Errorf("failed to check job existence via API: %w", err)
+		return false, fmt.Errorf("failed to check job existence: %w", err)
 	}
 
 	// Only cache positive results during discovery to avoid polluting cache
@@ -73,38 +111,130 @@ func (s *DataService) JobExistsForDiscovery(linkedinJobID int) (bool, error) {
 	return exists, nil
 }
 
-// CreateOrGetCompany gets an existing company or creates a new one
-func (s *DataService) CreateOrGetCompany(name string) (*models.Company, error) {
-	// Check cache first
-	if company, found := s.cache.GetCompanyByName(name); found {
-		logrus.Debugf("🎯 Cache hit for company: %s (ID: %d)", name, company.CompanyID)
+// CreateOrGetCompany gets an existing company or creates a new one.
+// The reference is matched on LinkedIn company ID, then slug, then display name.
+func (s *DataService) CreateOrGetCompany(ref *models.Company) (*models.Company, error) {
+	company, err := s.CompanyExists(ref)
+	if err != nil {
+		return nil, err
+	}
+	if company != nil {
 		return company, nil
 	}
 
-	// Cache miss, check via API
-	logrus.Debugf("💻 Cache miss, checking company via API: %s", name)
-	company, err := s.apiClient.CheckCompanyExists(name)
+	return s.CreateCompany(ref)
+}
+
+// GetCachedCompanyEnrichment returns previously scraped LinkedIn page details for a company slug
+func (s *DataService) GetCachedCompanyEnrichment(companySlug string) (*models.Company, bool) {
+	return s.cache.GetCompanyEnrichment(companySlug)
+}
+
+// CacheCompanyEnrichment stores scraped LinkedIn page details for a company slug
+func (s *DataService) CacheCompanyEnrichment(companySlug string, details *models.Company) {
+	s.cache.SetCompanyEnrichment(companySlug, details)
+}
+
+// GetCompaniesForEnrichment returns companies with a LinkedIn URL that have not been enriched yet
+func (s *DataService) GetCompaniesForEnrichment(limit int) ([]models.Company, error) {
+	companies, err := s.store.ListCompanies(models.CompanyFilter{NeedsEnrichment: true, Limit: limit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get companies for enrichment: %w", err)
+	}
+	return companies, nil
+}
+
+// UpdateCompany saves enriched company details and refreshes the cache
+func (s *DataService) UpdateCompany(company *models.Company) error {
+	updated, err := s.store.UpdateCompany(company)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check company existence via API: %w", err)
+		return fmt.Errorf("failed to update company: %w", err)
 	}
 
-	// If company exists, cache it and return
-	if company != nil {
-		s.cache.SetCompany(company)
-		return company, nil
+	if updated != nil {
+		s.cache.SetCompany(updated)
 	}
+	return nil
+}
 
-	// Company doesn't exist, create it
-	logrus.Debugf("🆕 Creating new company via API: %s", name)
-	company, err = s.apiClient.CreateCompany(name)
+// GetJobsToRefresh returns jobs that are still open or were posted more than olderThanDays
+// days ago (only open jobs if it is 0). Jobs refreshed longest ago come first, so each run
+// moves on to jobs the previous run didn't reach.
+func (s *DataService) GetJobsToRefresh(olderThanDays, limit int) ([]models.JobPosting, error) {
+	filter := models.JobFilter{Open: true, LeastRefreshed: true, Limit: limit}
+	if olderThanDays > 0 {
+		filter.Open = false
+		filter.OpenOrPostedBefore = time.Now().AddDate(0, 0, -olderThanDays)
+	}
+	jobs, err := s.store.ListJobs(filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create company via API: %w", err)
+		return nil, fmt.Errorf("failed to get jobs to refresh: %w", err)
 	}
+	return jobs, nil
+}
 
-	// Cache the new company
-	s.cache.SetCompany(company)
+// UpdateJob saves a refreshed job's applicant count and closed date
+func (s *DataService) UpdateJob(job *models.JobPosting) error {
+	if err := s.store.UpdateJob(job); err != nil {
+		return fmt.Errorf("failed to update job: %w", err)
+	}
+	return nil
+}
+
+// RecordJobSnapshot saves the state of a job posting at one point in time
+func (s *DataService) RecordJobSnapshot(snapshot *models.JobSnapshot) error {
+	if _, err := s.store.CreateSnapshot(snapshot); err != nil {
+		return fmt.Errorf("failed to record job snapshot: %w", err)
+	}
+	return nil
+}
 
-	return company, nil
+// GetJobHistory returns all snapshots of a job, oldest first
+func (s *DataService) GetJobHistory(linkedinJobID int) ([]models.JobSnapshot, error) {
+	snapshots, err := s.store.JobSnapshots(linkedinJobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job snapshots: %w", err)
+	}
+	return snapshots, nil
+}
+
+// ListJobs returns the jobs matching filter
+func (s *DataService) ListJobs(filter models.JobFilter) ([]models.JobPosting, error) {
+	jobs, err := s.store.ListJobs(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// GetJob returns a job by LinkedIn job ID, or nil if it isn't stored
+func (s *DataService) GetJob(linkedinJobID int) (*models.JobPosting, error) {
+	jobs, err := s.store.ListJobs(models.JobFilter{LinkedInJobID: linkedinJobID, Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job %d: %w", linkedinJobID, err)
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return &jobs[0], nil
+}
+
+// GetJobRatings returns the ratings of a job (by JobID), one per rating type
+func (s *DataService) GetJobRatings(jobID int) ([]models.JobRating, error) {
+	ratings, err := s.store.JobRatings(jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job ratings: %w", err)
+	}
+	return ratings, nil
+}
+
+// ListCompanies returns the companies matching filter
+func (s *DataService) ListCompanies(filter models.CompanyFilter) ([]models.Company, error) {
+	companies, err := s.store.ListCompanies(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list companies: %w", err)
+	}
+	return companies, nil
 }
 
 // CreateJob creates a new job posting
@@ -120,11 +250,11 @@ func (s *DataService) CreateJob(job *models.JobPosting) (*models.JobPosting, err
 		return nil, fmt.Errorf("job already exists (LinkedIn ID: %d)", job.LinkedInJobID)
 	}
 
-	// Create job via API
-	logrus.Debugf("🆕 Creating new job via API: %d - %s", job.LinkedInJobID, job.Title)
-	createdJob, err := s.apiClient.CreateJob(job)
+	// Create job in the store
+	logrus.Debugf("🆕 Creating new job: %d - %s", job.LinkedInJobID, job.Title)
+	createdJob, err := s.store.CreateJob(job)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create job via API: %w", err)
+		return nil, fmt.Errorf("failed to create job: %w", err)
 	}
 
 	// Update cache to reflect that this job now exists
@@ -133,14 +263,14 @@ func (s *DataService) CreateJob(job *models.JobPosting) (*models.JobPosting, err
 	return createdJob, nil
 }
 
-// PreloadJobIDsToCache fetches all LinkedIn job IDs from API and populates Redis cache
+// PreloadJobIDsToCache fetches all LinkedIn job IDs from the store and populates Redis cache
 func (s *DataService) PreloadJobIDsToCache() error {
 	logrus.Info("🔄 Preloading existing job IDs to Redis cache...")
 
-	// Get all LinkedIn job IDs from API
-	jobIDs, err := s.apiClient.GetAllJobIDs()
+	// Get all LinkedIn job IDs from the store
+	jobIDs, err := s.store.JobIDs()
 	if err != nil {
-		return fmt.Errorf("failed to fetch job IDs from API: %w", err)
+		return fmt.Errorf("failed to fetch job IDs: %w", err)
 	}
 
 	if len(jobIDs) == 0 {
@@ -157,30 +287,6 @@ func (s *DataService) PreloadJobIDsToCache() error {
 	return nil
 }
 
-// PreloadCompanyNamesToCache fetches all company names from API and populates Redis cache
-func (s *DataService) PreloadCompanyNamesToCache() error {
-	logrus.Info("🔄 Preloading existing company names to Redis cache...")
-
-	// Get all company names from API
-	companyNames, err := s.apiClient.GetAllCompanyNames()
-	if err != nil {
-		return fmt.Errorf("failed to fetch company names from API: %w", err)
-	}
-
-	if len(companyNames) == 0 {
-		logrus.Info("📝 No existing companies found in database")
-		return nil
-	}
-
-	// Add all company names to cache as existing
-	for _, companyName := range companyNames {
-		s.cache.SetCompanyExists(companyName, true)
-	}
-
-	logrus.Infof("✅ Successfully preloaded %d company names to Redis cache", len(companyNames))
-	return nil
-}
-
 // ExtractJobIDFromURL extracts the LinkedIn job ID from a job URL (helper method)
 func (s *DataService) ExtractJobIDFromURL(jobURL string) (int, error) {
 	// This is the same logic from the scraper - we can move it here for reuse
@@ -216,17 +322,19 @@ func (s *DataService) IsJobNew(jobURL string) bool {
 	return !exists
 }
 
-// Close closes the cache connection
+// Close closes the store and the cache connection
 func (s *DataService) Close() error {
-	return s.cache.Close()
+	storeErr := s.store.Close()
+	if err := s.cache.Close(); err != nil {
+		return err
+	}
+	return storeErr
 }
 
-// QueueJobForProcessing adds a job ID to the Redis processing queue only if it doesn't already exist
+// QueueJobForProcessing adds a job ID to the processing queue only if it doesn't already exist
 func (s *DataService) QueueJobForProcessing(jobID, jobURL string) error {
-	queueKey := "job_processing_queue"
-
 	// Add job ID to queue only if it doesn't already exist
-	added, err := s.cache.AddJobToQueueIfNotExists(queueKey, jobID)
+	added, err := s.queue.Push(jobID)
 	if err != nil {
 		return fmt.Errorf("failed to queue job for processing: %w", err)
 	}
@@ -236,16 +344,14 @@ func (s *DataService) QueueJobForProcessing(jobID, jobURL string) error {
 	} else {
 		logrus.Debugf("⏭️  Job ID %s already in queue, skipping", jobID)
 	}
-	
+
 	return nil
 }
 
-// GetNextJobFromQueue gets the next job from the Redis processing queue
+// GetNextJobFromQueue gets the next job from the processing queue
 func (s *DataService) GetNextJobFromQueue() (jobID, jobURL string, err error) {
-	queueKey := "job_processing_queue"
-
-	// Get job ID from the right side of the list (FIFO)
-	jobID, err = s.cache.RPop(queueKey)
+	// Oldest job first (FIFO)
+	jobID, err = s.queue.Pop()
 	if err != nil {
 		return "", "", fmt.Errorf("failed to get job from queue: %w", err)
 	}
@@ -262,27 +368,45 @@ func (s *DataService) GetNextJobFromQueue() (jobID, jobURL string, err error) {
 	return jobID, jobURL, nil
 }
 
-// RemoveJobFromQueue removes a specific job from the processing queue (for error handling)
+// RemoveJobFromQueue marks a dequeued job as done
 func (s *DataService) RemoveJobFromQueue(jobID string) error {
-	// For simplicity, we don't need to implement this since we already popped the job
-	// This method is here for interface compatibility and future enhancements
+	if err := s.queue.Finish(jobID, models.StatusDone); err != nil {
+		return fmt.Errorf("failed to finish job in queue: %w", err)
+	}
 	logrus.Debugf("🗑️  Removed job ID %s from queue", jobID)
 	return nil
 }
 
+// FailJobInQueue marks a dequeued job that could not be scraped or saved
+func (s *DataService) FailJobInQueue(jobID string) error {
+	if err := s.queue.Finish(jobID, models.StatusError); err != nil {
+		return fmt.Errorf("failed to finish job in queue: %w", err)
+	}
+	logrus.Debugf("🗑️  Removed failed job ID %s from queue", jobID)
+	return nil
+}
+
 // GetQueueLength returns the number of jobs waiting in the processing queue
 func (s *DataService) GetQueueLength() (int, error) {
-	queueKey := "job_processing_queue"
-	length, err := s.cache.LLen(queueKey)
+	length, err := s.queue.Len()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get queue length: %w", err)
 	}
 	return length, nil
 }
 
+// GetQueueStats counts the jobs in the processing queue by status
+func (s *DataService) GetQueueStats() (models.QueueStats, error) {
+	stats, err := s.queue.Stats()
+	if err != nil {
+		return stats, fmt.Errorf("failed to get queue stats: %w", err)
+	}
+	return stats, nil
+}
+
 // IsJobInQueue checks if a job ID is already in the processing queue
 func (s *DataService) IsJobInQueue(jobID string) (bool, error) {
-	return s.cache.IsJobInQueue(jobID)
+	return s.queue.Contains(jobID)
 }
 
 // Helper functions
@@ -331,7 +455,7 @@ func (s *DataService) ClearJobExistsCache() error {
 	}
 
 	// Clear job processing queue
-	if err := s.cache.ClearJobProcessingQueue(); err != nil {
+	if err := s.queue.Clear(); err != nil {
 		return fmt.Errorf("failed to clear job processing queue: %w", err)
 	}
 
@@ -340,5 +464,5 @@ func (s *DataService) ClearJobExistsCache() error {
 
 // GetQueueSize returns the current size of the job processing queue
 func (s *DataService) GetQueueSize() (int, error) {
-	return s.cache.GetQueueSize()
+	return s.queue.Len()
 }
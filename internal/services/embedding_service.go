@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"linkedin-job-scraper/internal/embeddings"
+	"linkedin-job-scraper/internal/models"
+
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateEmbeddings embeds open jobs that are new or changed since they were added to idx.
+// With limit 0 every open job is fetched and jobs that are no longer open are removed from
+// the index; with a limit only the newest jobs are checked and nothing is removed.
+func (ds *DataService) UpdateEmbeddings(ctx context.Context, embedder embeddings.Embedder, idx *embeddings.Index, limit int) (embedded, removed int, err error) {
+	jobs, err := ds.store.ListJobs(models.JobFilter{Open: true, NewestFirst: true, Limit: limit})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get open jobs: %w", err)
+	}
+
+	var pending []*models.JobPosting
+	var hashes []uint64
+	var texts []string
+	open := make(map[int]bool, len(jobs))
+	for i := range jobs {
+		job := &jobs[i]
+		open[job.LinkedInJobID] = true
+		if needed, hash := idx.NeedsEmbedding(job); needed {
+			pending = append(pending, job)
+			hashes = append(hashes, hash)
+			texts = append(texts, embeddings.JobText(job))
+		}
+	}
+
+	if limit == 0 {
+		removed = idx.Retain(open)
+	}
+	logrus.Infof("🧮 %d open jobs, %d to embed, %d closed jobs removed", len(jobs), len(pending), removed)
+	if len(pending) == 0 {
+		return 0, removed, nil
+	}
+
+	vectors, err := embedder.Embed(ctx, texts)
+	if err != nil {
+		return 0, removed, err
+	}
+	for i, job := range pending {
+		idx.Put(job, hashes[i], vectors[i])
+	}
+
+	return len(pending), removed, nil
+}
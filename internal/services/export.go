@@ -0,0 +1,86 @@
+package services
+
+import (
+	"fmt"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// exportBatchSize is how many jobs or companies are fetched from the store at a time
+const exportBatchSize = 500
+
+// EachJob calls fn for every job matching filter, oldest first. Jobs are fetched from the
+// store in batches, so exports never hold all jobs in memory. filter.Limit caps the total.
+func (s *DataService) EachJob(filter models.JobFilter, fn func(job *models.JobPosting) error) error {
+	total := filter.Limit
+	batch := filter
+	batch.NewestFirst = false
+	batch.Offset = 0
+
+	seen := 0
+	var firstID int
+	for total <= 0 || seen < total {
+		batch.Limit = exportBatchSize
+		if total > 0 {
+			batch.Limit = min(exportBatchSize, total-seen)
+		}
+
+		jobs, err := s.store.ListJobs(batch)
+		if err != nil {
+			return fmt.Errorf("failed to list jobs: %w", err)
+		}
+		if len(jobs) == 0 {
+			return nil
+		}
+		// A backend that ignores the offset would hand out the first batch forever
+		if batch.Offset > 0 && jobs[0].JobID == firstID {
+			return fmt.Errorf("storage backend returned the same jobs for offset %d, it doesn't support paging", batch.Offset)
+		}
+		firstID = jobs[0].JobID
+
+		for i := range jobs {
+			if err := fn(&jobs[i]); err != nil {
+				return err
+			}
+		}
+		seen += len(jobs)
+		if len(jobs) < batch.Limit {
+			return nil
+		}
+		batch.Offset += len(jobs)
+	}
+	return nil
+}
+
+// EachCompany calls fn for every company matching filter, in ID order, fetching them from
+// the store in batches
+func (s *DataService) EachCompany(filter models.CompanyFilter, fn func(company *models.Company) error) error {
+	batch := filter
+	batch.Limit = exportBatchSize
+	batch.Offset = 0
+
+	var firstID int
+	for {
+		companies, err := s.store.ListCompanies(batch)
+		if err != nil {
+			return fmt.Errorf("failed to list companies: %w", err)
+		}
+		if len(companies) == 0 {
+			return nil
+		}
+		if batch.Offset > 0 && companies[0].CompanyID == firstID {
+			return fmt.Errorf("storage backend returned the same companies for offset %d, it doesn't support paging", batch.Offset)
+		}
+		firstID = companies[0].CompanyID
+
+		for i := range companies {
+			if err := fn(&companies[i]); err != nil {
+				return err
+			}
+		}
+		if len(companies) < batch.Limit {
+			return nil
+		}
+		batch.Offset += len(companies)
+	}
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"fmt"
+	"linkedin-job-scraper/internal/match"
+	"linkedin-job-scraper/internal/models"
+)
+
+// RankProfilesForJob loads a job by its LinkedIn job ID and ranks up to limit user profiles
+// collected by linkedin-user-scraper for it, best first
+func (ds *DataService) RankProfilesForJob(matcher *match.ProfileMatcher, linkedinJobID, limit int) (*models.JobPosting, []match.ProfileMatch, error) {
+	if ds.standalone {
+		return nil, nil, fmt.Errorf("user profiles are only available from the Laravel API, which standalone mode doesn't use")
+	}
+
+	jobs, err := ds.store.ListJobs(models.JobFilter{LinkedInJobID: linkedinJobID, Limit: 1})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get job: %w", err)
+	}
+	if len(jobs) == 0 {
+		return nil, nil, fmt.Errorf("job %d not found", linkedinJobID)
+	}
+	job := &jobs[0]
+
+	profiles, err := ds.apiClient.GetUserProfiles(limit)
+	if err != nil {
+		return job, nil, fmt.Errorf("failed to get user profiles via API: %w", err)
+	}
+
+	return job, matcher.Rank(job, profiles), nil
+}
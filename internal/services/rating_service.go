@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"linkedin-job-scraper/internal/ai"
+	"linkedin-job-scraper/internal/match"
+	"linkedin-job-scraper/internal/models"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RateJobs scores up to limit open jobs that have no rules rating yet with matcher and saves
+// the ratings. With dryRun the ratings are only logged. Returns the ratings made.
+func (ds *DataService) RateJobs(matcher *match.Matcher, limit int, dryRun bool) ([]models.JobRating, error) {
+	jobs, err := ds.store.ListJobs(models.JobFilter{Open: true, UnratedBy: match.RatingType, NewestFirst: true, Limit: limit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unrated jobs: %w", err)
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	companiesByID, err := ds.companiesByID()
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := make([]models.JobRating, 0, len(jobs))
+	now := time.Now()
+	for i := range jobs {
+		job := &jobs[i]
+
+		rating := matcher.Rate(job, companiesByID[job.CompanyID], now)
+		logrus.Infof("⭐ %3d/100  %s (job %d)", rating.OverallScore, job.Title, job.LinkedInJobID)
+		for rule, result := range *rating.Criteria {
+			logrus.Debugf("    %s: %+v", rule, result)
+		}
+
+		if !dryRun {
+			if _, err := ds.store.CreateRating(rating); err != nil {
+				return ratings, fmt.Errorf("failed to save rating for job %d: %w", job.LinkedInJobID, err)
+			}
+		}
+		ratings = append(ratings, *rating)
+	}
+
+	return ratings, nil
+}
+
+// RateJobsWithAI rates up to limit open jobs that have no AI rating yet with rater, which
+// limits how many requests are in flight. Jobs the model fails to rate are logged and left
+// unrated for the next run; a rating that can't be saved stops the run. With dryRun the
+// ratings are only logged. Token usage and cost are available from rater.Stats().
+func (ds *DataService) RateJobsWithAI(ctx context.Context, rater *ai.Rater, limit int, dryRun bool) ([]models.JobRating, error) {
+	jobs, err := ds.store.ListJobs(models.JobFilter{Open: true, UnratedBy: ai.RatingType, NewestFirst: true, Limit: limit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unrated jobs: %w", err)
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	companiesByID, err := ds.companiesByID()
+	if err != nil {
+		return nil, err
+	}
+
+	queue := make([]ai.Job, len(jobs))
+	for i := range jobs {
+		queue[i] = ai.Job{Posting: &jobs[i], Company: companiesByID[jobs[i].CompanyID]}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var ratings []models.JobRating
+	var saveErr error
+	rater.RateAll(ctx, queue, func(result ai.Result) {
+		job := result.Job.Posting
+		if result.Err != nil {
+			logrus.Warnf("⚠️  %s (job %d): %v", job.Title, job.LinkedInJobID, result.Err)
+			return
+		}
+		logrus.Infof("🤖 %3d/100  %s (job %d, %d tokens)", result.Rating.OverallScore, job.Title, job.LinkedInJobID, result.Usage.Total())
+
+		if !dryRun && saveErr == nil {
+			if _, err := ds.store.CreateRating(result.Rating); err != nil {
+				saveErr = fmt.Errorf("failed to save rating for job %d: %w", job.LinkedInJobID, err)
+				cancel()
+				return
+			}
+		}
+		ratings = append(ratings, *result.Rating)
+	})
+
+	return ratings, saveErr
+}
+
+// companiesByID fetches all companies, keyed by company ID for joining with jobs
+func (ds *DataService) companiesByID() (map[int64]*models.Company, error) {
+	companies, err := ds.store.ListCompanies(models.CompanyFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get companies: %w", err)
+	}
+	companiesByID := make(map[int64]*models.Company, len(companies))
+	for i := range companies {
+		companiesByID[int64(companies[i].CompanyID)] = &companies[i]
+	}
+	return companiesByID, nil
+}
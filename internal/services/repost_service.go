@@ -0,0 +1,94 @@
+package services
+
+import (
+	"fmt"
+	"linkedin-job-scraper/internal/dedupe"
+	"linkedin-job-scraper/internal/models"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LinkRepost fingerprints a new job and, if it is a repost of an earlier posting from the same
+// company, links it to the first version. Returns the matched earlier posting or nil.
+func (ds *DataService) LinkRepost(job *models.JobPosting) (*models.JobFingerprint, error) {
+	fingerprint := dedupe.JobFingerprint(job)
+	if fingerprint == "" {
+		return nil, nil
+	}
+	job.Fingerprint = &fingerprint
+
+	candidates, err := ds.store.JobFingerprints(job.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job fingerprints: %w", err)
+	}
+
+	original := dedupe.FindRepost(job, fingerprint, candidates)
+	if original == nil {
+		return nil, nil
+	}
+
+	originalID := original.OriginalJobID()
+	job.RepostOfJobID = &originalID
+	logrus.Debugf("🔁 Job %d is a repost of job %d (%s)", job.LinkedInJobID, original.LinkedInJobID, original.Title)
+	return original, nil
+}
+
+// DetectReposts fingerprints up to limit stored jobs that have no fingerprint yet and links
+// reposts to their earlier versions. Returns the number of jobs fingerprinted and reposts found.
+func (ds *DataService) DetectReposts(limit int) (processed int, reposts int, err error) {
+	jobs, err := ds.store.ListJobs(models.JobFilter{MissingFingerprint: true, Limit: limit})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get jobs without fingerprint: %w", err)
+	}
+
+	// Oldest first, so a repost is always compared against postings that came before it
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return jobs[i].PostedDate.Before(jobs[j].PostedDate)
+	})
+
+	fingerprintsByCompany := make(map[int64][]models.JobFingerprint)
+	for i := range jobs {
+		job := &jobs[i]
+
+		fingerprint := dedupe.JobFingerprint(job)
+		if fingerprint == "" {
+			logrus.Debugf("⏭️  Job %d has no description to fingerprint", job.LinkedInJobID)
+			continue
+		}
+		job.Fingerprint = &fingerprint
+
+		candidates, ok := fingerprintsByCompany[job.CompanyID]
+		if !ok {
+			candidates, err = ds.store.JobFingerprints(job.CompanyID)
+			if err != nil {
+				return processed, reposts, fmt.Errorf("failed to get job fingerprints: %w", err)
+			}
+		}
+
+		if original := dedupe.FindRepost(job, fingerprint, candidates); original != nil {
+			originalID := original.OriginalJobID()
+			job.RepostOfJobID = &originalID
+			reposts++
+			logrus.Debugf("🔁 Job %d is a repost of job %d (%s)", job.LinkedInJobID, original.LinkedInJobID, original.Title)
+		}
+
+		if err := ds.UpdateJob(job); err != nil {
+			return processed, reposts, err
+		}
+		processed++
+
+		// Later jobs in this batch may be reposts of this one
+		fingerprintsByCompany[job.CompanyID] = append(candidates, models.JobFingerprint{
+			JobID:         job.JobID,
+			LinkedInJobID: job.LinkedInJobID,
+			CompanyID:     job.CompanyID,
+			Title:         job.Title,
+			Fingerprint:   fingerprint,
+			PostedDate:    job.PostedDate,
+			RepostOfJobID: job.RepostOfJobID,
+		})
+	}
+
+	return processed, reposts, nil
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+
+	"github.com/sirupsen/logrus"
+)
+
+// StartRun begins recording a run of a scraping command. Pass the result to FinishRun.
+func (s *DataService) StartRun(command, arguments string) *models.ScrapeRun {
+	return &models.ScrapeRun{
+		Command:   command,
+		Arguments: arguments,
+		StartedAt: time.Now(),
+	}
+}
+
+// FinishRun saves a run started with StartRun, failed if err is set. The run history is
+// informational, so a failure to save it is only logged.
+func (s *DataService) FinishRun(run *models.ScrapeRun, err error) {
+	run.FinishedAt = time.Now()
+	run.Status = models.RunStatusSucceeded
+	if err != nil {
+		run.Status = models.RunStatusFailed
+		run.Error = err.Error()
+	}
+
+	if _, err := s.store.RecordRun(run); err != nil {
+		logrus.Warnf("⚠️  Failed to record %s run: %v", run.Command, err)
+	}
+}
+
+// ListRuns returns the most recent runs of scraping commands, newest first
+func (s *DataService) ListRuns(limit int) ([]models.ScrapeRun, error) {
+	runs, err := s.store.ListRuns(limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list runs: %w", err)
+	}
+	return runs, nil
+}
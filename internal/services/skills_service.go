@@ -0,0 +1,59 @@
+package services
+
+import (
+	"fmt"
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/skills"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// BackfillSkills normalises the skills of up to limit jobs without canonical skill IDs, and
+// extracts skills from the description of those LinkedIn's insights gave none. Jobs without
+// known skills get an empty list of skill IDs, so they aren't checked again. With dryRun
+// the skills are only logged. Returns the number of jobs checked and jobs given skills.
+func (ds *DataService) BackfillSkills(extractor *skills.Extractor, limit int, dryRun bool) (processed int, filled int, err error) {
+	jobs, err := ds.store.ListJobs(models.JobFilter{MissingSkillIDs: true, Limit: limit})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get jobs without skill IDs: %w", err)
+	}
+
+	for i := range jobs {
+		job := &jobs[i]
+		processed++
+
+		if !extractor.ApplyTo(job) {
+			none := models.SkillsSourceNone
+			job.SkillsSource = &none
+		}
+		if job.SkillIDs == nil || len(*job.SkillIDs) == 0 {
+			// An empty list records that the job was checked, so the next run moves on
+			logrus.Debugf("⏭️  No known skills for job %d (%s)", job.LinkedInJobID, job.Title)
+			checked := models.SkillsList{}
+			job.SkillIDs = &checked
+			if !dryRun {
+				if err := ds.UpdateJob(job); err != nil {
+					return processed, filled, err
+				}
+			}
+			continue
+		}
+		filled++
+
+		source := models.SkillsSourceInsights
+		if job.SkillsSource != nil {
+			source = *job.SkillsSource
+		}
+		logrus.Infof("🧠 Job %d (%s): %s [%s]", job.LinkedInJobID, job.Title, strings.Join(*job.SkillIDs, ", "), source)
+
+		if dryRun {
+			continue
+		}
+		if err := ds.UpdateJob(job); err != nil {
+			return processed, filled, err
+		}
+	}
+
+	return processed, filled, nil
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+	"linkedin-job-scraper/internal/skills"
+	"linkedin-job-scraper/internal/storage"
+)
+
+func TestBackfillSkillsMovesOnPastJobsWithoutSkills(t *testing.T) {
+	store, err := storage.OpenJSONLStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("OpenJSONLStore() error = %v", err)
+	}
+	defer store.Close()
+	taxonomy, err := skills.LoadTaxonomy("../../" + skills.DefaultTaxonomyPath)
+	if err != nil {
+		t.Fatalf("LoadTaxonomy() error = %v", err)
+	}
+	ds := &DataService{store: store}
+
+	posted := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	unknown := models.SkillsList{"Underwater Basket Weaving"}
+	jobs := []models.JobPosting{
+		{LinkedInJobID: 1, Title: "Receptionist", Description: "Greet our guests."},
+		{LinkedInJobID: 2, Title: "Weaver", Skills: &unknown},
+		{LinkedInJobID: 3, Title: "Backend Developer", Description: "We use Go and Docker."},
+	}
+	for i := range jobs {
+		jobs[i].PostedDate = posted.AddDate(0, 0, i)
+		if _, err := store.CreateJob(&jobs[i]); err != nil {
+			t.Fatalf("CreateJob() error = %v", err)
+		}
+	}
+
+	// The first run only reaches the two jobs without known skills
+	if processed, filled, err := ds.BackfillSkills(skills.NewExtractor(taxonomy), 2, false); err != nil || processed != 2 || filled != 0 {
+		t.Fatalf("BackfillSkills() = %d, %d, %v, want 2 checked and none filled", processed, filled, err)
+	}
+	if processed, filled, err := ds.BackfillSkills(skills.NewExtractor(taxonomy), 2, false); err != nil || processed != 1 || filled != 1 {
+		t.Fatalf("second BackfillSkills() = %d, %d, %v, want the next job checked and filled", processed, filled, err)
+	}
+
+	sources := make(map[int]string)
+	for _, linkedinJobID := range []int{1, 2, 3} {
+		list, _ := store.ListJobs(models.JobFilter{LinkedInJobID: linkedinJobID})
+		job := list[0]
+		if job.SkillIDs == nil || job.SkillsSource == nil {
+			t.Fatalf("job %d has skill IDs %v and source %v, want both set", linkedinJobID, job.SkillIDs, job.SkillsSource)
+		}
+		sources[linkedinJobID] = *job.SkillsSource
+		if linkedinJobID == 3 && !reflect.DeepEqual(*job.SkillIDs, models.SkillsList{"go", "docker"}) {
+			t.Errorf("job 3 skill IDs = %v, want [go docker]", *job.SkillIDs)
+		}
+	}
+	want := map[int]string{1: models.SkillsSourceNone, 2: models.SkillsSourceInsights, 3: models.SkillsSourceDescription}
+	if !reflect.DeepEqual(sources, want) {
+		t.Errorf("skills sources = %v, want %v", sources, want)
+	}
+}
@@ -0,0 +1,223 @@
+package skills
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// Extractor finds taxonomy skills mentioned in free text such as job descriptions
+type Extractor struct {
+	taxonomy *Taxonomy
+	terms    []term
+}
+
+// term is one label of a skill with its matching rules
+type term struct {
+	skill         *Skill
+	text          string // Lowercased unless caseSensitive
+	caseSensitive bool
+	plainWord     bool // Only letters, so it can also be an ordinary word
+}
+
+// mention is a term found at text[start:end]
+type mention struct {
+	start, end int
+	term       *term
+}
+
+// minCaseInsensitiveLetters is the shortest label matched regardless of case. Shorter
+// labels are abbreviations ("JS", "AWS", "C#", ".NET") that are only meaningful in their
+// exact casing; lowercase "go", "ai" or "net" are ordinary words.
+const minCaseInsensitiveLetters = 4
+
+// NewExtractor creates an extractor for every label in the taxonomy. Single-character
+// labels ("C") and labels with a parenthesised qualifier are skipped, since they don't
+// occur in running text. A nil taxonomy gives a nil extractor, which finds nothing.
+func NewExtractor(t *Taxonomy) *Extractor {
+	if t == nil {
+		return nil
+	}
+
+	e := &Extractor{taxonomy: t}
+	for i := range t.Skills {
+		skill := &t.Skills[i]
+		seen := map[string]bool{}
+		for _, label := range append([]string{skill.Name}, skill.labels()[2:]...) {
+			label = strings.TrimSpace(label)
+			if utf8.RuneCountInString(label) < 2 || strings.ContainsAny(label, "()") || seen[label] {
+				continue
+			}
+			seen[label] = true
+
+			letters, plainWord := 0, true
+			for _, r := range label {
+				if unicode.IsLetter(r) {
+					letters++
+				} else {
+					plainWord = false
+				}
+			}
+
+			caseSensitive := skill.Ambiguous || letters < minCaseInsensitiveLetters
+			text := label
+			if !caseSensitive {
+				text = strings.ToLower(label)
+			}
+			e.terms = append(e.terms, term{skill: skill, text: text, caseSensitive: caseSensitive, plainWord: plainWord})
+		}
+	}
+	return e
+}
+
+// Extract returns the skills mentioned in text in order of first mention, without
+// duplicates. Where labels overlap the longest wins, so "React Native" is not also "React"
+// and "ASP.NET Core" is not also ".NET".
+func (e *Extractor) Extract(text string) []*Skill {
+	if e == nil || text == "" {
+		return nil
+	}
+
+	lower := strings.ToLower(text)
+	if len(lower) != len(text) {
+		// A few characters change byte length when lowercased; fold only ASCII so offsets
+		// in both texts stay the same
+		lower = strings.Map(func(r rune) rune {
+			if r < utf8.RuneSelf {
+				return unicode.ToLower(r)
+			}
+			return r
+		}, text)
+	}
+
+	var mentions []mention
+	for i := range e.terms {
+		t := &e.terms[i]
+		haystack := lower
+		if t.caseSensitive {
+			haystack = text
+		}
+		for offset := 0; offset < len(haystack); {
+			j := strings.Index(haystack[offset:], t.text)
+			if j < 0 {
+				break
+			}
+			start, end := offset+j, offset+j+len(t.text)
+			if t.accepts(text, start, end) {
+				mentions = append(mentions, mention{start: start, end: end, term: t})
+			}
+			offset = start + 1
+		}
+	}
+
+	// Leftmost-longest, non-overlapping
+	sort.Slice(mentions, func(i, j int) bool {
+		if mentions[i].start != mentions[j].start {
+			return mentions[i].start < mentions[j].start
+		}
+		return mentions[i].end > mentions[j].end
+	})
+
+	var found []*Skill
+	seen := map[string]bool{}
+	covered := 0
+	for _, m := range mentions {
+		if m.start < covered {
+			continue
+		}
+		covered = m.end
+		if !seen[m.term.skill.ID] {
+			seen[m.term.skill.ID] = true
+			found = append(found, m.term.skill)
+		}
+	}
+	return found
+}
+
+// accepts checks the word boundaries around a match at text[start:end]. Labels may start
+// or end with symbols ("C#", "C++", ".NET", "Node.js"), so only the characters around the
+// match are checked: "C#" matches "C#, Azure" but not "C#m", ".NET" matches "C#/.NET" but
+// not "ASP.NET" (which is its own label).
+func (t *term) accepts(text string, start, end int) bool {
+	before, _ := utf8.DecodeLastRuneInString(text[:start])
+	after, afterSize := utf8.DecodeRuneInString(text[end:])
+	if start > 0 && isWordRune(before) || end < len(text) && isWordRune(after) {
+		return false
+	}
+	// "C++" shouldn't match the start of "C+++" and "C#" not of "C##"
+	last, _ := utf8.DecodeLastRuneInString(t.text)
+	if !isWordRune(last) && end < len(text) && after == last {
+		return false
+	}
+
+	if !t.skill.Ambiguous || !t.plainWord {
+		return true
+	}
+	// An ambiguous word is not a skill when hyphenated ("Go-live", "React-ready"). As the first
+	// word of a sentence or line it must stand alone, as in a list ("Go, Docker"), not start
+	// a phrase ("Go ahead and apply", "Swift onboarding").
+	if end < len(text) && after == '-' {
+		if next, _ := utf8.DecodeRuneInString(text[end+afterSize:]); isWordRune(next) {
+			return false
+		}
+	}
+	return !sentenceStart(text[:start]) || standsAlone(text[end:])
+}
+
+// sentenceStart reports whether the text before a word ends a sentence, a line or a bullet
+func sentenceStart(before string) bool {
+	before = strings.TrimRight(before, " \t ")
+	if before == "" {
+		return true
+	}
+	last, _ := utf8.DecodeLastRuneInString(before)
+	return strings.ContainsRune(".!?\n\r•·*-–", last)
+}
+
+// standsAlone reports whether the text after a word ends it as a list item
+func standsAlone(after string) bool {
+	after = strings.TrimLeft(after, " \t ")
+	if after == "" {
+		return true
+	}
+	next, _ := utf8.DecodeRuneInString(after)
+	return strings.ContainsRune(",;/()&\n\r", next)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// ApplyTo sets a job's canonical skill IDs and where its skills came from. Skills from
+// LinkedIn's insights are kept as they are and normalised; a job without them gets the
+// skills mentioned in its description. Returns false if the job has no skills either way.
+// A nil extractor only marks insights skills.
+func (e *Extractor) ApplyTo(job *models.JobPosting) bool {
+	if job.Skills != nil && len(*job.Skills) > 0 {
+		source := models.SkillsSourceInsights
+		job.SkillsSource = &source
+		if e != nil {
+			if ids := e.taxonomy.CanonicalIDs(*job.Skills); len(ids) > 0 {
+				skillIDs := models.SkillsList(ids)
+				job.SkillIDs = &skillIDs
+			}
+		}
+		return true
+	}
+
+	found := e.Extract(job.Title + "\n" + job.Description)
+	if len(found) == 0 {
+		return false
+	}
+	labels := make(models.SkillsList, len(found))
+	ids := make(models.SkillsList, len(found))
+	for i, skill := range found {
+		labels[i], ids[i] = skill.Name, skill.ID
+	}
+	source := models.SkillsSourceDescription
+	job.Skills, job.SkillIDs, job.SkillsSource = &labels, &ids, &source
+	return true
+}
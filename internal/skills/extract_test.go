@@ -0,0 +1,46 @@
+package skills
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	extractor := NewExtractor(loadRepositoryTaxonomy(t))
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"go in a list", "We use Go, Docker and Kubernetes.", []string{"go", "docker", "kubernetes"}},
+		{"go as a verb", "Go ahead and apply! We go the extra mile with Google and MongoDB.", []string{"mongodb"}},
+		{"go at a list line start", "Requirements:\n- Go\n- PostgreSQL", []string{"go", "postgresql"}},
+		{"go hyphenated", "Go-live is in May, and we go-to-market with Golang.", []string{"go"}},
+		{"csharp and dotnet", "Experience with C#/.NET and Azure", []string{"csharp", "dotnet", "azure"}},
+		{"asp.net is one skill", "ASP.NET Core backend, some C++ too", []string{"dotnet", "cpp"}},
+		{"csharp boundary", "C#m and c# don't count, C# does", []string{"csharp"}},
+		{"react native wins over react", "Mobile apps in React Native, web in React.", []string{"react-native", "react"}},
+		{"react as a verb", "You react quickly to incidents. React fast.", nil},
+		{"node.js", "Backend in Node.js with TypeScript", []string{"nodejs", "typescript"}},
+		{"case insensitive long labels", "erfaring med laravel, PHP og docker", []string{"laravel", "php", "docker"}},
+		{"danish variants", "Du har erfaring med projektledelse og IT-sikkerhed", []string{"project-management", "it-security"}},
+		{"no single letters", "Plan A, B or C", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, skill := range extractor.Extract(tt.text) {
+				got = append(got, skill.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Extract(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+
+	var nilExtractor *Extractor
+	if got := nilExtractor.Extract("Go, Docker"); got != nil {
+		t.Errorf("nil extractor found %v", got)
+	}
+}
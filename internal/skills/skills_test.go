@@ -0,0 +1,80 @@
+package skills
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func loadRepositoryTaxonomy(t *testing.T) *Taxonomy {
+	t.Helper()
+	taxonomy, err := LoadTaxonomy("../../" + DefaultTaxonomyPath)
+	if err != nil {
+		t.Fatalf("LoadTaxonomy() error = %v", err)
+	}
+	return taxonomy
+}
+
+func TestNormalise(t *testing.T) {
+	taxonomy := loadRepositoryTaxonomy(t)
+
+	tests := []struct {
+		label string
+		want  string
+	}{
+		{"Go", "go"},
+		{"Golang", "go"},
+		{"Go (Programming Language)", "go"},
+		{"go  (programmeringssprog)", "go"},
+		{"C#", "csharp"},
+		{"ASP.NET Core", "dotnet"},
+		{"React.js", "react"},
+		{"React Native", "react-native"},
+		{"Amazon Web Services (AWS)", "aws"},
+		{"Kotlin (Programming Language)", "kotlin"}, // Qualifier dropped
+		{"Projektledelse", "project-management"},
+		{"Projektledning", "project-management"},
+		{"IT-sikkerhed", "it-security"},
+		{"Dansk", "danish"},
+		{"Basket Weaving", ""},
+	}
+	for _, tt := range tests {
+		got, ok := taxonomy.Normalise(tt.label)
+		if got != tt.want || ok != (tt.want != "") {
+			t.Errorf("Normalise(%q) = %q, %v, want %q", tt.label, got, ok, tt.want)
+		}
+	}
+
+	var nilTaxonomy *Taxonomy
+	if _, ok := nilTaxonomy.Normalise("Go"); ok {
+		t.Error("nil taxonomy normalised a label")
+	}
+}
+
+func TestCanonicalIDs(t *testing.T) {
+	taxonomy := loadRepositoryTaxonomy(t)
+
+	got := taxonomy.CanonicalIDs([]string{"Golang", "Laravel", "Go", "Underwater Welding", "PHP (Programming Language)"})
+	if want := []string{"go", "laravel", "php"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CanonicalIDs() = %v, want %v", got, want)
+	}
+	if got := taxonomy.CategoryPath("react"); !reflect.DeepEqual(got, []string{"frontend", "web-development", "technology"}) {
+		t.Errorf("CategoryPath(react) = %v", got)
+	}
+}
+
+func TestParseTaxonomyValidation(t *testing.T) {
+	invalid := map[string]string{
+		"unknown category": `{"categories": [], "skills": [{"id": "go", "name": "Go", "category": "languages"}]}`,
+		"unknown parent":   `{"categories": [{"id": "a", "parent": "b"}], "skills": []}`,
+		"cycle":            `{"categories": [{"id": "a", "parent": "b"}, {"id": "b", "parent": "a"}], "skills": []}`,
+		"shared label": `{"categories": [{"id": "a"}], "skills": [
+			{"id": "go", "name": "Go", "category": "a"},
+			{"id": "golang", "name": "Golang", "category": "a", "aliases": ["go"]}]}`,
+	}
+	for name, data := range invalid {
+		if _, err := ParseTaxonomy(strings.NewReader(data)); err == nil {
+			t.Errorf("%s: ParseTaxonomy() succeeded", name)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Package skills maps skill labels as LinkedIn renders them ("Golang", "Go (Programming
+// Language)", "Projektledelse") to canonical skill IDs from skill_taxonomy.json.
+package skills
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// DefaultTaxonomyPath is the taxonomy shipped with the repository
+const DefaultTaxonomyPath = "skill_taxonomy.json"
+
+// Category groups skills; Parent is empty for top-level categories
+type Category struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Parent string `json:"parent,omitempty"`
+}
+
+// Skill is a canonical skill. Aliases are other names in any language; Variants are the
+// names used in LinkedIn's translated interfaces, by language code. Ambiguous skills have
+// names that are also ordinary words ("Go", "Swift", "React"), which the description
+// extractor only accepts in their exact casing and not as the first word of a sentence.
+type Skill struct {
+	ID        string              `json:"id"`
+	Name      string              `json:"name"`
+	Category  string              `json:"category"`
+	Ambiguous bool                `json:"ambiguous,omitempty"`
+	Aliases   []string            `json:"aliases,omitempty"`
+	Variants  map[string][]string `json:"variants,omitempty"`
+}
+
+// Taxonomy is a set of skills and categories with a lookup from every known label
+type Taxonomy struct {
+	Categories []Category `json:"categories"`
+	Skills     []Skill    `json:"skills"`
+
+	categories map[string]*Category
+	skills     map[string]*Skill
+	labels     map[string]string // Label key to skill ID
+}
+
+// LoadTaxonomy reads a taxonomy file
+func LoadTaxonomy(path string) (*Taxonomy, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open skill taxonomy: %w", err)
+	}
+	defer file.Close()
+	return ParseTaxonomy(file)
+}
+
+// ParseTaxonomy reads a taxonomy and checks that every category exists, categories don't
+// form cycles and no label belongs to two skills
+func ParseTaxonomy(r io.Reader) (*Taxonomy, error) {
+	var t Taxonomy
+	if err := json.NewDecoder(r).Decode(&t); err != nil {
+		return nil, fmt.Errorf("failed to parse skill taxonomy: %w", err)
+	}
+
+	t.categories = make(map[string]*Category, len(t.Categories))
+	for i := range t.Categories {
+		category := &t.Categories[i]
+		if t.categories[category.ID] != nil {
+			return nil, fmt.Errorf("duplicate category %q", category.ID)
+		}
+		t.categories[category.ID] = category
+	}
+	for _, category := range t.Categories {
+		seen := map[string]bool{}
+		for id := category.ID; id != ""; id = t.categories[id].Parent {
+			if seen[id] {
+				return nil, fmt.Errorf("category %q has a cycle of parents", category.ID)
+			}
+			seen[id] = true
+			if t.categories[id] == nil {
+				return nil, fmt.Errorf("category %q has unknown parent %q", category.ID, id)
+			}
+		}
+	}
+
+	t.skills = make(map[string]*Skill, len(t.Skills))
+	t.labels = make(map[string]string)
+	for i := range t.Skills {
+		skill := &t.Skills[i]
+		if t.skills[skill.ID] != nil {
+			return nil, fmt.Errorf("duplicate skill %q", skill.ID)
+		}
+		if t.categories[skill.Category] == nil {
+			return nil, fmt.Errorf("skill %q has unknown category %q", skill.ID, skill.Category)
+		}
+		t.skills[skill.ID] = skill
+
+		for _, label := range skill.labels() {
+			key := labelKey(label)
+			if other, ok := t.labels[key]; ok && other != skill.ID {
+				return nil, fmt.Errorf("label %q belongs to both %q and %q", label, other, skill.ID)
+			}
+			t.labels[key] = skill.ID
+		}
+	}
+
+	return &t, nil
+}
+
+// labels returns every name the skill is known by
+func (s *Skill) labels() []string {
+	labels := append([]string{s.ID, s.Name}, s.Aliases...)
+	for _, variants := range s.Variants {
+		labels = append(labels, variants...)
+	}
+	return labels
+}
+
+// Skill returns a skill by ID, or nil
+func (t *Taxonomy) Skill(id string) *Skill {
+	if t == nil {
+		return nil
+	}
+	return t.skills[id]
+}
+
+// trailingQualifier matches LinkedIn's disambiguation suffix, e.g. " (Programming Language)"
+var trailingQualifier = regexp.MustCompile(`\s*\([^()]*\)$`)
+
+// Normalise returns the canonical skill ID for a label. Labels are compared ignoring case
+// and spacing, and a trailing qualifier in parentheses is dropped if the full label is
+// unknown. A nil taxonomy knows no labels.
+func (t *Taxonomy) Normalise(label string) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	if id, ok := t.labels[labelKey(label)]; ok {
+		return id, true
+	}
+	if trimmed := trailingQualifier.ReplaceAllString(label, ""); trimmed != label && trimmed != "" {
+		id, ok := t.labels[labelKey(trimmed)]
+		return id, ok
+	}
+	return "", false
+}
+
+// CanonicalIDs returns the canonical IDs of labels in order, without duplicates. Unknown
+// labels are left out; they stay available in the raw labels.
+func (t *Taxonomy) CanonicalIDs(labels []string) []string {
+	var ids []string
+	seen := map[string]bool{}
+	for _, label := range labels {
+		if id, ok := t.Normalise(label); ok && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// CategoryPath returns the IDs of a skill's category and its parents, nearest first
+func (t *Taxonomy) CategoryPath(skillID string) []string {
+	skill := t.Skill(skillID)
+	if skill == nil {
+		return nil
+	}
+	var path []string
+	for id := skill.Category; id != ""; id = t.categories[id].Parent {
+		path = append(path, id)
+	}
+	return path
+}
+
+// labelKey folds case and whitespace
+func labelKey(label string) string {
+	return strings.Join(strings.Fields(strings.ToLower(label)), " ")
+}
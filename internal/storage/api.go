@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"linkedin-job-scraper/internal/api"
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/models"
+)
+
+// APIStore keeps jobs and companies in the Laravel API
+type APIStore struct {
+	client *api.Client
+}
+
+// NewAPIStore creates a store backed by the Laravel API
+func NewAPIStore(cfg *config.APIConfig) *APIStore {
+	return &APIStore{client: api.NewClient(cfg)}
+}
+
+func (s *APIStore) JobExists(linkedinJobID int) (bool, error) {
+	return s.client.CheckJobExists(linkedinJobID)
+}
+
+func (s *APIStore) CreateJob(job *models.JobPosting) (*models.JobPosting, error) {
+	return s.client.CreateJob(job)
+}
+
+func (s *APIStore) UpdateJob(job *models.JobPosting) error {
+	_, err := s.client.UpdateJob(job)
+	return err
+}
+
+func (s *APIStore) ListJobs(filter models.JobFilter) ([]models.JobPosting, error) {
+	return s.client.GetJobs(filter)
+}
+
+func (s *APIStore) JobIDs() ([]int, error) {
+	return s.client.GetAllJobIDs()
+}
+
+func (s *APIStore) FindCompany(ref *models.Company) (*models.Company, error) {
+	return s.client.CheckCompanyExists(ref)
+}
+
+func (s *APIStore) CreateCompany(ref *models.Company) (*models.Company, error) {
+	return s.client.CreateCompany(ref)
+}
+
+func (s *APIStore) UpdateCompany(company *models.Company) (*models.Company, error) {
+	return s.client.UpdateCompany(company)
+}
+
+func (s *APIStore) ListCompanies(filter models.CompanyFilter) ([]models.Company, error) {
+	return s.client.GetCompanies(filter)
+}
+
+func (s *APIStore) CompanyNames() ([]string, error) {
+	return s.client.GetAllCompanyNames()
+}
+
+func (s *APIStore) MergeCompanies(canonicalID int, duplicateIDs []int) (int, error) {
+	result, err := s.client.MergeCompanies(canonicalID, duplicateIDs)
+	if err != nil {
+		return 0, err
+	}
+	return result.JobsMoved, nil
+}
+
+func (s *APIStore) CompanyAliases() ([]models.CompanyAlias, error) {
+	return s.client.GetCompanyAliases()
+}
+
+func (s *APIStore) CreateCompanyAlias(alias *models.CompanyAlias) (*models.CompanyAlias, error) {
+	return s.client.CreateCompanyAlias(alias)
+}
+
+func (s *APIStore) CreateRating(rating *models.JobRating) (*models.JobRating, error) {
+	return s.client.CreateJobRating(rating)
+}
+
+func (s *APIStore) JobRatings(jobID int) ([]models.JobRating, error) {
+	return s.client.GetJobRatings(jobID)
+}
+
+func (s *APIStore) CreateSnapshot(snapshot *models.JobSnapshot) (*models.JobSnapshot, error) {
+	return s.client.CreateJobSnapshot(snapshot)
+}
+
+func (s *APIStore) JobSnapshots(linkedinJobID int) ([]models.JobSnapshot, error) {
+	return s.client.GetJobSnapshots(linkedinJobID)
+}
+
+func (s *APIStore) JobFingerprints(companyID int64) ([]models.JobFingerprint, error) {
+	return s.client.GetJobFingerprints(companyID)
+}
+
+func (s *APIStore) RecordRun(run *models.ScrapeRun) (*models.ScrapeRun, error) {
+	return s.client.CreateScrapeRun(run)
+}
+
+func (s *APIStore) ListRuns(limit int) ([]models.ScrapeRun, error) {
+	return s.client.GetScrapeRuns(limit)
+}
+
+func (s *APIStore) Close() error {
+	return nil
+}
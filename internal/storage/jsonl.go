@@ -0,0 +1,718 @@
+package storage
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+// JSONL files in the store directory
+const (
+	jobsFile      = "jobs.jsonl"
+	companiesFile = "companies.jsonl"
+	aliasesFile   = "company_aliases.jsonl"
+	ratingsFile   = "ratings.jsonl"
+	snapshotsFile = "snapshots.jsonl"
+	runsFile      = "runs.jsonl"
+)
+
+// companyRecord is a line of companies.jsonl. Merged companies are removed by a line
+// with only their ID and deleted set.
+type companyRecord struct {
+	models.Company
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// ratingKey identifies a rating; a job has at most one rating of each type
+type ratingKey struct {
+	jobID      int
+	ratingType string
+}
+
+// JSONLStore keeps jobs, companies and their ratings, snapshots and aliases as append-only
+// JSON Lines files. Every create and update appends the full record; when the files are
+// loaded the last line for an ID wins.
+type JSONLStore struct {
+	mu            sync.Mutex
+	out           map[string]*os.File             // By file name
+	jobs          map[int]*models.JobPosting      // By JobID
+	companies     map[int]*models.Company         // By CompanyID
+	aliases       map[string]*models.CompanyAlias // By NormalisedName
+	ratings       map[ratingKey]*models.JobRating
+	snapshots     []models.JobSnapshot
+	runs          []models.ScrapeRun
+	nextJobID     int
+	nextCompanyID int
+}
+
+// OpenJSONLStore loads the JSONL files in dir, creating the directory if needed
+func OpenJSONLStore(dir string) (*JSONLStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	s := &JSONLStore{
+		out:           make(map[string]*os.File),
+		jobs:          make(map[int]*models.JobPosting),
+		companies:     make(map[int]*models.Company),
+		aliases:       make(map[string]*models.CompanyAlias),
+		ratings:       make(map[ratingKey]*models.JobRating),
+		nextJobID:     1,
+		nextCompanyID: 1,
+	}
+
+	loaders := map[string]func(line []byte) error{
+		companiesFile: func(line []byte) error {
+			var record companyRecord
+			if err := json.Unmarshal(line, &record); err != nil {
+				return err
+			}
+			s.nextCompanyID = max(s.nextCompanyID, record.CompanyID+1)
+			if record.Deleted {
+				delete(s.companies, record.CompanyID)
+			} else {
+				s.companies[record.CompanyID] = &record.Company
+			}
+			return nil
+		},
+		jobsFile: func(line []byte) error {
+			var job models.JobPosting
+			if err := json.Unmarshal(line, &job); err != nil {
+				return err
+			}
+			s.jobs[job.JobID] = &job
+			s.nextJobID = max(s.nextJobID, job.JobID+1)
+			return nil
+		},
+		aliasesFile: func(line []byte) error {
+			var alias models.CompanyAlias
+			if err := json.Unmarshal(line, &alias); err != nil {
+				return err
+			}
+			s.aliases[alias.NormalisedName] = &alias
+			return nil
+		},
+		ratingsFile: func(line []byte) error {
+			var rating models.JobRating
+			if err := json.Unmarshal(line, &rating); err != nil {
+				return err
+			}
+			s.ratings[ratingKey{rating.JobID, rating.RatingType}] = &rating
+			return nil
+		},
+		snapshotsFile: func(line []byte) error {
+			var snapshot models.JobSnapshot
+			if err := json.Unmarshal(line, &snapshot); err != nil {
+				return err
+			}
+			s.snapshots = append(s.snapshots, snapshot)
+			return nil
+		},
+		runsFile: func(line []byte) error {
+			var run models.ScrapeRun
+			if err := json.Unmarshal(line, &run); err != nil {
+				return err
+			}
+			s.runs = append(s.runs, run)
+			return nil
+		},
+	}
+
+	for name, load := range loaders {
+		path := filepath.Join(dir, name)
+		if err := replay(path, load); err != nil {
+			s.Close()
+			return nil, err
+		}
+		f, err := openAppend(path)
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+		s.out[name] = f
+	}
+	return s, nil
+}
+
+// replay calls apply for every line of a JSONL file. A missing file is empty.
+func replay(path string, apply func(line []byte) error) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024) // Descriptions can be long
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		if err := apply(scanner.Bytes()); err != nil {
+			return fmt.Errorf("%s line %d: %w", path, lineNo, err)
+		}
+	}
+	return scanner.Err()
+}
+
+func openAppend(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	return f, nil
+}
+
+// appendRecord writes v as one line of the named file
+func (s *JSONLStore) appendRecord(name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	f := s.out[name]
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write %s: %w", f.Name(), err)
+	}
+	return nil
+}
+
+func (s *JSONLStore) JobExists(linkedinJobID int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.findJob(linkedinJobID) != nil, nil
+}
+
+func (s *JSONLStore) findJob(linkedinJobID int) *models.JobPosting {
+	for _, job := range s.jobs {
+		if job.LinkedInJobID == linkedinJobID {
+			return job
+		}
+	}
+	return nil
+}
+
+func (s *JSONLStore) CreateJob(job *models.JobPosting) (*models.JobPosting, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.findJob(job.LinkedInJobID) != nil {
+		return nil, fmt.Errorf("job %d already exists", job.LinkedInJobID)
+	}
+
+	created := *job
+	created.JobID = s.nextJobID
+	if err := s.appendRecord(jobsFile, &created); err != nil {
+		return nil, err
+	}
+	s.nextJobID++
+	s.jobs[created.JobID] = &created
+
+	result := created
+	return &result, nil
+}
+
+func (s *JSONLStore) UpdateJob(job *models.JobPosting) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.jobs[job.JobID]; !ok {
+		return fmt.Errorf("job %d not found", job.JobID)
+	}
+	updated := *job
+	if err := s.appendRecord(jobsFile, &updated); err != nil {
+		return err
+	}
+	s.jobs[job.JobID] = &updated
+	return nil
+}
+
+// ListJobs returns the jobs matching filter in posted date order
+func (s *JSONLStore) ListJobs(filter models.JobFilter) ([]models.JobPosting, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var jobs []models.JobPosting
+	for _, job := range s.jobs {
+		if s.matchesJobFilter(job, filter) {
+			jobs = append(jobs, s.withCompany(*job))
+		}
+	}
+	var refreshed map[int]time.Time
+	if filter.LeastRefreshed {
+		refreshed = s.lastSnapshots()
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		a, b := jobs[i], jobs[j]
+		if filter.LeastRefreshed {
+			ra, rb := refreshed[a.LinkedInJobID], refreshed[b.LinkedInJobID]
+			if !ra.Equal(rb) {
+				return ra.Before(rb)
+			}
+		} else if filter.NewestFirst {
+			a, b = b, a
+		}
+		if !a.PostedDate.Equal(b.PostedDate) {
+			return a.PostedDate.Before(b.PostedDate)
+		}
+		return a.JobID < b.JobID
+	})
+	return page(jobs, filter.Limit, filter.Offset), nil
+}
+
+func (s *JSONLStore) matchesJobFilter(job *models.JobPosting, filter models.JobFilter) bool {
+	switch {
+	case filter.LinkedInJobID != 0 && job.LinkedInJobID != filter.LinkedInJobID:
+		return false
+	case filter.Open && job.JobPostClosedDate != nil:
+		return false
+	case filter.Closed && job.JobPostClosedDate == nil:
+		return false
+	case !filter.PostedAfter.IsZero() && job.PostedDate.Before(filter.PostedAfter):
+		return false
+	case !filter.PostedBefore.IsZero() && !job.PostedDate.Before(filter.PostedBefore):
+		return false
+	case !filter.OpenOrPostedBefore.IsZero() && job.JobPostClosedDate != nil && !job.PostedDate.Before(filter.OpenOrPostedBefore):
+		return false
+	case filter.CompanyID != 0 && job.CompanyID != filter.CompanyID:
+		return false
+	case filter.Company != "" && !containsFold(s.companyName(job.CompanyID), filter.Company):
+		return false
+	case filter.WorkType != "" && (job.WorkType == nil || !strings.EqualFold(*job.WorkType, filter.WorkType)):
+		return false
+	case filter.MinRating > 0 && !s.ratedAtLeast(job.JobID, filter.MinRating, filter.RatingType):
+		return false
+	case filter.MissingFingerprint && job.Fingerprint != nil:
+		return false
+	case filter.MissingAddress && job.OpenaiAdresse != nil:
+		return false
+	case filter.MissingSkillIDs && job.SkillIDs != nil:
+		return false
+	case filter.UnratedBy != "" && s.ratings[ratingKey{job.JobID, filter.UnratedBy}] != nil:
+		return false
+	}
+	for _, skill := range filter.Skills {
+		if !hasSkill(job.SkillIDs, skill) && !hasSkill(job.Skills, skill) {
+			return false
+		}
+	}
+	return true
+}
+
+// lastSnapshots returns when each job, by LinkedIn job ID, was last snapshotted
+func (s *JSONLStore) lastSnapshots() map[int]time.Time {
+	last := make(map[int]time.Time)
+	for _, snapshot := range s.snapshots {
+		if snapshot.CapturedAt.After(last[snapshot.LinkedInJobID]) {
+			last[snapshot.LinkedInJobID] = snapshot.CapturedAt
+		}
+	}
+	return last
+}
+
+// ratedAtLeast reports whether a job has a rating of ratingType (any type if empty)
+// with an overall score of at least minScore
+func (s *JSONLStore) ratedAtLeast(jobID, minScore int, ratingType string) bool {
+	for key, rating := range s.ratings {
+		if key.jobID == jobID && (ratingType == "" || key.ratingType == ratingType) && rating.OverallScore >= minScore {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *JSONLStore) companyName(companyID int64) string {
+	if company, ok := s.companies[int(companyID)]; ok {
+		return company.Name
+	}
+	return ""
+}
+
+func hasSkill(skills *models.SkillsList, skill string) bool {
+	if skills == nil {
+		return false
+	}
+	for _, s := range *skills {
+		if strings.EqualFold(s, skill) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// page returns the items after offset, at most limit of them. Offset only applies with a limit,
+// like in SQL.
+func page[T any](items []T, limit, offset int) []T {
+	if limit <= 0 {
+		return items
+	}
+	if offset >= len(items) {
+		return nil
+	}
+	return items[offset:min(offset+limit, len(items))]
+}
+
+// withCompany fills in the company fields the SQL backends join
+func (s *JSONLStore) withCompany(job models.JobPosting) models.JobPosting {
+	if company, ok := s.companies[int(job.CompanyID)]; ok {
+		job.CompanyName = company.Name
+		job.CompanyImageURL = company.ImageURL
+	}
+	return job
+}
+
+func (s *JSONLStore) JobIDs() ([]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]int, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		ids = append(ids, job.LinkedInJobID)
+	}
+	return ids, nil
+}
+
+func (s *JSONLStore) FindCompany(ref *models.Company) (*models.Company, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if company := s.findCompany(ref); company != nil {
+		found := *company
+		return &found, nil
+	}
+	return nil, nil
+}
+
+// findCompany matches on LinkedIn company ID, then slug, then name. Of several companies
+// with the same name, the oldest is returned.
+func (s *JSONLStore) findCompany(ref *models.Company) *models.Company {
+	matchers := []func(c *models.Company) bool{
+		func(c *models.Company) bool {
+			return ref.LinkedInCompanyID != "" && c.LinkedInCompanyID == ref.LinkedInCompanyID
+		},
+		func(c *models.Company) bool { return ref.LinkedInSlug != "" && c.LinkedInSlug == ref.LinkedInSlug },
+		func(c *models.Company) bool { return ref.Name != "" && c.Name == ref.Name },
+	}
+	for _, matches := range matchers {
+		var found *models.Company
+		for _, company := range s.companies {
+			if matches(company) && (found == nil || company.CompanyID < found.CompanyID) {
+				found = company
+			}
+		}
+		if found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func (s *JSONLStore) CreateCompany(ref *models.Company) (*models.Company, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Like the Laravel API, return the existing company - unless it only shares the name
+	if company := s.findCompany(ref); company != nil && !company.ConflictsWith(ref) {
+		existing := *company
+		return &existing, nil
+	}
+
+	created := *ref
+	created.CompanyID = s.nextCompanyID
+	if err := s.appendRecord(companiesFile, &created); err != nil {
+		return nil, err
+	}
+	s.nextCompanyID++
+	s.companies[created.CompanyID] = &created
+
+	result := created
+	return &result, nil
+}
+
+func (s *JSONLStore) UpdateCompany(company *models.Company) (*models.Company, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.companies[company.CompanyID]; !ok {
+		return nil, fmt.Errorf("company %d not found", company.CompanyID)
+	}
+	updated := *company
+	if err := s.appendRecord(companiesFile, &updated); err != nil {
+		return nil, err
+	}
+	s.companies[company.CompanyID] = &updated
+
+	result := updated
+	return &result, nil
+}
+
+// ListCompanies returns companies by ID
+func (s *JSONLStore) ListCompanies(filter models.CompanyFilter) ([]models.Company, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var companies []models.Company
+	for _, company := range s.companies {
+		if filter.NeedsEnrichment && (company.LinkedInURL == "" || company.EnrichedAt != nil) {
+			continue
+		}
+		if filter.Name != "" && !containsFold(company.Name, filter.Name) {
+			continue
+		}
+		companies = append(companies, *company)
+	}
+	sort.Slice(companies, func(i, j int) bool { return companies[i].CompanyID < companies[j].CompanyID })
+	return page(companies, filter.Limit, filter.Offset), nil
+}
+
+func (s *JSONLStore) CompanyNames() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := make([]string, 0, len(s.companies))
+	for _, company := range s.companies {
+		names = append(names, company.Name)
+	}
+	return names, nil
+}
+
+func (s *JSONLStore) MergeCompanies(canonicalID int, duplicateIDs []int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.companies[canonicalID]; !ok {
+		return 0, fmt.Errorf("company %d not found", canonicalID)
+	}
+	duplicates := make(map[int]bool, len(duplicateIDs))
+	for _, id := range duplicateIDs {
+		if id != canonicalID {
+			duplicates[id] = true
+		}
+	}
+
+	moved := 0
+	for _, job := range s.jobs {
+		if !duplicates[int(job.CompanyID)] {
+			continue
+		}
+		updated := *job
+		updated.CompanyID = int64(canonicalID)
+		if err := s.appendRecord(jobsFile, &updated); err != nil {
+			return moved, err
+		}
+		s.jobs[job.JobID] = &updated
+		moved++
+	}
+	for _, alias := range s.aliases {
+		if !duplicates[alias.CompanyID] {
+			continue
+		}
+		updated := *alias
+		updated.CompanyID = canonicalID
+		if err := s.appendRecord(aliasesFile, &updated); err != nil {
+			return moved, err
+		}
+		s.aliases[alias.NormalisedName] = &updated
+	}
+	for id := range duplicates {
+		if _, ok := s.companies[id]; !ok {
+			continue
+		}
+		tombstone := companyRecord{Company: models.Company{CompanyID: id}, Deleted: true}
+		if err := s.appendRecord(companiesFile, &tombstone); err != nil {
+			return moved, err
+		}
+		delete(s.companies, id)
+	}
+	return moved, nil
+}
+
+// CompanyAliases returns all aliases with their canonical company name
+func (s *JSONLStore) CompanyAliases() ([]models.CompanyAlias, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var aliases []models.CompanyAlias
+	for _, alias := range s.aliases {
+		company, ok := s.companies[alias.CompanyID]
+		if !ok {
+			continue
+		}
+		a := *alias
+		a.CompanyName = company.Name
+		aliases = append(aliases, a)
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].AliasID < aliases[j].AliasID })
+	return aliases, nil
+}
+
+func (s *JSONLStore) CreateCompanyAlias(alias *models.CompanyAlias) (*models.CompanyAlias, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := *alias
+	saved.CompanyName = "" // Joined, not saved
+	if existing, ok := s.aliases[alias.NormalisedName]; ok {
+		saved.AliasID = existing.AliasID
+	} else {
+		saved.AliasID = len(s.aliases) + 1
+	}
+	if err := s.appendRecord(aliasesFile, &saved); err != nil {
+		return nil, err
+	}
+	s.aliases[saved.NormalisedName] = &saved
+
+	result := saved
+	return &result, nil
+}
+
+func (s *JSONLStore) CreateRating(rating *models.JobRating) (*models.JobRating, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := *rating
+	key := ratingKey{saved.JobID, saved.RatingType}
+	if existing, ok := s.ratings[key]; ok {
+		saved.RatingID = existing.RatingID
+	} else {
+		saved.RatingID = len(s.ratings) + 1
+	}
+	if saved.RatedAt.IsZero() {
+		saved.RatedAt = time.Now()
+	}
+	if err := s.appendRecord(ratingsFile, &saved); err != nil {
+		return nil, err
+	}
+	s.ratings[key] = &saved
+
+	result := saved
+	return &result, nil
+}
+
+// JobRatings returns the ratings of a job by rating type
+func (s *JSONLStore) JobRatings(jobID int) ([]models.JobRating, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ratings []models.JobRating
+	for key, rating := range s.ratings {
+		if key.jobID == jobID {
+			ratings = append(ratings, *rating)
+		}
+	}
+	sort.Slice(ratings, func(i, j int) bool { return ratings[i].RatingType < ratings[j].RatingType })
+	return ratings, nil
+}
+
+func (s *JSONLStore) CreateSnapshot(snapshot *models.JobSnapshot) (*models.JobSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	created := *snapshot
+	created.SnapshotID = len(s.snapshots) + 1
+	if err := s.appendRecord(snapshotsFile, &created); err != nil {
+		return nil, err
+	}
+	s.snapshots = append(s.snapshots, created)
+
+	result := created
+	return &result, nil
+}
+
+// JobSnapshots returns all snapshots of a job, oldest first
+func (s *JSONLStore) JobSnapshots(linkedinJobID int) ([]models.JobSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var snapshots []models.JobSnapshot
+	for _, snapshot := range s.snapshots {
+		if snapshot.LinkedInJobID == linkedinJobID {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool { return snapshots[i].CapturedAt.Before(snapshots[j].CapturedAt) })
+	return snapshots, nil
+}
+
+// JobFingerprints returns the fingerprinted jobs of a company, oldest first
+func (s *JSONLStore) JobFingerprints(companyID int64) ([]models.JobFingerprint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var fingerprints []models.JobFingerprint
+	for _, job := range s.jobs {
+		if job.Fingerprint == nil || (companyID > 0 && job.CompanyID != companyID) {
+			continue
+		}
+		fingerprints = append(fingerprints, models.JobFingerprint{
+			JobID:         job.JobID,
+			LinkedInJobID: job.LinkedInJobID,
+			CompanyID:     job.CompanyID,
+			Title:         job.Title,
+			Fingerprint:   *job.Fingerprint,
+			PostedDate:    job.PostedDate,
+			RepostOfJobID: job.RepostOfJobID,
+		})
+	}
+	sort.Slice(fingerprints, func(i, j int) bool {
+		if !fingerprints[i].PostedDate.Equal(fingerprints[j].PostedDate) {
+			return fingerprints[i].PostedDate.Before(fingerprints[j].PostedDate)
+		}
+		return fingerprints[i].JobID < fingerprints[j].JobID
+	})
+	return fingerprints, nil
+}
+
+func (s *JSONLStore) RecordRun(run *models.ScrapeRun) (*models.ScrapeRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	created := *run
+	created.RunID = len(s.runs) + 1
+	if err := s.appendRecord(runsFile, &created); err != nil {
+		return nil, err
+	}
+	s.runs = append(s.runs, created)
+
+	result := created
+	return &result, nil
+}
+
+// ListRuns returns the most recent runs, newest first
+func (s *JSONLStore) ListRuns(limit int) ([]models.ScrapeRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	runs := make([]models.ScrapeRun, 0, len(s.runs))
+	for i := len(s.runs) - 1; i >= 0; i-- {
+		runs = append(runs, s.runs[i])
+	}
+	return page(runs, limit, 0), nil
+}
+
+func (s *JSONLStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var firstErr error
+	for _, f := range s.out {
+		if err := f.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
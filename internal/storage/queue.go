@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"linkedin-job-scraper/internal/cache"
+	"linkedin-job-scraper/internal/database"
+	"linkedin-job-scraper/internal/models"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Queue holds the LinkedIn job IDs found by discover until process scrapes them, in FIFO order
+type Queue interface {
+	// Push queues a job unless it is already waiting or being scraped. Returns whether it was added.
+	Push(linkedinJobID string) (bool, error)
+	// Pop takes the oldest waiting job, or returns "" if the queue is empty
+	Pop() (string, error)
+	// Finish records the outcome of a popped job: models.StatusDone or models.StatusError
+	Finish(linkedinJobID string, status int) error
+	Contains(linkedinJobID string) (bool, error)
+	// Len returns the number of jobs waiting
+	Len() (int, error)
+	Stats() (models.QueueStats, error)
+	Clear() error
+}
+
+// RedisQueue keeps the queue in a Redis list. Popped jobs leave the list, so Finish does nothing.
+type RedisQueue struct {
+	cache *cache.RedisCache
+}
+
+// redisQueueKey is the Redis list holding the queue
+const redisQueueKey = "job_processing_queue"
+
+// NewRedisQueue creates a queue in Redis
+func NewRedisQueue(redisCache *cache.RedisCache) *RedisQueue {
+	return &RedisQueue{cache: redisCache}
+}
+
+func (q *RedisQueue) Push(linkedinJobID string) (bool, error) {
+	return q.cache.AddJobToQueueIfNotExists(redisQueueKey, linkedinJobID)
+}
+
+func (q *RedisQueue) Pop() (string, error) {
+	return q.cache.RPop(redisQueueKey)
+}
+
+func (q *RedisQueue) Finish(linkedinJobID string, status int) error {
+	return nil
+}
+
+func (q *RedisQueue) Contains(linkedinJobID string) (bool, error) {
+	return q.cache.IsJobInQueue(linkedinJobID)
+}
+
+func (q *RedisQueue) Len() (int, error) {
+	return q.cache.GetQueueSize()
+}
+
+func (q *RedisQueue) Stats() (models.QueueStats, error) {
+	pending, err := q.cache.GetQueueSize()
+	return models.QueueStats{Pending: pending}, err
+}
+
+func (q *RedisQueue) Clear() error {
+	return q.cache.ClearJobProcessingQueue()
+}
+
+// SQLQueue keeps the queue in the job_queue table of an SQLite database. Jobs stay in the
+// table with their status, so finished jobs can be counted and failed jobs retried.
+type SQLQueue struct {
+	repo *database.JobQueueRepository
+}
+
+// StaleClaimAge is how long a job can stay in progress before a new SQLQueue assumes the
+// process scraping it died and queues it again
+const StaleClaimAge = time.Hour
+
+// NewSQLQueue creates a queue in the job_queue table. Jobs left in progress for longer than
+// StaleClaimAge, by a process that crashed or was killed, are queued again.
+func NewSQLQueue(db *database.DB) (*SQLQueue, error) {
+	repo := database.NewJobQueueRepository(db)
+	requeued, err := repo.RequeueStale(time.Now().Add(-StaleClaimAge))
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue stale jobs: %w", err)
+	}
+	if requeued > 0 {
+		logrus.Infof("🔁 Requeued %d jobs left in progress by an earlier run", requeued)
+	}
+	return &SQLQueue{repo: repo}, nil
+}
+
+func (q *SQLQueue) Push(linkedinJobID string) (bool, error) {
+	id, err := strconv.Atoi(linkedinJobID)
+	if err != nil {
+		return false, fmt.Errorf("invalid LinkedIn job ID %q", linkedinJobID)
+	}
+
+	status, err := q.repo.Status(id)
+	switch {
+	case err == sql.ErrNoRows:
+		return true, q.repo.Add(id)
+	case err != nil:
+		return false, err
+	case status == models.StatusPending || status == models.StatusInProgress:
+		return false, nil
+	default:
+		// Scraped or failed before - queue it again, like a job popped from Redis
+		return true, q.repo.Requeue(id)
+	}
+}
+
+func (q *SQLQueue) Pop() (string, error) {
+	id, err := q.repo.Claim()
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(id), nil
+}
+
+func (q *SQLQueue) Finish(linkedinJobID string, status int) error {
+	id, err := strconv.Atoi(linkedinJobID)
+	if err != nil {
+		return fmt.Errorf("invalid LinkedIn job ID %q", linkedinJobID)
+	}
+	return q.repo.UpdateStatus(id, status)
+}
+
+func (q *SQLQueue) Contains(linkedinJobID string) (bool, error) {
+	id, err := strconv.Atoi(linkedinJobID)
+	if err != nil {
+		return false, nil
+	}
+	status, err := q.repo.Status(id)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return status == models.StatusPending || status == models.StatusInProgress, nil
+}
+
+func (q *SQLQueue) Len() (int, error) {
+	counts, err := q.repo.CountByStatus()
+	if err != nil {
+		return 0, err
+	}
+	return counts[models.StatusPending], nil
+}
+
+func (q *SQLQueue) Stats() (models.QueueStats, error) {
+	counts, err := q.repo.CountByStatus()
+	if err != nil {
+		return models.QueueStats{}, err
+	}
+	return models.QueueStats{
+		Pending:    counts[models.StatusPending],
+		InProgress: counts[models.StatusInProgress],
+		Done:       counts[models.StatusDone],
+		Error:      counts[models.StatusError],
+	}, nil
+}
+
+func (q *SQLQueue) Clear() error {
+	return q.repo.Clear()
+}
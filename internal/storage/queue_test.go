@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/database"
+	"linkedin-job-scraper/internal/models"
+)
+
+func openTestQueue(t *testing.T) (*SQLQueue, *database.DB) {
+	t.Helper()
+	db, err := database.OpenSQLite(filepath.Join(t.TempDir(), "jobs.db"))
+	if err != nil {
+		t.Fatalf("OpenSQLite() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	queue, err := NewSQLQueue(db)
+	if err != nil {
+		t.Fatalf("NewSQLQueue() error = %v", err)
+	}
+	return queue, db
+}
+
+func TestSQLQueuePopClaimsEachJobOnce(t *testing.T) {
+	queue, _ := openTestQueue(t)
+
+	const jobs = 20
+	for i := 1; i <= jobs; i++ {
+		if _, err := queue.Push(strconv.Itoa(i)); err != nil {
+			t.Fatalf("Push(%d) error = %v", i, err)
+		}
+	}
+
+	var (
+		mu     sync.Mutex
+		popped []int
+		wg     sync.WaitGroup
+	)
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				id, err := queue.Pop()
+				if err != nil {
+					t.Errorf("Pop() error = %v", err)
+					return
+				}
+				if id == "" {
+					return
+				}
+				n, _ := strconv.Atoi(id)
+				mu.Lock()
+				popped = append(popped, n)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	sort.Ints(popped)
+	if len(popped) != jobs {
+		t.Fatalf("popped %d jobs, want %d: %v", len(popped), jobs, popped)
+	}
+	for i, id := range popped {
+		if id != i+1 {
+			t.Fatalf("popped %v, want each job once", popped)
+		}
+	}
+
+	stats, err := queue.Stats()
+	if err != nil {
+		t.Fatalf("Stats() error = %v", err)
+	}
+	if stats.Pending != 0 || stats.InProgress != jobs {
+		t.Errorf("Stats() = %+v, want %d in progress", stats, jobs)
+	}
+}
+
+func TestSQLQueuePopOrder(t *testing.T) {
+	queue, _ := openTestQueue(t)
+
+	for _, id := range []string{"3", "1", "2"} {
+		queue.Push(id)
+	}
+	for _, want := range []string{"3", "1", "2", ""} {
+		if got, err := queue.Pop(); err != nil || got != want {
+			t.Fatalf("Pop() = %q, %v, want %q", got, err, want)
+		}
+	}
+}
+
+func TestNewSQLQueueRequeuesStaleJobs(t *testing.T) {
+	queue, db := openTestQueue(t)
+
+	for _, id := range []string{"1", "2"} {
+		queue.Push(id)
+		queue.Pop()
+	}
+	// Job 1 was claimed by a run that died long ago, job 2 is still being scraped
+	stale := time.Now().Add(-2 * StaleClaimAge)
+	if _, err := db.Exec(`UPDATE job_queue SET updated_at = ? WHERE job_id = 1`, stale); err != nil {
+		t.Fatalf("failed to age job 1: %v", err)
+	}
+
+	queue, err := NewSQLQueue(db)
+	if err != nil {
+		t.Fatalf("NewSQLQueue() error = %v", err)
+	}
+	if added, _ := queue.Push("2"); added {
+		t.Error("Push(2) added a job that is in progress")
+	}
+	stats, _ := queue.Stats()
+	if want := (models.QueueStats{Pending: 1, InProgress: 1}); stats != want {
+		t.Errorf("Stats() = %+v, want %+v", stats, want)
+	}
+	if got, _ := queue.Pop(); got != "1" {
+		t.Errorf("Pop() = %q, want the stale job 1", got)
+	}
+}
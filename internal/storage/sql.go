@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	"linkedin-job-scraper/internal/database"
+	"linkedin-job-scraper/internal/models"
+)
+
+// SQLStore keeps jobs and companies in MySQL or SQLite
+type SQLStore struct {
+	db        *database.DB
+	jobs      *database.JobPostingRepository
+	companies *database.CompanyRepository
+	aliases   *database.CompanyAliasRepository
+	ratings   *database.RatingRepository
+	snapshots *database.SnapshotRepository
+	runs      *database.RunRepository
+}
+
+// NewSQLStore creates a store on an open database. The store closes the database.
+func NewSQLStore(db *database.DB) *SQLStore {
+	return &SQLStore{
+		db:        db,
+		jobs:      database.NewJobPostingRepository(db),
+		companies: database.NewCompanyRepository(db),
+		aliases:   database.NewCompanyAliasRepository(db),
+		ratings:   database.NewRatingRepository(db),
+		snapshots: database.NewSnapshotRepository(db),
+		runs:      database.NewRunRepository(db),
+	}
+}
+
+// DB returns the underlying database
+func (s *SQLStore) DB() *database.DB {
+	return s.db
+}
+
+func (s *SQLStore) JobExists(linkedinJobID int) (bool, error) {
+	return s.jobs.ExistsLinkedInJobID(int64(linkedinJobID))
+}
+
+func (s *SQLStore) CreateJob(job *models.JobPosting) (*models.JobPosting, error) {
+	created := *job
+	return s.jobs.Create(&created)
+}
+
+func (s *SQLStore) UpdateJob(job *models.JobPosting) error {
+	return s.jobs.Update(job)
+}
+
+func (s *SQLStore) ListJobs(filter models.JobFilter) ([]models.JobPosting, error) {
+	return s.jobs.List(filter)
+}
+
+func (s *SQLStore) JobIDs() ([]int, error) {
+	return s.jobs.LinkedInJobIDs()
+}
+
+func (s *SQLStore) FindCompany(ref *models.Company) (*models.Company, error) {
+	company, err := s.companies.Find(ref)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return company, err
+}
+
+func (s *SQLStore) CreateCompany(ref *models.Company) (*models.Company, error) {
+	// Like the Laravel API, return the existing company - unless it only shares the name
+	existing, err := s.companies.Find(ref)
+	if err == nil && !existing.ConflictsWith(ref) {
+		return existing, nil
+	}
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to look up company %s: %w", ref.Name, err)
+	}
+	return s.companies.Create(ref)
+}
+
+func (s *SQLStore) UpdateCompany(company *models.Company) (*models.Company, error) {
+	if err := s.companies.Update(company); err != nil {
+		return nil, err
+	}
+	return company, nil
+}
+
+func (s *SQLStore) ListCompanies(filter models.CompanyFilter) ([]models.Company, error) {
+	return s.companies.List(filter)
+}
+
+func (s *SQLStore) CompanyNames() ([]string, error) {
+	return s.companies.Names()
+}
+
+func (s *SQLStore) MergeCompanies(canonicalID int, duplicateIDs []int) (int, error) {
+	return s.companies.Merge(canonicalID, duplicateIDs)
+}
+
+func (s *SQLStore) CompanyAliases() ([]models.CompanyAlias, error) {
+	return s.aliases.List()
+}
+
+func (s *SQLStore) CreateCompanyAlias(alias *models.CompanyAlias) (*models.CompanyAlias, error) {
+	return s.aliases.Save(alias)
+}
+
+func (s *SQLStore) CreateRating(rating *models.JobRating) (*models.JobRating, error) {
+	return s.ratings.Save(rating)
+}
+
+func (s *SQLStore) JobRatings(jobID int) ([]models.JobRating, error) {
+	return s.ratings.ListByJobID(jobID)
+}
+
+func (s *SQLStore) CreateSnapshot(snapshot *models.JobSnapshot) (*models.JobSnapshot, error) {
+	return s.snapshots.Create(snapshot)
+}
+
+func (s *SQLStore) JobSnapshots(linkedinJobID int) ([]models.JobSnapshot, error) {
+	return s.snapshots.ListByLinkedInJobID(linkedinJobID)
+}
+
+func (s *SQLStore) JobFingerprints(companyID int64) ([]models.JobFingerprint, error) {
+	return s.jobs.Fingerprints(companyID)
+}
+
+func (s *SQLStore) RecordRun(run *models.ScrapeRun) (*models.ScrapeRun, error) {
+	return s.runs.Create(run)
+}
+
+func (s *SQLStore) ListRuns(limit int) ([]models.ScrapeRun, error) {
+	return s.runs.List(limit)
+}
+
+func (s *SQLStore) Close() error {
+	return s.db.Close()
+}
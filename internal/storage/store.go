@@ -0,0 +1,87 @@
+// Package storage persists jobs and companies. The Laravel API is the default backend;
+// MySQL, SQLite and JSONL files let the scraper run against a database directly or
+// without any server at all.
+package storage
+
+import (
+	"fmt"
+
+	"linkedin-job-scraper/internal/config"
+	"linkedin-job-scraper/internal/database"
+	"linkedin-job-scraper/internal/models"
+)
+
+// Backends selectable with STORAGE_BACKEND
+const (
+	BackendAPI    = "api"
+	BackendMySQL  = "mysql"
+	BackendSQLite = "sqlite"
+	BackendJSONL  = "jsonl"
+)
+
+// Store is where jobs, companies and their ratings, snapshots and aliases are kept
+type Store interface {
+	JobExists(linkedinJobID int) (bool, error)
+	CreateJob(job *models.JobPosting) (*models.JobPosting, error)
+	// UpdateJob saves a job that was read from the store, identified by JobID
+	UpdateJob(job *models.JobPosting) error
+	ListJobs(filter models.JobFilter) ([]models.JobPosting, error)
+	// JobIDs returns the LinkedIn job IDs of all stored jobs
+	JobIDs() ([]int, error)
+
+	// FindCompany matches ref on LinkedIn company ID, then slug, then name.
+	// Returns nil if the company doesn't exist.
+	FindCompany(ref *models.Company) (*models.Company, error)
+	// CreateCompany returns the existing company if FindCompany matches ref, unless that is a
+	// different LinkedIn company with the same name
+	CreateCompany(ref *models.Company) (*models.Company, error)
+	UpdateCompany(company *models.Company) (*models.Company, error)
+	ListCompanies(filter models.CompanyFilter) ([]models.Company, error)
+	CompanyNames() ([]string, error)
+	// MergeCompanies moves the jobs and aliases of the duplicates to the canonical company and
+	// deletes the duplicates. Returns the number of jobs moved.
+	MergeCompanies(canonicalID int, duplicateIDs []int) (int, error)
+	CompanyAliases() ([]models.CompanyAlias, error)
+	CreateCompanyAlias(alias *models.CompanyAlias) (*models.CompanyAlias, error)
+
+	// CreateRating replaces an earlier rating of the same type for the job
+	CreateRating(rating *models.JobRating) (*models.JobRating, error)
+	// JobRatings returns the ratings of a job (by JobID), one per rating type
+	JobRatings(jobID int) ([]models.JobRating, error)
+	CreateSnapshot(snapshot *models.JobSnapshot) (*models.JobSnapshot, error)
+	// JobSnapshots returns all snapshots of a job, oldest first
+	JobSnapshots(linkedinJobID int) ([]models.JobSnapshot, error)
+	// JobFingerprints returns the fingerprinted jobs of a company (all companies when companyID is 0)
+	JobFingerprints(companyID int64) ([]models.JobFingerprint, error)
+
+	// RecordRun saves a finished run of a scraping command
+	RecordRun(run *models.ScrapeRun) (*models.ScrapeRun, error)
+	// ListRuns returns the most recent runs, newest first
+	ListRuns(limit int) ([]models.ScrapeRun, error)
+
+	Close() error
+}
+
+// Open returns the store selected by cfg.Storage.Backend
+func Open(cfg *config.Config) (Store, error) {
+	switch cfg.Storage.Backend {
+	case BackendAPI, "":
+		return NewAPIStore(&cfg.API), nil
+	case BackendMySQL:
+		db, err := database.OpenMySQL(&cfg.Database)
+		if err != nil {
+			return nil, err
+		}
+		return NewSQLStore(db), nil
+	case BackendSQLite:
+		db, err := database.OpenSQLite(cfg.Storage.SQLitePath)
+		if err != nil {
+			return nil, err
+		}
+		return NewSQLStore(db), nil
+	case BackendJSONL:
+		return OpenJSONLStore(cfg.Storage.JSONLDir)
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_BACKEND %q, expected api, mysql, sqlite or jsonl", cfg.Storage.Backend)
+	}
+}
@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/database"
+	"linkedin-job-scraper/internal/models"
+)
+
+// testStores returns an empty store of each local backend
+func testStores(t *testing.T) map[string]Store {
+	t.Helper()
+	db, err := database.OpenSQLite(filepath.Join(t.TempDir(), "jobs.db"))
+	if err != nil {
+		t.Fatalf("OpenSQLite() error = %v", err)
+	}
+	jsonl, err := OpenJSONLStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("OpenJSONLStore() error = %v", err)
+	}
+	stores := map[string]Store{BackendSQLite: NewSQLStore(db), BackendJSONL: jsonl}
+	t.Cleanup(func() {
+		for _, store := range stores {
+			store.Close()
+		}
+	})
+	return stores
+}
+
+func TestListJobsToRefresh(t *testing.T) {
+	now := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	cutoff := now.AddDate(0, 0, -30)
+
+	for name, store := range testStores(t) {
+		t.Run(name, func(t *testing.T) {
+			company, err := store.CreateCompany(&models.Company{Name: "Novo Nordisk"})
+			if err != nil {
+				t.Fatalf("CreateCompany() error = %v", err)
+			}
+			jobIDs := make(map[int]int)
+			createJob := func(linkedinJobID int, postedDaysAgo int, closed bool) {
+				job := &models.JobPosting{
+					LinkedInJobID: linkedinJobID,
+					Title:         "Developer",
+					CompanyID:     int64(company.CompanyID),
+					PostedDate:    now.AddDate(0, 0, -postedDaysAgo),
+				}
+				if closed {
+					closedDate := now.AddDate(0, 0, -1)
+					job.JobPostClosedDate = &closedDate
+				}
+				created, err := store.CreateJob(job)
+				if err != nil {
+					t.Fatalf("CreateJob(%d) error = %v", linkedinJobID, err)
+				}
+				jobIDs[linkedinJobID] = created.JobID
+			}
+			refresh := func(linkedinJobID int, daysAgo int) {
+				snapshot := &models.JobSnapshot{JobID: jobIDs[linkedinJobID], LinkedInJobID: linkedinJobID, CapturedAt: now.AddDate(0, 0, -daysAgo), Title: "Developer", DescriptionHash: "hash", IsOpen: true}
+				if _, err := store.CreateSnapshot(snapshot); err != nil {
+					t.Fatalf("CreateSnapshot(%d) error = %v", linkedinJobID, err)
+				}
+			}
+
+			createJob(1, 60, false) // Old and open
+			createJob(2, 5, false)  // New and open
+			createJob(3, 60, true)  // Old and closed
+			createJob(4, 5, true)   // New and closed, never due
+			createJob(5, 90, false) // Oldest and open, refreshed yesterday
+			refresh(1, 3)
+			refresh(5, 1)
+			refresh(1, 10) // Older snapshots don't count
+
+			jobs, err := store.ListJobs(models.JobFilter{OpenOrPostedBefore: cutoff, LeastRefreshed: true})
+			if err != nil {
+				t.Fatalf("ListJobs() error = %v", err)
+			}
+			var got []int
+			for _, job := range jobs {
+				got = append(got, job.LinkedInJobID)
+			}
+			// Never refreshed by posted date, then refreshed longest ago
+			if want := []int{3, 2, 1, 5}; !reflect.DeepEqual(got, want) {
+				t.Errorf("ListJobs() = %v, want %v", got, want)
+			}
+		})
+	}
+}
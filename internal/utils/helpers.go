@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // ParseRelativeDate converts relative date strings like "2 weeks ago" to actual dates
@@ -64,6 +65,18 @@ func ExtractJobIDFromURL(url string) (int64, error) {
 	return 0, nil
 }
 
+// Truncate cuts s to at most n bytes without splitting a UTF-8 character, and appends
+// suffix if anything was cut
+func Truncate(s string, n int, suffix string) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + suffix
+}
+
 // SanitizeString removes extra whitespace and cleans up text
 func SanitizeString(s string) string {
 	return strings.TrimSpace(strings.ReplaceAll(s, "\n", " "))
@@ -0,0 +1,302 @@
+// Package web serves the scraped jobs as a JSON API with a small HTML UI on top, reading
+// from whichever store is configured. It replaces the Laravel dashboard for browsing.
+package web
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+
+	"github.com/sirupsen/logrus"
+)
+
+//go:embed static
+var static embed.FS
+
+// Page sizes for the list endpoints
+const (
+	DefaultLimit = 50
+	MaxLimit     = 500
+)
+
+// Source is what the server reads from; services.DataService implements it
+type Source interface {
+	ListJobs(filter models.JobFilter) ([]models.JobPosting, error)
+	GetJob(linkedinJobID int) (*models.JobPosting, error)
+	GetJobRatings(jobID int) ([]models.JobRating, error)
+	GetJobHistory(linkedinJobID int) ([]models.JobSnapshot, error)
+	ListCompanies(filter models.CompanyFilter) ([]models.Company, error)
+	GetQueueStats() (models.QueueStats, error)
+	ListRuns(limit int) ([]models.ScrapeRun, error)
+}
+
+// Server handles the JSON API under /api/ and the UI at /
+type Server struct {
+	source Source
+	mux    *http.ServeMux
+}
+
+// NewServer creates a server reading from source
+func NewServer(source Source) *Server {
+	s := &Server{source: source, mux: http.NewServeMux()}
+
+	ui, _ := fs.Sub(static, "static")
+	s.mux.Handle("/", http.FileServer(http.FS(ui)))
+	s.mux.HandleFunc("/api/jobs", s.handleJobs)
+	s.mux.HandleFunc("/api/jobs/", s.handleJob)
+	s.mux.HandleFunc("/api/companies", s.handleCompanies)
+	s.mux.HandleFunc("/api/queue", s.handleQueue)
+	s.mux.HandleFunc("/api/runs", s.handleRuns)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "only GET is supported")
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// handleJobs lists jobs, newest first. Descriptions are left out to keep pages small;
+// /api/jobs/{linkedin_job_id} has the full job.
+func (s *Server) handleJobs(w http.ResponseWriter, r *http.Request) {
+	filter, err := ParseJobFilter(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	jobs, err := s.source.ListJobs(filter)
+	if err != nil {
+		s.internalError(w, err)
+		return
+	}
+	for i := range jobs {
+		jobs[i].Description = ""
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"count":   len(jobs),
+		"offset":  filter.Offset,
+		"jobs":    emptyIfNil(jobs),
+	})
+}
+
+// handleJob returns a job with its ratings and snapshot history
+func (s *Server) handleJob(w http.ResponseWriter, r *http.Request) {
+	linkedinJobID, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/api/jobs/"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, "expected /api/jobs/{linkedin_job_id}")
+		return
+	}
+
+	job, err := s.source.GetJob(linkedinJobID)
+	if err != nil {
+		s.internalError(w, err)
+		return
+	}
+	if job == nil {
+		writeError(w, http.StatusNotFound, "job not found")
+		return
+	}
+	ratings, err := s.source.GetJobRatings(job.JobID)
+	if err != nil {
+		s.internalError(w, err)
+		return
+	}
+	snapshots, err := s.source.GetJobHistory(linkedinJobID)
+	if err != nil {
+		s.internalError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"success":   true,
+		"job":       job,
+		"ratings":   emptyIfNil(ratings),
+		"snapshots": emptyIfNil(snapshots),
+	})
+}
+
+func (s *Server) handleCompanies(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit, offset, err := parsePage(query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	companies, err := s.source.ListCompanies(models.CompanyFilter{Name: query.Get("name"), Limit: limit, Offset: offset})
+	if err != nil {
+		s.internalError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"success":   true,
+		"count":     len(companies),
+		"offset":    offset,
+		"companies": emptyIfNil(companies),
+	})
+}
+
+func (s *Server) handleQueue(w http.ResponseWriter, r *http.Request) {
+	stats, err := s.source.GetQueueStats()
+	if err != nil {
+		s.internalError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"queue":   stats,
+	})
+}
+
+func (s *Server) handleRuns(w http.ResponseWriter, r *http.Request) {
+	limit, _, err := parsePage(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	runs, err := s.source.ListRuns(limit)
+	if err != nil {
+		s.internalError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"count":   len(runs),
+		"runs":    emptyIfNil(runs),
+	})
+}
+
+// ParseJobFilter reads the job list query parameters: skill (repeatable or comma separated),
+// company, company_id, work_type, posted_after, posted_before (YYYY-MM-DD), min_rating,
+// rating_type, status (open or closed), limit and offset. Jobs are listed newest first.
+func ParseJobFilter(values url.Values) (models.JobFilter, error) {
+	filter := models.JobFilter{
+		Company:     values.Get("company"),
+		WorkType:    values.Get("work_type"),
+		RatingType:  values.Get("rating_type"),
+		NewestFirst: true,
+	}
+
+	for _, skills := range values["skill"] {
+		for _, skill := range strings.Split(skills, ",") {
+			if skill = strings.TrimSpace(skill); skill != "" {
+				filter.Skills = append(filter.Skills, skill)
+			}
+		}
+	}
+
+	var err error
+	if filter.CompanyID, err = parseInt64(values, "company_id"); err != nil {
+		return filter, err
+	}
+	if filter.MinRating, err = parseInt(values, "min_rating"); err != nil {
+		return filter, err
+	}
+	if filter.PostedAfter, err = parseDate(values, "posted_after"); err != nil {
+		return filter, err
+	}
+	if filter.PostedBefore, err = parseDate(values, "posted_before"); err != nil {
+		return filter, err
+	}
+
+	switch status := values.Get("status"); status {
+	case "":
+	case "open":
+		filter.Open = true
+	case "closed":
+		filter.Closed = true
+	default:
+		return filter, fmt.Errorf("status must be open or closed, got %q", status)
+	}
+
+	filter.Limit, filter.Offset, err = parsePage(values)
+	return filter, err
+}
+
+// parsePage reads limit (DefaultLimit if missing, at most MaxLimit) and offset
+func parsePage(values url.Values) (limit, offset int, err error) {
+	if limit, err = parseInt(values, "limit"); err != nil {
+		return 0, 0, err
+	}
+	if offset, err = parseInt(values, "offset"); err != nil {
+		return 0, 0, err
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	return min(limit, MaxLimit), max(offset, 0), nil
+}
+
+// internalError logs the cause and keeps storage details (SQL, file paths) from the client
+func (s *Server) internalError(w http.ResponseWriter, err error) {
+	logrus.Errorf("❌ API request failed: %v", err)
+	writeError(w, http.StatusInternalServerError, "internal server error")
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"success": false,
+		"message": message,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logrus.Warnf("⚠️  Failed to write response: %v", err)
+	}
+}
+
+// emptyIfNil makes empty lists encode as [] rather than null
+func emptyIfNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
+
+// dateLayout is the format of the date query parameters
+const dateLayout = "2006-01-02"
+
+func parseDate(values url.Values, name string) (time.Time, error) {
+	value := values.Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be a date like 2025-01-31, got %q", name, value)
+	}
+	return date, nil
+}
+
+func parseInt(values url.Values, name string) (int, error) {
+	n, err := parseInt64(values, name)
+	return int(n), err
+}
+
+func parseInt64(values url.Values, name string) (int64, error) {
+	value := values.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number, got %q", name, value)
+	}
+	return n, nil
+}
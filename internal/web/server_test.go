@@ -0,0 +1,166 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"linkedin-job-scraper/internal/models"
+)
+
+type fakeSource struct {
+	jobs    []models.JobPosting
+	filters []models.JobFilter
+	err     error
+}
+
+func (f *fakeSource) ListJobs(filter models.JobFilter) ([]models.JobPosting, error) {
+	f.filters = append(f.filters, filter)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return append([]models.JobPosting(nil), f.jobs...), nil
+}
+
+func (f *fakeSource) GetJob(linkedinJobID int) (*models.JobPosting, error) {
+	for _, job := range f.jobs {
+		if job.LinkedInJobID == linkedinJobID {
+			return &job, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeSource) GetJobRatings(jobID int) ([]models.JobRating, error) {
+	return []models.JobRating{{JobID: jobID, OverallScore: 80, RatingType: "ai"}}, nil
+}
+
+func (f *fakeSource) GetJobHistory(linkedinJobID int) ([]models.JobSnapshot, error) {
+	return nil, nil
+}
+
+func (f *fakeSource) ListCompanies(filter models.CompanyFilter) ([]models.Company, error) {
+	return []models.Company{{CompanyID: 1, Name: "Novo Nordisk"}}, nil
+}
+
+func (f *fakeSource) GetQueueStats() (models.QueueStats, error) {
+	return models.QueueStats{Pending: 3, Done: 7}, nil
+}
+
+func (f *fakeSource) ListRuns(limit int) ([]models.ScrapeRun, error) {
+	return nil, nil
+}
+
+func get(t *testing.T, server *Server, path string) (int, map[string]interface{}) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	var body map[string]interface{}
+	if strings.HasPrefix(path, "/api/") {
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+			t.Fatalf("GET %s: invalid JSON %q", path, rec.Body.String())
+		}
+	}
+	return rec.Code, body
+}
+
+func TestParseJobFilter(t *testing.T) {
+	values, _ := url.ParseQuery("skill=go,%20docker&skill=php&company=novo&work_type=Remote" +
+		"&posted_after=2025-01-01&min_rating=70&status=closed&limit=9999&offset=20")
+	filter, err := ParseJobFilter(values)
+	if err != nil {
+		t.Fatalf("ParseJobFilter() error = %v", err)
+	}
+
+	want := models.JobFilter{
+		Closed:      true,
+		PostedAfter: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Skills:      []string{"go", "docker", "php"},
+		Company:     "novo",
+		WorkType:    "Remote",
+		MinRating:   70,
+		NewestFirst: true,
+		Limit:       MaxLimit,
+		Offset:      20,
+	}
+	if !reflect.DeepEqual(filter, want) {
+		t.Errorf("ParseJobFilter() = %+v, want %+v", filter, want)
+	}
+
+	for _, query := range []string{"status=gone", "posted_before=31-01-2025", "min_rating=high"} {
+		values, _ := url.ParseQuery(query)
+		if _, err := ParseJobFilter(values); err == nil {
+			t.Errorf("ParseJobFilter(%s) expected an error", query)
+		}
+	}
+}
+
+func TestServerEndpoints(t *testing.T) {
+	source := &fakeSource{jobs: []models.JobPosting{
+		{JobID: 1, LinkedInJobID: 101, Title: "Go Developer", Description: "Build services in Go."},
+	}}
+	server := NewServer(source)
+
+	status, body := get(t, server, "/api/jobs?work_type=Hybrid")
+	if status != http.StatusOK || body["count"] != 1.0 {
+		t.Fatalf("GET /api/jobs = %d %v", status, body)
+	}
+	if job := body["jobs"].([]interface{})[0].(map[string]interface{}); job["description"] != "" {
+		t.Errorf("job list includes the description %q", job["description"])
+	}
+	if source.filters[0].WorkType != "Hybrid" || source.filters[0].Limit != DefaultLimit {
+		t.Errorf("filter = %+v, want work type Hybrid and the default limit", source.filters[0])
+	}
+
+	status, body = get(t, server, "/api/jobs/101")
+	if status != http.StatusOK || len(body["ratings"].([]interface{})) != 1 || body["snapshots"] == nil {
+		t.Errorf("GET /api/jobs/101 = %d %v", status, body)
+	}
+	if job := body["job"].(map[string]interface{}); job["description"] != "Build services in Go." {
+		t.Errorf("job detail description = %q", job["description"])
+	}
+
+	if status, _ := get(t, server, "/api/jobs/999"); status != http.StatusNotFound {
+		t.Errorf("GET unknown job = %d, want 404", status)
+	}
+	if status, _ := get(t, server, "/api/jobs?posted_after=yesterday"); status != http.StatusBadRequest {
+		t.Errorf("GET with a bad date = %d, want 400", status)
+	}
+
+	status, body = get(t, server, "/api/queue")
+	if queue := body["queue"].(map[string]interface{}); status != http.StatusOK || queue["pending"] != 3.0 {
+		t.Errorf("GET /api/queue = %d %v", status, body)
+	}
+
+	if status, body = get(t, server, "/api/runs"); status != http.StatusOK || body["runs"] == nil {
+		t.Errorf("GET /api/runs = %d %v, want an empty list", status, body)
+	}
+
+	if status, _ := get(t, server, "/"); status != http.StatusOK {
+		t.Errorf("GET / = %d, want the UI", status)
+	}
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/jobs", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST /api/jobs = %d, want 405", rec.Code)
+	}
+}
+
+func TestServerHidesInternalErrors(t *testing.T) {
+	server := NewServer(&fakeSource{err: errors.New("no such table: job_postings in /var/lib/scraper/jobs.db")})
+
+	status, body := get(t, server, "/api/jobs")
+	if status != http.StatusInternalServerError {
+		t.Fatalf("GET /api/jobs status = %d, want 500", status)
+	}
+	if body["message"] != "internal server error" {
+		t.Errorf("message = %v, want the error kept from the client", body["message"])
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"linkedin-user-scraper/internal/config"
+	"linkedin-user-scraper/internal/models"
+	"linkedin-user-scraper/internal/profile"
+	"linkedin-user-scraper/internal/services"
+	"linkedin-user-scraper/internal/skills"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var candidateProfileCmd = &cobra.Command{
+	Use:   "candidate-profile",
+	Short: "Generate a job match config for a scraped LinkedIn user",
+	Long: `Builds the candidate section of the job scraper's job_match_config.json from a scraped
+user: years of experience, primary and secondary skills, preferred roles and location.
+Everything a profile can't tell (avoid_roles, work preferences, salary, weights) is kept
+from the base config. Rate jobs for the user with the job scraper's "rate --config <output>".`,
+	Run: func(cmd *cobra.Command, args []string) {
+		username, _ := cmd.Flags().GetString("username")
+		file, _ := cmd.Flags().GetString("file")
+		base, _ := cmd.Flags().GetString("base")
+		output, _ := cmd.Flags().GetString("output")
+		debug, _ := cmd.Flags().GetBool("debug")
+
+		if debug {
+			logrus.SetLevel(logrus.DebugLevel)
+			logrus.Info("🐛 Debug mode enabled")
+		}
+
+		if username == "" && file == "" {
+			logrus.Fatal("No user provided. Use --username or --file")
+		}
+
+		runCandidateProfile(cleanUsername(username), file, base, output)
+	},
+}
+
+func init() {
+	candidateProfileCmd.Flags().StringP("username", "u", "", "LinkedIn username or full profile URL of a saved user")
+	candidateProfileCmd.Flags().StringP("file", "f", "", "User JSON file (as dumped with DUMP_DATA_TO_CONSOLE) instead of the API")
+	candidateProfileCmd.Flags().StringP("base", "b", profile.DefaultConfigPath, "Match config to take the preferences and weights from")
+	candidateProfileCmd.Flags().StringP("output", "o", "", "Match config file to write (default: <username>_match_config.json)")
+	candidateProfileCmd.Flags().BoolP("debug", "d", false, "Enable debug mode")
+
+	rootCmd.AddCommand(candidateProfileCmd)
+}
+
+func runCandidateProfile(username, file, base, output string) {
+	// Initialize configuration
+	cfg := config.Load()
+
+	// Setup logging
+	setupLogging(cfg.LogLevel)
+
+	user, err := loadUser(cfg, username, file)
+	if err != nil {
+		logrus.Fatal("Failed to load user: ", err)
+	}
+
+	taxonomy, err := skills.LoadTaxonomy(cfg.Skills.TaxonomyPath)
+	if err != nil {
+		logrus.Warnf("⚠️ Skills will not be normalised: %v", err)
+	}
+
+	candidate := profile.FromUser(user, taxonomy, profile.DefaultOptions)
+	if candidate.Name == "" {
+		candidate.Name = username
+	}
+
+	if output == "" {
+		output = fmt.Sprintf("%s_match_config.json", candidate.Name)
+	}
+
+	if err := profile.WriteConfig(output, base, candidate); err != nil {
+		logrus.Fatal("Failed to write match config: ", err)
+	}
+
+	summary, _ := json.MarshalIndent(candidate, "", "  ")
+	fmt.Println(string(summary))
+	fmt.Printf("\n🎉 Candidate profile written to %s\n", output)
+	fmt.Printf("   Rate jobs for %s with: linkedin-scraper rate --config %s\n", candidate.Name, output)
+}
+
+// loadUser reads a user from a JSON file, or from the Laravel API by username
+func loadUser(cfg *config.Config, username, file string) (*models.User, error) {
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		return services.ParseUser(data)
+	}
+
+	dataService := services.NewDataService(cfg)
+	defer dataService.Close()
+
+	logrus.Infof("📡 Loading user from API: %s", username)
+	return dataService.GetUser(username)
+}
+
+// cleanUsername extracts the username if a full profile URL was provided
+func cleanUsername(username string) string {
+	username = strings.TrimSpace(username)
+	if strings.Contains(username, "linkedin.com/in/") {
+		parts := strings.Split(username, "/in/")
+		if len(parts) > 1 {
+			username = strings.TrimSuffix(parts[1], "/")
+		}
+	}
+	return username
+}
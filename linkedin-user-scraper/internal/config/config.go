@@ -12,6 +12,7 @@ type Config struct {
 	Redis    RedisConfig
 	API      APIConfig
 	Debug    DebugConfig
+	Skills   SkillsConfig
 	LogLevel string
 }
 
@@ -44,6 +45,10 @@ type DebugConfig struct {
 	DumpDataToConsole bool
 }
 
+type SkillsConfig struct {
+	TaxonomyPath string // The job scraper's skill_taxonomy.json
+}
+
 func Load() *Config {
 	return &Config{
 		LinkedIn: LinkedInConfig{
@@ -70,6 +75,9 @@ func Load() *Config {
 		Debug: DebugConfig{
 			DumpDataToConsole: getEnvAsBool("DUMP_DATA_TO_CONSOLE", false),
 		},
+		Skills: SkillsConfig{
+			TaxonomyPath: getEnv("SKILL_TAXONOMY_PATH", "../skill_taxonomy.json"),
+		},
 		LogLevel: getEnv("LOG_LEVEL", "info"),
 	}
 }
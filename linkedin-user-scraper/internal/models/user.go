@@ -151,8 +151,9 @@ type VolunteerExperience struct {
 
 // Skill represents the skills table
 type Skill struct {
-	ID        int       `json:"id" db:"id"`
-	Name      string    `json:"name" db:"name"`
+	ID          int       `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	CanonicalID string    `json:"canonical_id,omitempty" db:"canonical_id"` // From skill_taxonomy.json, empty if unknown
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
@@ -0,0 +1,297 @@
+// Package profile turns a scraped LinkedIn user into the candidate section of the job
+// scraper's job_match_config.json, so jobs can be rated for that user.
+package profile
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"linkedin-user-scraper/internal/models"
+	"linkedin-user-scraper/internal/skills"
+)
+
+// Candidate is the generated part of the candidate section in job_match_config.json.
+// The JSON tags match the job scraper's match.Candidate.
+type Candidate struct {
+	Name            string   `json:"name"`
+	Location        string   `json:"location"`
+	YearsExperience int      `json:"years_experience"`
+	PrimarySkills   []string `json:"primary_skills"`
+	SecondarySkills []string `json:"secondary_skills"`
+	PreferredRoles  []string `json:"preferred_roles"`
+
+	// City is where the user lives, the commute origin in work_preferences; "" if unknown
+	City string `json:"-"`
+}
+
+// Options controls how many skills and roles end up in the candidate profile
+type Options struct {
+	PrimarySkills   int
+	SecondarySkills int
+	PreferredRoles  int
+	RecentRoleYears int       // Titles of positions that ended longer ago aren't preferred roles
+	HalfLifeYears   float64   // A skill last used this many years ago counts half
+	Now             time.Time // Ends current positions; defaults to time.Now()
+}
+
+// DefaultOptions mirror the size of the hand-written job_match_config.json
+var DefaultOptions = Options{
+	PrimarySkills:   6,
+	SecondarySkills: 6,
+	PreferredRoles:  5,
+	RecentRoleYears: 5,
+	HalfLifeYears:   3,
+}
+
+// profileSkillRecency is the recency of a skill only listed on the profile, not on a position
+const profileSkillRecency = 0.25
+
+// FromUser builds a candidate from a scraped user. Skills are ranked by frequency times the
+// recency of the last position that used them; the taxonomy merges synonyms and may be nil.
+func FromUser(user *models.User, taxonomy *skills.Taxonomy, options Options) Candidate {
+	now := options.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	positions := datedPositions(user.Positions, now)
+	ranked := rankSkills(user, positions, taxonomy, options.HalfLifeYears, now)
+
+	candidate := Candidate{
+		Name:            username(user.LinkedInURL),
+		Location:        location(user),
+		YearsExperience: yearsOfExperience(positions),
+		PrimarySkills:   take(ranked, 0, options.PrimarySkills),
+		SecondarySkills: take(ranked, options.PrimarySkills, options.SecondarySkills),
+		PreferredRoles:  recentTitles(positions, now.AddDate(-options.RecentRoleYears, 0, 0), options.PreferredRoles),
+		City:            strings.TrimSpace(user.LocationCity),
+	}
+	return candidate
+}
+
+// datedPosition is a position with its resolved date range
+type datedPosition struct {
+	models.Position
+	start, end time.Time // end is now for current positions
+}
+
+// datedPositions resolves position dates, newest first. Positions without a start date
+// are kept with a zero range so their skills and titles still count.
+func datedPositions(positions []models.Position, now time.Time) []datedPosition {
+	dated := make([]datedPosition, 0, len(positions))
+	for _, pos := range positions {
+		start, ok := positionDate(pos.StartDate, pos.StartYear, pos.StartMonth)
+		end, hasEnd := positionDate(pos.EndDate, pos.EndYear, pos.EndMonth)
+		if !hasEnd || end.After(now) {
+			end = now
+		}
+		if !ok || start.After(end) {
+			start = end
+		}
+		dated = append(dated, datedPosition{Position: pos, start: start, end: end})
+	}
+
+	sort.SliceStable(dated, func(i, j int) bool {
+		if !dated[i].end.Equal(dated[j].end) {
+			return dated[i].end.After(dated[j].end)
+		}
+		return dated[i].start.After(dated[j].start)
+	})
+	return dated
+}
+
+// positionDate prefers the parsed date and falls back to year and month
+func positionDate(date *time.Time, year, month *int) (time.Time, bool) {
+	if date != nil && !date.IsZero() {
+		return *date, true
+	}
+	if year == nil || *year == 0 {
+		return time.Time{}, false
+	}
+	m := 1
+	if month != nil && *month >= 1 && *month <= 12 {
+		m = *month
+	}
+	return time.Date(*year, time.Month(m), 1, 0, 0, 0, 0, time.UTC), true
+}
+
+// yearsOfExperience counts the time covered by any position, so overlapping
+// positions aren't counted twice
+func yearsOfExperience(positions []datedPosition) int {
+	ranges := make([]datedPosition, len(positions))
+	copy(ranges, positions)
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start.Before(ranges[j].start) })
+
+	var total time.Duration
+	var start, end time.Time
+	for _, r := range ranges {
+		if !r.end.After(r.start) {
+			continue
+		}
+		if end.IsZero() || r.start.After(end) {
+			total += end.Sub(start)
+			start, end = r.start, r.end
+		} else if r.end.After(end) {
+			end = r.end
+		}
+	}
+	total += end.Sub(start)
+
+	// Whole months first, so five calendar years aren't 4.999 years because of a leap day
+	months := int(math.Round(total.Hours() / 24 / (365.25 / 12)))
+	return months / 12
+}
+
+// rankedSkill is a skill with its score for ordering
+type rankedSkill struct {
+	name  string
+	score float64
+}
+
+// rankSkills orders the user's skills by frequency times recency, best first
+func rankSkills(user *models.User, positions []datedPosition, taxonomy *skills.Taxonomy, halfLife float64, now time.Time) []string {
+	names := make(map[string]string)
+	frequency := make(map[string]int)
+	recency := make(map[string]float64)
+	var order []string
+
+	add := func(skill models.Skill) string {
+		key := skillKey(skill, taxonomy)
+		if key == "" {
+			return ""
+		}
+		if _, ok := names[key]; !ok {
+			name := taxonomy.Name(key)
+			if name == "" {
+				name = strings.TrimSpace(skill.Name)
+			}
+			names[key] = name
+			order = append(order, key)
+		}
+		return key
+	}
+
+	for _, pos := range positions {
+		weight := decay(now.Sub(pos.end), halfLife)
+		for _, skill := range pos.Skills {
+			if key := add(skill); key != "" {
+				frequency[key]++
+				recency[key] = math.Max(recency[key], weight)
+			}
+		}
+	}
+	for _, edu := range user.Educations {
+		weight := profileSkillRecency
+		if edu.EndYear != nil && *edu.EndYear > 0 {
+			end := time.Date(*edu.EndYear, time.June, 30, 0, 0, 0, 0, time.UTC)
+			weight = decay(now.Sub(end), halfLife)
+		}
+		for _, skill := range edu.Skills {
+			if key := add(skill); key != "" {
+				frequency[key]++
+				recency[key] = math.Max(recency[key], weight)
+			}
+		}
+	}
+	for _, skill := range user.Skills {
+		if key := add(skill); key != "" {
+			recency[key] = math.Max(recency[key], profileSkillRecency)
+		}
+	}
+
+	// The scraper's frequencies include skill pages not tied to a position, so they win
+	// when they are higher than what the positions and educations above add up to
+	scraped := make(map[string]int)
+	for _, freq := range user.SkillFrequencies {
+		if key := add(freq.Skill); key != "" {
+			scraped[key] += freq.Frequency
+			recency[key] = math.Max(recency[key], profileSkillRecency)
+		}
+	}
+
+	ranked := make([]rankedSkill, 0, len(order))
+	for _, key := range order {
+		count := max(frequency[key], scraped[key], 1)
+		ranked = append(ranked, rankedSkill{name: names[key], score: float64(count) * recency[key]})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].score > ranked[j].score })
+
+	result := make([]string, len(ranked))
+	for i, skill := range ranked {
+		result[i] = skill.name
+	}
+	return result
+}
+
+// skillKey identifies a skill: its canonical ID, or the folded label if it has none
+func skillKey(skill models.Skill, taxonomy *skills.Taxonomy) string {
+	if skill.CanonicalID != "" {
+		return skill.CanonicalID
+	}
+	if id, ok := taxonomy.Normalise(skill.Name); ok {
+		return id
+	}
+	return strings.ToLower(strings.TrimSpace(skill.Name))
+}
+
+// decay halves a weight for every halfLife years that have passed
+func decay(age time.Duration, halfLife float64) float64 {
+	if age <= 0 || halfLife <= 0 {
+		return 1
+	}
+	years := age.Hours() / 24 / 365.25
+	return math.Pow(0.5, years/halfLife)
+}
+
+// recentTitles returns the distinct titles of positions that ended after since, newest
+// first. The latest title is always included, however old.
+func recentTitles(positions []datedPosition, since time.Time, limit int) []string {
+	seen := make(map[string]bool)
+	titles := make([]string, 0, limit)
+	for _, pos := range positions {
+		if len(titles) >= limit || (len(titles) > 0 && pos.end.Before(since)) {
+			break
+		}
+		title := strings.TrimSpace(pos.Title)
+		key := strings.ToLower(title)
+		if title == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		titles = append(titles, title)
+	}
+	return titles
+}
+
+// location joins city and country, leaving out the country if the city already names it
+func location(user *models.User) string {
+	city := strings.TrimSpace(user.LocationCity)
+	country := strings.TrimSpace(user.LocationCountry)
+	switch {
+	case city == "":
+		return country
+	case country == "" || strings.Contains(strings.ToLower(city), strings.ToLower(country)):
+		return city
+	}
+	return city + ", " + country
+}
+
+// username extracts the profile slug from a LinkedIn URL
+func username(linkedinURL string) string {
+	parts := strings.Split(strings.TrimSuffix(linkedinURL, "/"), "/in/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// take returns up to n items starting at offset
+func take(items []string, offset, n int) []string {
+	result := []string{}
+	for i := offset; i < len(items) && i < offset+n; i++ {
+		result = append(result, items[i])
+	}
+	return result
+}
@@ -0,0 +1,221 @@
+package profile
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"linkedin-user-scraper/internal/models"
+	"linkedin-user-scraper/internal/skills"
+)
+
+var testNow = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func date(year int, month time.Month) *time.Time {
+	t := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func skillList(names ...string) []models.Skill {
+	list := make([]models.Skill, len(names))
+	for i, name := range names {
+		list[i] = models.Skill{Name: name}
+	}
+	return list
+}
+
+func loadTaxonomy(t *testing.T) *skills.Taxonomy {
+	t.Helper()
+	taxonomy, err := skills.LoadTaxonomy("../../../skill_taxonomy.json")
+	if err != nil {
+		t.Fatalf("LoadTaxonomy() error = %v", err)
+	}
+	return taxonomy
+}
+
+func TestYearsOfExperience(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []models.Position
+		want      int
+	}{
+		{"none", nil, 0},
+		{"single", []models.Position{
+			{StartDate: date(2015, time.January), EndDate: date(2020, time.January)},
+		}, 5},
+		{"overlapping", []models.Position{
+			{StartDate: date(2010, time.January), EndDate: date(2015, time.January)},
+			{StartDate: date(2013, time.January), EndDate: date(2018, time.January)},
+		}, 8},
+		{"nested", []models.Position{
+			{StartDate: date(2012, time.January), EndDate: date(2014, time.January)},
+			{StartDate: date(2010, time.January), EndDate: date(2020, time.January)},
+		}, 10},
+		{"gap", []models.Position{
+			{StartDate: date(2010, time.January), EndDate: date(2012, time.January)},
+			{StartDate: date(2014, time.January), EndDate: date(2016, time.January)},
+		}, 4},
+		{"current position ends now", []models.Position{
+			{StartDate: date(2020, time.January)},
+		}, 5},
+		{"end in the future", []models.Position{
+			{StartDate: date(2022, time.January), EndDate: date(2030, time.January)},
+		}, 3},
+		{"year and month without dates", []models.Position{
+			{StartYear: intPtr(2016), StartMonth: intPtr(7), EndYear: intPtr(2019), EndMonth: intPtr(7)},
+		}, 3},
+		{"year without month", []models.Position{
+			{StartYear: intPtr(2016), EndYear: intPtr(2018)},
+		}, 2},
+		{"missing start doesn't count", []models.Position{
+			{EndDate: date(2020, time.January)},
+			{StartDate: date(2010, time.January), EndDate: date(2015, time.January)},
+		}, 5},
+		{"start after end doesn't count", []models.Position{
+			{StartDate: date(2021, time.January), EndDate: date(2019, time.January)},
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := yearsOfExperience(datedPositions(tt.positions, testNow)); got != tt.want {
+				t.Errorf("yearsOfExperience() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankSkills(t *testing.T) {
+	taxonomy := loadTaxonomy(t)
+
+	tests := []struct {
+		name     string
+		user     models.User
+		taxonomy *skills.Taxonomy
+		want     []string
+	}{
+		{
+			name: "frequency",
+			user: models.User{Positions: []models.Position{
+				{StartDate: date(2020, time.January), Skills: skillList("PHP", "Go")},
+				{StartDate: date(2018, time.January), EndDate: date(2020, time.January), Skills: skillList("Go")},
+			}},
+			want: []string{"Go", "PHP"},
+		},
+		{
+			// Two uses ten years ago count 2 * 0.5^(10/3), less than one use now
+			name: "recency beats frequency",
+			user: models.User{Positions: []models.Position{
+				{StartDate: date(2022, time.January), Skills: skillList("Laravel")},
+				{StartDate: date(2012, time.January), EndDate: date(2015, time.January), Skills: skillList("Java")},
+				{StartDate: date(2010, time.January), EndDate: date(2012, time.January), Skills: skillList("Java")},
+			}},
+			want: []string{"Laravel", "Java"},
+		},
+		{
+			name: "synonyms merge into the canonical name",
+			user: models.User{Positions: []models.Position{
+				{StartDate: date(2020, time.January), Skills: skillList("Golang", "PHP")},
+				{StartDate: date(2018, time.January), EndDate: date(2020, time.January), Skills: skillList("Go (Programming Language)")},
+			}},
+			taxonomy: taxonomy,
+			want:     []string{"Go", "PHP"},
+		},
+		{
+			name: "canonical ID from the scraper wins over the label",
+			user: models.User{Positions: []models.Position{
+				{StartDate: date(2020, time.January), Skills: []models.Skill{
+					{Name: "PHP"}, {Name: "Golang", CanonicalID: "go"}, {Name: "Go", CanonicalID: "go"},
+				}},
+			}},
+			want: []string{"Golang", "PHP"},
+		},
+		{
+			name: "without a taxonomy labels are folded",
+			user: models.User{Positions: []models.Position{
+				{StartDate: date(2020, time.January), Skills: skillList("Docker", "docker ", "Git")},
+			}},
+			want: []string{"Docker", "Git"},
+		},
+		{
+			name: "profile-only skills rank after position skills",
+			user: models.User{
+				Skills:    skillList("Cobol", "Python"),
+				Positions: []models.Position{{StartDate: date(2020, time.January), Skills: skillList("Python")}},
+			},
+			want: []string{"Python", "Cobol"},
+		},
+		{
+			name: "education skills decay from the end year",
+			user: models.User{
+				Educations: []models.Education{{EndYear: intPtr(2024), Skills: skillList("Java")}},
+				Positions:  []models.Position{{StartDate: date(2005, time.January), EndDate: date(2010, time.January), Skills: skillList("Perl")}},
+			},
+			want: []string{"Java", "Perl"},
+		},
+		{
+			// Docker counts 5 * 0.25, Git 1 * 1
+			name: "scraped frequencies win when higher",
+			user: models.User{
+				Positions: []models.Position{{StartDate: date(2020, time.January), Skills: skillList("Git")}},
+				SkillFrequencies: []models.SkillFrequency{
+					{Skill: models.Skill{Name: "Docker"}, Frequency: 5},
+					{Skill: models.Skill{Name: "Git"}, Frequency: 1},
+				},
+			},
+			want: []string{"Docker", "Git"},
+		},
+		{
+			name: "blank labels are skipped",
+			user: models.User{Skills: skillList(" ", "Go")},
+			want: []string{"Go"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			positions := datedPositions(tt.user.Positions, testNow)
+			got := rankSkills(&tt.user, positions, tt.taxonomy, DefaultOptions.HalfLifeYears, testNow)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rankSkills() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromUser(t *testing.T) {
+	user := &models.User{
+		LinkedInURL:     "https://www.linkedin.com/in/jane-doe/",
+		LocationCity:    "Aarhus",
+		LocationCountry: "Denmark",
+		Positions: []models.Position{
+			{Title: "Old Title", StartDate: date(2005, time.January), EndDate: date(2010, time.January), Skills: skillList("Perl")},
+			{Title: "Backend Developer", StartDate: date(2016, time.January), EndDate: date(2021, time.January), Skills: skillList("Go", "PHP", "MySQL")},
+			{Title: "Senior Developer", StartDate: date(2021, time.January), Skills: skillList("Golang", "Docker")},
+			{Title: "senior developer", StartDate: date(2020, time.June), EndDate: date(2021, time.March)},
+		},
+	}
+	options := Options{PrimarySkills: 2, SecondarySkills: 2, PreferredRoles: 5, RecentRoleYears: 5, HalfLifeYears: 3, Now: testNow}
+
+	got := FromUser(user, loadTaxonomy(t), options)
+	want := Candidate{
+		Name:            "jane-doe",
+		Location:        "Aarhus, Denmark",
+		YearsExperience: 14, // 2005-2010 and 2016-2025
+		PrimarySkills:   []string{"Go", "Docker"},
+		SecondarySkills: []string{"PHP", "MySQL"},
+		PreferredRoles:  []string{"Senior Developer", "Backend Developer"},
+		City:            "Aarhus",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromUser() = %+v, want %+v", got, want)
+	}
+
+	// Without recent positions the latest title is still a preferred role
+	old := &models.User{Positions: []models.Position{{Title: "Old Title", StartDate: date(2005, time.January), EndDate: date(2010, time.January)}}}
+	if got := FromUser(old, nil, options).PreferredRoles; !reflect.DeepEqual(got, []string{"Old Title"}) {
+		t.Errorf("PreferredRoles = %v, want [Old Title]", got)
+	}
+}
@@ -0,0 +1,100 @@
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// DefaultConfigPath is the job scraper's hand-written match config, used as the base
+// for the preferences and weights a scraped profile can't tell
+const DefaultConfigPath = "../job_match_config.json"
+
+// maxCommutePattern splits a commute preference like "45 minutes from Roskilde" into the
+// duration and the origin, like the job scraper's commute.ParseMaxCommute
+var maxCommutePattern = regexp.MustCompile(`(?i)^\s*(\d+\s*\pL+)\s+(?:from|fra)\s+.+$`)
+
+// MergeConfig replaces the generated fields of the candidate section in a job match config,
+// keeping everything else (avoid_roles, work_preferences, weights, ...) from the base. The
+// base commute origin belongs to someone else: max_commute is moved to the candidate's
+// city, and dropped with home_postal_code when the city is unknown.
+func MergeConfig(base []byte, candidate Candidate) ([]byte, error) {
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(base, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse match config: %w", err)
+	}
+
+	section := make(map[string]json.RawMessage)
+	if raw, ok := config["candidate"]; ok {
+		if err := json.Unmarshal(raw, &section); err != nil {
+			return nil, fmt.Errorf("failed to parse candidate section: %w", err)
+		}
+	}
+
+	generated, err := json.Marshal(candidate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal candidate: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(generated, &fields); err != nil {
+		return nil, fmt.Errorf("failed to marshal candidate: %w", err)
+	}
+	for key, value := range fields {
+		section[key] = value
+	}
+	if raw, ok := section["work_preferences"]; ok {
+		if section["work_preferences"], err = moveCommuteOrigin(raw, candidate.City); err != nil {
+			return nil, err
+		}
+	}
+
+	if config["candidate"], err = json.Marshal(section); err != nil {
+		return nil, fmt.Errorf("failed to marshal candidate section: %w", err)
+	}
+	return json.MarshalIndent(config, "", "  ")
+}
+
+// moveCommuteOrigin points the commute preferences at city, keeping the base max commute time
+func moveCommuteOrigin(raw json.RawMessage, city string) (json.RawMessage, error) {
+	var prefs map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &prefs); err != nil {
+		return nil, fmt.Errorf("failed to parse work preferences: %w", err)
+	}
+
+	// The postal code overrides the max_commute origin, and the profile only has a city
+	delete(prefs, "home_postal_code")
+
+	var maxCommute string
+	if value, ok := prefs["max_commute"]; ok {
+		if err := json.Unmarshal(value, &maxCommute); err != nil {
+			return nil, fmt.Errorf("failed to parse max_commute: %w", err)
+		}
+	}
+	matches := maxCommutePattern.FindStringSubmatch(maxCommute)
+	if city == "" || matches == nil {
+		delete(prefs, "max_commute")
+	} else {
+		prefs["max_commute"], _ = json.Marshal(matches[1] + " from " + city)
+	}
+
+	return json.Marshal(prefs)
+}
+
+// WriteConfig merges the candidate into the config at basePath and writes it to path
+func WriteConfig(path, basePath string, candidate Candidate) error {
+	base, err := os.ReadFile(basePath)
+	if err != nil {
+		return fmt.Errorf("failed to read base match config: %w", err)
+	}
+
+	data, err := MergeConfig(base, candidate)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write match config: %w", err)
+	}
+	return nil
+}
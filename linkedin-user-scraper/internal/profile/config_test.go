@@ -0,0 +1,72 @@
+package profile
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testBaseConfig = `{
+  "candidate": {
+    "name": "Senior Developer",
+    "location": "Roskilde, Denmark",
+    "avoid_roles": ["Manager"],
+    "work_preferences": {
+      "remote": true,
+      "max_commute": "45 minutes from Roskilde",
+      "home_postal_code": "4000",
+      "commute_mode": "car"
+    }
+  },
+  "weights": {"location": 25}
+}`
+
+func TestMergeConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		city           string
+		wantMaxCommute string
+	}{
+		{"commute from the candidate's city", "Aarhus", "45 minutes from Aarhus"},
+		{"no commute without a city", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidate := Candidate{Name: "jane-doe", Location: tt.city, City: tt.city}
+			data, err := MergeConfig([]byte(testBaseConfig), candidate)
+			if err != nil {
+				t.Fatalf("MergeConfig() error = %v", err)
+			}
+
+			var config struct {
+				Candidate struct {
+					Name            string           `json:"name"`
+					AvoidRoles      []string         `json:"avoid_roles"`
+					WorkPreferences map[string]any   `json:"work_preferences"`
+					City            *json.RawMessage `json:"City"`
+				} `json:"candidate"`
+				Weights map[string]int `json:"weights"`
+			}
+			if err := json.Unmarshal(data, &config); err != nil {
+				t.Fatalf("merged config is invalid JSON: %v", err)
+			}
+
+			c := config.Candidate
+			if c.Name != "jane-doe" || len(c.AvoidRoles) != 1 || config.Weights["location"] != 25 {
+				t.Errorf("merged config = %s, want generated fields replaced and the rest kept", data)
+			}
+			if c.City != nil {
+				t.Error("City was written to the config")
+			}
+			prefs := c.WorkPreferences
+			if _, ok := prefs["home_postal_code"]; ok {
+				t.Errorf("home_postal_code = %v, want the base user's postal code removed", prefs["home_postal_code"])
+			}
+			if got, _ := prefs["max_commute"].(string); got != tt.wantMaxCommute {
+				t.Errorf("max_commute = %q, want %q", got, tt.wantMaxCommute)
+			}
+			if prefs["commute_mode"] != "car" || prefs["remote"] != true {
+				t.Errorf("work_preferences = %v, want the other preferences kept", prefs)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"linkedin-user-scraper/internal/config"
 	"linkedin-user-scraper/internal/models"
 	"linkedin-user-scraper/internal/services"
+	"linkedin-user-scraper/internal/skills"
 	"os"
 	"strings"
 	"time"
@@ -19,14 +20,35 @@ import (
 type LinkedInUserScraper struct {
 	config      *config.Config
 	dataService *services.DataService
+	skills      *skills.Taxonomy // Nil if the taxonomy couldn't be loaded
 }
 
 // NewLinkedInUserScraper creates a new LinkedIn user scraper
 func NewLinkedInUserScraper(cfg *config.Config, dataService *services.DataService) *LinkedInUserScraper {
+	taxonomy, err := skills.LoadTaxonomy(cfg.Skills.TaxonomyPath)
+	if err != nil {
+		logrus.Warnf("⚠️ Skills will not be normalised: %v", err)
+	}
+
 	return &LinkedInUserScraper{
 		config:      cfg,
 		dataService: dataService,
+		skills:      taxonomy,
+	}
+}
+
+// newSkill creates a skill with its canonical ID, so "Golang" and "Go" count as one skill
+func (s *LinkedInUserScraper) newSkill(name string) models.Skill {
+	canonicalID, _ := s.skills.Normalise(name)
+	return models.Skill{Name: name, CanonicalID: canonicalID}
+}
+
+// skillKey identifies a skill for counting: its canonical ID, or the label if it has none
+func skillKey(skill models.Skill) string {
+	if skill.CanonicalID != "" {
+		return skill.CanonicalID
 	}
+	return skill.Name
 }
 
 // ScrapeUser scrapes a LinkedIn user profile
@@ -564,6 +586,7 @@ func (s *LinkedInUserScraper) extractUserData(ctx context.Context, username stri
 	// Process all skill URLs AFTER extracting all basic profile data
 	logrus.Info("🔍 Now processing all skill URLs by navigating to overlay pages...")
 	skillFrequencyMap := make(map[string]int)
+	skillsByKey := make(map[string]models.Skill) // First label seen for each skill
 	
 	for i, skillUrlData := range skillUrls {
 		url, _ := skillUrlData["url"].(string)
@@ -589,9 +612,12 @@ func (s *LinkedInUserScraper) extractUserData(ctx context.Context, username stri
 			for j := range user.Positions {
 				if strings.EqualFold(user.Positions[j].Title, title) {
 					for _, skillName := range skills {
-						skillModel := models.Skill{Name: skillName}
+						skillModel := s.newSkill(skillName)
 						user.Positions[j].Skills = append(user.Positions[j].Skills, skillModel)
-						skillFrequencyMap[skillName]++
+						if _, seen := skillsByKey[skillKey(skillModel)]; !seen {
+							skillsByKey[skillKey(skillModel)] = skillModel
+						}
+						skillFrequencyMap[skillKey(skillModel)]++
 					}
 					logrus.Infof("✅ Added %d skills to position: %s", len(skills), title)
 					break
@@ -602,9 +628,12 @@ func (s *LinkedInUserScraper) extractUserData(ctx context.Context, username stri
 			for j := range user.Educations {
 				if strings.EqualFold(user.Educations[j].SchoolName, title) {
 					for _, skillName := range skills {
-						skillModel := models.Skill{Name: skillName}
+						skillModel := s.newSkill(skillName)
 						user.Educations[j].Skills = append(user.Educations[j].Skills, skillModel)
-						skillFrequencyMap[skillName]++
+						if _, seen := skillsByKey[skillKey(skillModel)]; !seen {
+							skillsByKey[skillKey(skillModel)] = skillModel
+						}
+						skillFrequencyMap[skillKey(skillModel)]++
 					}
 					logrus.Infof("✅ Added %d skills to education: %s", len(skills), title)
 					break
@@ -619,9 +648,9 @@ func (s *LinkedInUserScraper) extractUserData(ctx context.Context, username stri
 	}
 	
 	// Create skill frequency list
-	for skillName, frequency := range skillFrequencyMap {
+	for key, frequency := range skillFrequencyMap {
 		skillFreq := models.SkillFrequency{
-			Skill:     models.Skill{Name: skillName},
+			Skill:     skillsByKey[key],
 			Frequency: frequency,
 		}
 		user.SkillFrequencies = append(user.SkillFrequencies, skillFreq)
@@ -9,6 +9,7 @@ import (
 	"linkedin-user-scraper/internal/config"
 	"linkedin-user-scraper/internal/models"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,7 +27,7 @@ type SimplifiedUser struct {
 	Avatar          string                   `json:"avatar,omitempty"`
 	Positions       []SimplifiedPosition     `json:"positions"`
 	Educations      []SimplifiedEducation    `json:"educations"`
-	SkillFrequencies map[string]int          `json:"skill_frequencies"`
+	SkillFrequencies SimplifiedSkillFrequencies `json:"skill_frequencies"`
 }
 
 type SimplifiedPosition struct {
@@ -36,7 +37,7 @@ type SimplifiedPosition struct {
 	Location    string    `json:"location,omitempty"`
 	StartDate   *time.Time `json:"start_date,omitempty"`
 	EndDate     *time.Time `json:"end_date,omitempty"`
-	Skills      []string  `json:"skills,omitempty"`
+	Skills      []SimplifiedSkill `json:"skills,omitempty"`
 }
 
 type SimplifiedEducation struct {
@@ -44,7 +45,56 @@ type SimplifiedEducation struct {
 	Degree      string   `json:"degree,omitempty"`
 	StartYear   *int     `json:"start_year,omitempty"`
 	EndYear     *int     `json:"end_year,omitempty"`
-	Skills      []string `json:"skills,omitempty"`
+	Skills      []SimplifiedSkill `json:"skills,omitempty"`
+}
+
+// SimplifiedSkill is a skill label as scraped, with its canonical ID from skill_taxonomy.json
+type SimplifiedSkill struct {
+	Name        string `json:"name"`
+	CanonicalID string `json:"canonical_id,omitempty"`
+}
+
+// UnmarshalJSON also reads a bare label, as sent before canonical IDs were added
+func (s *SimplifiedSkill) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		*s = SimplifiedSkill{}
+		return json.Unmarshal(data, &s.Name)
+	}
+	type plain SimplifiedSkill
+	return json.Unmarshal(data, (*plain)(s))
+}
+
+// SimplifiedSkillFrequency is how many of the profile's skill pages list a skill
+type SimplifiedSkillFrequency struct {
+	Name        string `json:"name"`
+	CanonicalID string `json:"canonical_id,omitempty"`
+	Frequency   int    `json:"frequency"`
+}
+
+// SimplifiedSkillFrequencies are the skill frequencies of a user, most frequent first
+type SimplifiedSkillFrequencies []SimplifiedSkillFrequency
+
+// UnmarshalJSON also reads the object of label to frequency sent before canonical IDs were added
+func (f *SimplifiedSkillFrequencies) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '{' {
+		return json.Unmarshal(data, (*[]SimplifiedSkillFrequency)(f))
+	}
+
+	var byName map[string]int
+	if err := json.Unmarshal(data, &byName); err != nil {
+		return err
+	}
+	*f = make(SimplifiedSkillFrequencies, 0, len(byName))
+	for name, frequency := range byName {
+		*f = append(*f, SimplifiedSkillFrequency{Name: name, Frequency: frequency})
+	}
+	sort.Slice(*f, func(i, j int) bool {
+		if (*f)[i].Frequency != (*f)[j].Frequency {
+			return (*f)[i].Frequency > (*f)[j].Frequency
+		}
+		return (*f)[i].Name < (*f)[j].Name
+	})
+	return nil
 }
 
 type DataService struct {
@@ -98,7 +148,7 @@ func (ds *DataService) convertToSimplifiedUser(user *models.User) *SimplifiedUse
 		Avatar:      user.Avatar,
 		Positions:   make([]SimplifiedPosition, 0),
 		Educations:  make([]SimplifiedEducation, 0),
-		SkillFrequencies: make(map[string]int),
+		SkillFrequencies: make(SimplifiedSkillFrequencies, 0, len(user.SkillFrequencies)),
 	}
 
 	// Convert positions
@@ -110,12 +160,12 @@ func (ds *DataService) convertToSimplifiedUser(user *models.User) *SimplifiedUse
 			Location:    pos.Location,
 			StartDate:   pos.StartDate,
 			EndDate:     pos.EndDate,
-			Skills:      make([]string, 0),
+			Skills:      make([]SimplifiedSkill, 0),
 		}
 		
-		// Extract skill names
+		// Keep the canonical IDs next to the labels
 		for _, skill := range pos.Skills {
-			simplifiedPos.Skills = append(simplifiedPos.Skills, skill.Name)
+			simplifiedPos.Skills = append(simplifiedPos.Skills, SimplifiedSkill{Name: skill.Name, CanonicalID: skill.CanonicalID})
 		}
 		
 		simplified.Positions = append(simplified.Positions, simplifiedPos)
@@ -128,12 +178,12 @@ func (ds *DataService) convertToSimplifiedUser(user *models.User) *SimplifiedUse
 			Degree:     edu.Degree,
 			StartYear:  edu.StartYear,
 			EndYear:    edu.EndYear,
-			Skills:     make([]string, 0),
+			Skills:     make([]SimplifiedSkill, 0),
 		}
 		
-		// Extract skill names
+		// Keep the canonical IDs next to the labels
 		for _, skill := range edu.Skills {
-			simplifiedEdu.Skills = append(simplifiedEdu.Skills, skill.Name)
+			simplifiedEdu.Skills = append(simplifiedEdu.Skills, SimplifiedSkill{Name: skill.Name, CanonicalID: skill.CanonicalID})
 		}
 		
 		simplified.Educations = append(simplified.Educations, simplifiedEdu)
@@ -141,7 +191,11 @@ func (ds *DataService) convertToSimplifiedUser(user *models.User) *SimplifiedUse
 
 	// Convert skill frequencies
 	for _, freq := range user.SkillFrequencies {
-		simplified.SkillFrequencies[freq.Skill.Name] = freq.Frequency
+		simplified.SkillFrequencies = append(simplified.SkillFrequencies, SimplifiedSkillFrequency{
+			Name:        freq.Skill.Name,
+			CanonicalID: freq.Skill.CanonicalID,
+			Frequency:   freq.Frequency,
+		})
 	}
 
 	return simplified
@@ -290,6 +344,111 @@ func (ds *DataService) CheckUserExists(linkedinURL string) (bool, error) {
 	return userExists, nil
 }
 
+// GetUser loads a saved user from the Laravel API by LinkedIn username
+func (ds *DataService) GetUser(username string) (*models.User, error) {
+	url := fmt.Sprintf("%s/linkedin-profile/users/%s", ds.config.API.BaseURL, username)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-API-Key", ds.config.API.APIKey)
+
+	resp, err := ds.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make API request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("user not found: %s", username)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return ParseUser(body)
+}
+
+// ParseUser reads a user in the simplified format sent to the API and dumped to the
+// console, either bare or wrapped in a Laravel "data" envelope
+func ParseUser(data []byte) (*models.User, error) {
+	var envelope struct {
+		Data *SimplifiedUser `json:"data"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse user: %w", err)
+	}
+
+	simplified := envelope.Data
+	if simplified == nil {
+		simplified = &SimplifiedUser{}
+		if err := json.Unmarshal(data, simplified); err != nil {
+			return nil, fmt.Errorf("failed to parse user: %w", err)
+		}
+	}
+	if simplified.LinkedInURL == "" {
+		return nil, fmt.Errorf("user has no linkedin_url")
+	}
+
+	return convertFromSimplifiedUser(simplified), nil
+}
+
+// convertFromSimplifiedUser converts the simplified structure back to a User model
+func convertFromSimplifiedUser(simplified *SimplifiedUser) *models.User {
+	user := &models.User{
+		ID:           simplified.ID,
+		LinkedInURL:  simplified.LinkedInURL,
+		Headline:     simplified.Headline,
+		Summary:      simplified.Summary,
+		LocationCity: simplified.LocationCity,
+		Avatar:       simplified.Avatar,
+	}
+
+	for _, pos := range simplified.Positions {
+		position := models.Position{
+			Title:       pos.Title,
+			CompanyName: pos.CompanyName,
+			Summary:     pos.Summary,
+			Location:    pos.Location,
+			StartDate:   pos.StartDate,
+			EndDate:     pos.EndDate,
+		}
+		for _, skill := range pos.Skills {
+			position.Skills = append(position.Skills, models.Skill{Name: skill.Name, CanonicalID: skill.CanonicalID})
+		}
+		user.Positions = append(user.Positions, position)
+	}
+
+	for _, edu := range simplified.Educations {
+		education := models.Education{
+			SchoolName: edu.SchoolName,
+			Degree:     edu.Degree,
+			StartYear:  edu.StartYear,
+			EndYear:    edu.EndYear,
+		}
+		for _, skill := range edu.Skills {
+			education.Skills = append(education.Skills, models.Skill{Name: skill.Name, CanonicalID: skill.CanonicalID})
+		}
+		user.Educations = append(user.Educations, education)
+	}
+
+	for _, freq := range simplified.SkillFrequencies {
+		user.SkillFrequencies = append(user.SkillFrequencies, models.SkillFrequency{
+			Skill:     models.Skill{Name: freq.Name, CanonicalID: freq.CanonicalID},
+			Frequency: freq.Frequency,
+		})
+	}
+
+	return user
+}
+
 // GetQueueSize returns the size of the user processing queue
 func (ds *DataService) GetQueueSize() (int, error) {
 	size, err := ds.redisClient.LLen(context.Background(), "user_queue").Result()
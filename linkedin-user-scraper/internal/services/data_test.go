@@ -0,0 +1,73 @@
+package services
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"linkedin-user-scraper/internal/models"
+)
+
+func TestSimplifiedUserKeepsCanonicalIDs(t *testing.T) {
+	user := &models.User{
+		LinkedInURL: "https://www.linkedin.com/in/jane-doe/",
+		Positions: []models.Position{{Title: "Developer", Skills: []models.Skill{
+			{Name: "Golang", CanonicalID: "go"}, {Name: "Basket Weaving"},
+		}}},
+		Educations: []models.Education{{SchoolName: "DTU", Skills: []models.Skill{{Name: "Python 3", CanonicalID: "python"}}}},
+		SkillFrequencies: []models.SkillFrequency{
+			{Skill: models.Skill{Name: "Golang", CanonicalID: "go"}, Frequency: 3},
+			{Skill: models.Skill{Name: "Basket Weaving"}, Frequency: 1},
+		},
+	}
+
+	data, err := json.Marshal((&DataService{}).convertToSimplifiedUser(user))
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var payload struct {
+		Positions []struct {
+			Skills []map[string]string `json:"skills"`
+		} `json:"positions"`
+		SkillFrequencies []map[string]interface{} `json:"skill_frequencies"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got := payload.Positions[0].Skills[0]; got["name"] != "Golang" || got["canonical_id"] != "go" {
+		t.Errorf("position skill = %v, want name and canonical_id", got)
+	}
+	if got := payload.SkillFrequencies[0]; got["name"] != "Golang" || got["canonical_id"] != "go" || got["frequency"] != 3.0 {
+		t.Errorf("skill frequency = %v, want name, canonical_id and frequency", got)
+	}
+
+	parsed, err := ParseUser(data)
+	if err != nil {
+		t.Fatalf("ParseUser() error = %v", err)
+	}
+	if !reflect.DeepEqual(parsed.Positions[0].Skills, user.Positions[0].Skills) ||
+		!reflect.DeepEqual(parsed.Educations[0].Skills, user.Educations[0].Skills) ||
+		!reflect.DeepEqual(parsed.SkillFrequencies, user.SkillFrequencies) {
+		t.Errorf("ParseUser() lost skills: %+v", parsed)
+	}
+}
+
+func TestParseUserWithoutCanonicalIDs(t *testing.T) {
+	data := `{"data": {
+		"linkedin_url": "https://www.linkedin.com/in/jane-doe/",
+		"positions": [{"title": "Developer", "company_name": "Acme", "skills": ["Go", "PHP"]}],
+		"skill_frequencies": {"PHP": 1, "Go": 4}
+	}}`
+
+	user, err := ParseUser([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseUser() error = %v", err)
+	}
+	if want := []models.Skill{{Name: "Go"}, {Name: "PHP"}}; !reflect.DeepEqual(user.Positions[0].Skills, want) {
+		t.Errorf("position skills = %+v, want %+v", user.Positions[0].Skills, want)
+	}
+	want := []models.SkillFrequency{{Skill: models.Skill{Name: "Go"}, Frequency: 4}, {Skill: models.Skill{Name: "PHP"}, Frequency: 1}}
+	if !reflect.DeepEqual(user.SkillFrequencies, want) {
+		t.Errorf("skill frequencies = %+v, want %+v", user.SkillFrequencies, want)
+	}
+}
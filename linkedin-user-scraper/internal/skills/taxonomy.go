@@ -0,0 +1,126 @@
+// Package skills maps skill labels to canonical skill IDs from the job scraper's
+// skill_taxonomy.json, so skills on profiles and on job postings can be compared.
+package skills
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Taxonomy looks up canonical skill IDs by label
+type Taxonomy struct {
+	labels map[string]string // Label key to skill ID
+	names  map[string]string // Skill ID to display name
+}
+
+// taxonomyFile is the part of skill_taxonomy.json needed for lookups and validation
+type taxonomyFile struct {
+	Categories []struct {
+		ID     string `json:"id"`
+		Parent string `json:"parent"`
+	} `json:"categories"`
+	Skills []struct {
+		ID       string              `json:"id"`
+		Name     string              `json:"name"`
+		Category string              `json:"category"`
+		Aliases  []string            `json:"aliases"`
+		Variants map[string][]string `json:"variants"`
+	} `json:"skills"`
+}
+
+// LoadTaxonomy reads a taxonomy file and checks it like the job scraper's skills.ParseTaxonomy
+// does: every category exists, categories don't form cycles and no label belongs to two skills.
+// The job scraper's package is internal to its module, so the checks are repeated here.
+func LoadTaxonomy(path string) (*Taxonomy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read skill taxonomy: %w", err)
+	}
+	return parseTaxonomy(data)
+}
+
+func parseTaxonomy(data []byte) (*Taxonomy, error) {
+	var file taxonomyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse skill taxonomy: %w", err)
+	}
+
+	parents := make(map[string]string, len(file.Categories))
+	for _, category := range file.Categories {
+		if _, ok := parents[category.ID]; ok {
+			return nil, fmt.Errorf("duplicate category %q", category.ID)
+		}
+		parents[category.ID] = category.Parent
+	}
+	for _, category := range file.Categories {
+		seen := map[string]bool{}
+		for id := category.ID; id != ""; id = parents[id] {
+			if seen[id] {
+				return nil, fmt.Errorf("category %q has a cycle of parents", category.ID)
+			}
+			seen[id] = true
+			if _, ok := parents[id]; !ok {
+				return nil, fmt.Errorf("category %q has unknown parent %q", category.ID, id)
+			}
+		}
+	}
+
+	t := &Taxonomy{labels: make(map[string]string), names: make(map[string]string)}
+	for _, skill := range file.Skills {
+		if _, ok := t.names[skill.ID]; ok {
+			return nil, fmt.Errorf("duplicate skill %q", skill.ID)
+		}
+		if _, ok := parents[skill.Category]; !ok {
+			return nil, fmt.Errorf("skill %q has unknown category %q", skill.ID, skill.Category)
+		}
+		t.names[skill.ID] = skill.Name
+
+		labels := append([]string{skill.ID, skill.Name}, skill.Aliases...)
+		for _, variants := range skill.Variants {
+			labels = append(labels, variants...)
+		}
+		for _, label := range labels {
+			key := labelKey(label)
+			if other, ok := t.labels[key]; ok && other != skill.ID {
+				return nil, fmt.Errorf("label %q belongs to both %q and %q", label, other, skill.ID)
+			}
+			t.labels[key] = skill.ID
+		}
+	}
+	return t, nil
+}
+
+// trailingQualifier matches LinkedIn's disambiguation suffix, e.g. " (Programming Language)"
+var trailingQualifier = regexp.MustCompile(`\s*\([^()]*\)$`)
+
+// Normalise returns the canonical skill ID for a label, ignoring case, spacing and, if the
+// full label is unknown, a trailing qualifier in parentheses. A nil taxonomy knows no labels.
+func (t *Taxonomy) Normalise(label string) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	if id, ok := t.labels[labelKey(label)]; ok {
+		return id, true
+	}
+	if trimmed := trailingQualifier.ReplaceAllString(label, ""); trimmed != label && trimmed != "" {
+		id, ok := t.labels[labelKey(trimmed)]
+		return id, ok
+	}
+	return "", false
+}
+
+// Name returns the display name of a canonical skill ID, or "" if the ID is unknown
+func (t *Taxonomy) Name(id string) string {
+	if t == nil {
+		return ""
+	}
+	return t.names[id]
+}
+
+// labelKey folds case and whitespace
+func labelKey(label string) string {
+	return strings.Join(strings.Fields(strings.ToLower(label)), " ")
+}
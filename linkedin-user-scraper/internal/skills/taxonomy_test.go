@@ -0,0 +1,69 @@
+package skills
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadTaxonomy(t *testing.T) {
+	taxonomy, err := LoadTaxonomy("../../../skill_taxonomy.json")
+	if err != nil {
+		t.Fatalf("LoadTaxonomy() error = %v", err)
+	}
+	if id, ok := taxonomy.Normalise("Go (programmeringssprog)"); !ok || id != "go" {
+		t.Errorf("Normalise() = %q, %v, want go", id, ok)
+	}
+	if name := taxonomy.Name("go"); name != "Go" {
+		t.Errorf("Name(go) = %q, want Go", name)
+	}
+}
+
+func TestParseTaxonomyValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{
+			name: "duplicate category",
+			json: `{"categories": [{"id": "tech"}, {"id": "tech"}]}`,
+			want: `duplicate category "tech"`,
+		},
+		{
+			name: "unknown parent",
+			json: `{"categories": [{"id": "web", "parent": "tech"}]}`,
+			want: `category "web" has unknown parent "tech"`,
+		},
+		{
+			name: "cycle",
+			json: `{"categories": [{"id": "a", "parent": "b"}, {"id": "b", "parent": "a"}]}`,
+			want: `category "a" has a cycle of parents`,
+		},
+		{
+			name: "duplicate skill",
+			json: `{"categories": [{"id": "tech"}], "skills": [
+				{"id": "go", "name": "Go", "category": "tech"}, {"id": "go", "name": "Golang", "category": "tech"}]}`,
+			want: `duplicate skill "go"`,
+		},
+		{
+			name: "unknown category",
+			json: `{"categories": [{"id": "tech"}], "skills": [{"id": "go", "name": "Go", "category": "languages"}]}`,
+			want: `skill "go" has unknown category "languages"`,
+		},
+		{
+			name: "label of two skills",
+			json: `{"categories": [{"id": "tech"}], "skills": [
+				{"id": "go", "name": "Go", "category": "tech", "aliases": ["Golang"]},
+				{"id": "golang", "name": "Golang", "category": "tech"}]}`,
+			want: `label "golang" belongs to both "go" and "golang"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseTaxonomy([]byte(tt.json))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("parseTaxonomy() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}